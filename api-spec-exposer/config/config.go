@@ -1,15 +1,27 @@
 package config
 
-import "net/http"
+import (
+	"fmt"
+	"net/http"
+)
 
 // ApiType represents the type of API specification
 type ApiType string
 
 const (
-	ApiTypeRest     ApiType = "rest"
-	ApiTypeGraphQL  ApiType = "graphql"
-	ApiTypeMarkdown ApiType = "markdown"
-	ApiTypeUnknown  ApiType = "unknown"
+	ApiTypeRest         ApiType = "rest"
+	ApiTypeGraphQL      ApiType = "graphql"
+	ApiTypeMarkdown     ApiType = "markdown"
+	ApiTypeJSONSchema   ApiType = "json-schema"
+	ApiTypeSOAP         ApiType = "soap"
+	ApiTypeOData        ApiType = "odata"
+	ApiTypeRAML         ApiType = "raml"
+	ApiTypeAPIBlueprint ApiType = "api-blueprint"
+	ApiTypeAvro         ApiType = "avro"
+	ApiTypeArazzo       ApiType = "arazzo"
+	ApiTypeOverlay      ApiType = "overlay"
+	ApiTypePostman      ApiType = "postman"
+	ApiTypeUnknown      ApiType = "unknown"
 )
 
 // DocumentType represents the specific document type (for apihub-swagger-config)
@@ -25,6 +37,29 @@ const (
 
 	DocTypeMarkdown DocumentType = "markdown"
 
+	DocTypeJSONSchema DocumentType = "json-schema"
+
+	DocTypeWSDL11 DocumentType = "wsdl-1-1"
+	DocTypeWSDL20 DocumentType = "wsdl-2-0"
+	DocTypeXSD    DocumentType = "xsd"
+
+	DocTypeODataCSDLXML  DocumentType = "odata-csdl-xml"
+	DocTypeODataCSDLJSON DocumentType = "odata-csdl-json"
+
+	DocTypeRAML08       DocumentType = "raml-0-8"
+	DocTypeRAML10       DocumentType = "raml-1-0"
+	DocTypeAPIBlueprint DocumentType = "api-blueprint"
+
+	DocTypeAvroSchema   DocumentType = "avro-schema"
+	DocTypeAvroProtocol DocumentType = "avro-protocol"
+	DocTypeAvroIDL      DocumentType = "avro-idl"
+
+	DocTypeArazzo10  DocumentType = "arazzo-1-0"
+	DocTypeOverlay10 DocumentType = "overlay-1-0"
+
+	DocTypePostmanCollection20 DocumentType = "postman-collection-2-0"
+	DocTypePostmanCollection21 DocumentType = "postman-collection-2-1"
+
 	DocTypeUnknown DocumentType = "unknown"
 )
 
@@ -32,11 +67,27 @@ const (
 type Format string
 
 const (
-	FormatJSON     Format = "json"
-	FormatYAML     Format = "yaml"
-	FormatGraphQL  Format = "graphql"
-	FormatMarkdown Format = "md"
-	FormatUnknown  Format = "unknown"
+	FormatJSON         Format = "json"
+	FormatYAML         Format = "yaml"
+	FormatGraphQL      Format = "graphql"
+	FormatMarkdown     Format = "md"
+	FormatXML          Format = "xml"
+	FormatRAML         Format = "raml"
+	FormatAPIBlueprint Format = "apib"
+	FormatAvroIDL      Format = "avdl"
+	FormatUnknown      Format = "unknown"
+)
+
+// ConversionMode controls how a converted document is exposed relative to its source
+type ConversionMode string
+
+const (
+	// ConversionDisabled exposes the source document only
+	ConversionDisabled ConversionMode = ""
+	// ConversionReplace exposes the converted document instead of the source one
+	ConversionReplace ConversionMode = "replace"
+	// ConversionAlongside exposes the converted document in addition to the source one
+	ConversionAlongside ConversionMode = "alongside"
 )
 
 // SpecMetadata contains metadata about a discovered spec
@@ -48,6 +99,127 @@ type SpecMetadata struct {
 	Format   Format
 	FileId   string //slug
 	XApiKind string
+
+	// Files merged into the spec, FilePath is the directory of the group in this case
+	SourceFiles []string
+	// SourceFiles are federation subgraphs composed into a supergraph
+	Supergraph bool
+
+	// Files referenced by relative links of a Markdown document or imported by a WSDL document and served along with it
+	Assets []string
+
+	// Discovered OpenAPI specs referenced by the source descriptions of an Arazzo document or extended by an Overlay document
+	LinkedSpecs []string
+
+	// Position of the spec in a multi-document YAML file starting at 1, 0 if the spec is the whole file
+	DocumentIndex int
+
+	// Archive containing the spec, FilePath is the path of the archive joined with the path of the spec inside it in this case
+	Archive string
+	// Limits of the archive scanning the spec was found with, they apply when the spec is read from the archive as well
+	ArchiveLimits ArchiveScanningConfig
+
+	// Original encoding of a spec served converted to UTF-8, e.g. "UTF-16LE"; empty for UTF-8 files without a byte order mark
+	Encoding string
+	SpecDetails
+}
+
+// SpecDetails contains descriptive metadata extracted from the spec document
+type SpecDetails struct {
+	// REST specs, also filled from the matching fields of RAML, API Blueprint, Arazzo, Overlay and Postman documents
+	Version         string   `json:"version,omitempty"`
+	Description     string   `json:"description,omitempty"`
+	Contact         *Contact `json:"contact,omitempty"`
+	License         *License `json:"license,omitempty"`
+	Tags            []string `json:"tags,omitempty"`
+	Servers         []string `json:"servers,omitempty"`
+	OperationCount  int      `json:"operationCount,omitempty"`
+	SecuritySchemes []string `json:"securitySchemes,omitempty"`
+
+	// GraphQL specs
+	TypeCount        int    `json:"typeCount,omitempty"`
+	QueryCount       int    `json:"queryCount,omitempty"`
+	MutationCount    int    `json:"mutationCount,omitempty"`
+	QueryType        string `json:"queryType,omitempty"`
+	MutationType     string `json:"mutationType,omitempty"`
+	SubscriptionType string `json:"subscriptionType,omitempty"`
+
+	// Details of single document families
+	FederationDetails
+	MarkdownDetails
+	JSONSchemaDetails
+	SOAPDetails
+	ODataDetails
+	ArazzoOverlayDetails
+	AvroDetails
+	PostmanDetails
+}
+
+// FederationDetails contains the details of GraphQL federation subgraphs and supergraphs
+type FederationDetails struct {
+	FederationSubgraph bool     `json:"federationSubgraph,omitempty"`
+	SubgraphName       string   `json:"subgraphName,omitempty"`
+	Subgraphs          []string `json:"subgraphs,omitempty"` // names of the subgraphs composed into a supergraph
+}
+
+// MarkdownDetails contains the front matter details of Markdown documents
+type MarkdownDetails struct {
+	RelatedSpecs []string `json:"relatedSpecs,omitempty"` // file paths or names of the specs the document describes
+	Order        int      `json:"order,omitempty"`        // position in config listings, documents without order go last
+}
+
+// JSONSchemaDetails contains the details of standalone JSON Schema documents
+type JSONSchemaDetails struct {
+	SchemaId        string `json:"schemaId,omitempty"`        // '$id' of the schema
+	SchemaDialect   string `json:"schemaDialect,omitempty"`   // draft declared by '$schema', e.g. draft-07 or 2020-12
+	DefinitionCount int    `json:"definitionCount,omitempty"` // number of '$defs' or 'definitions' entries
+}
+
+// SOAPDetails contains the details of WSDL and XSD documents
+type SOAPDetails struct {
+	TargetNamespace    string   `json:"targetNamespace,omitempty"`
+	Services           []string `json:"services,omitempty"`           // names of the services described by a WSDL document
+	SOAPOperationCount int      `json:"soapOperationCount,omitempty"` // operations of WSDL 1.1 port types or WSDL 2.0 interfaces
+}
+
+// ODataDetails contains the details of OData CSDL metadata documents
+type ODataDetails struct {
+	ODataVersion    string `json:"odataVersion,omitempty"`    // CSDL version from the 'Version' attribute or '$Version'
+	EntityContainer string `json:"entityContainer,omitempty"` // qualified name of the entity container
+	EntitySetCount  int    `json:"entitySetCount,omitempty"`
+}
+
+// AvroDetails contains the details of Avro schemas, protocols and IDL documents
+type AvroDetails struct {
+	NamedTypeCount int `json:"namedTypeCount,omitempty"` // records, enums and fixed types declared in the document
+	MessageCount   int `json:"messageCount,omitempty"`   // messages of a protocol
+}
+
+// ArazzoOverlayDetails contains the details of Arazzo and Overlay documents
+type ArazzoOverlayDetails struct {
+	SourceDescriptions []string `json:"sourceDescriptions,omitempty"` // URLs of the OpenAPI source descriptions of an Arazzo document
+	WorkflowCount      int      `json:"workflowCount,omitempty"`
+	Extends            string   `json:"extends,omitempty"` // URL of the document an Overlay applies to
+	ActionCount        int      `json:"actionCount,omitempty"`
+	LinkedSpecURLs     []string `json:"linkedSpecs,omitempty"` // endpoint paths of the linked OpenAPI specs, set in config listings only
+}
+
+// PostmanDetails contains the details of Postman collections
+type PostmanDetails struct {
+	RequestCount int `json:"requestCount,omitempty"` // requests, including those in folders
+}
+
+// Contact represents the contact information of a REST spec
+type Contact struct {
+	Name  string `json:"name,omitempty"`
+	URL   string `json:"url,omitempty"`
+	Email string `json:"email,omitempty"`
+}
+
+// License represents the license information of a REST spec
+type License struct {
+	Name string `json:"name,omitempty"`
+	URL  string `json:"url,omitempty"`
 }
 
 // EndpointConfig represents an HTTP endpoint configuration with its handler function and related API spec metadata
@@ -57,11 +229,43 @@ type EndpointConfig struct {
 	Handler func(w http.ResponseWriter, r *http.Request)
 }
 
+// Severity represents the severity of a diagnostic
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityInfo    Severity = "info"
+	SeverityOff     Severity = "off" // disables a lint rule
+)
+
+// Diagnostic describes a problem found in a discovered spec document
+type Diagnostic struct {
+	FilePath string
+	Location string // JSON pointer to the problem location within JSON and YAML documents, 'line:column' within GraphQL SDL
+	Severity Severity
+	Rule     string // lint rule name, empty for structural problems
+	Message  string
+}
+
+// String returns a human-readable representation of the diagnostic
+func (d Diagnostic) String() string {
+	location := d.Location
+	if location == "" {
+		location = "/"
+	}
+	if d.Rule != "" {
+		return fmt.Sprintf("%s: file %s at '%s': %s [%s]", d.Severity, d.FilePath, location, d.Message, d.Rule)
+	}
+	return fmt.Sprintf("%s: file %s at '%s': %s", d.Severity, d.FilePath, location, d.Message)
+}
+
 // DiscoveryResult contains the result of spec discovery
 type DiscoveryResult struct {
-	Endpoints []EndpointConfig
-	Warnings  []string
-	Errors    []error
+	Endpoints   []EndpointConfig
+	Diagnostics []Diagnostic
+	Warnings    []string
+	Errors      []error
 }
 
 // DiscoveryConfig contains configuration for spec discovery
@@ -71,6 +275,111 @@ type DiscoveryConfig struct {
 
 	// Exclude patterns
 	ExcludePatterns []string
+
+	// API types of files with custom extensions, e.g. {"sdl": ApiTypeGraphQL, "oas": ApiTypeRest}
+	ExtensionMappings map[string]ApiType
+
+	// Detect the API type of extensionless and .txt files from their content
+	ContentSniffing bool
+
+	// Discovery of specs inside .zip, .tar and .tar.gz archives
+	ArchiveScanning ArchiveScanningConfig
+
+	// Conversion of OpenAPI 2.0 (Swagger) specs to OpenAPI 3.0
+	Swagger2Conversion ConversionMode
+
+	// Validate identified specs against the structural rules of their document type
+	ValidateSpecs bool
+
+	// API style linting of REST and GraphQL specs
+	Lint LintConfig
+
+	// Include spec details (@SpecDetails) in config endpoint responses
+	ExposeMetadata bool
+
+	// Generate operation index endpoints for REST and GraphQL specs
+	ExposeOperationIndex bool
+
+	// Serve an introspection result derived from a single GraphQL schema and a schema derived from a single introspection result
+	DeriveGraphQLSpecs bool
+
+	// Merging of GraphQL SDL files into logical schemas
+	GraphQLStitching GraphQLStitchingConfig
+
+	// Compose a supergraph schema from GraphQL federation subgraphs discovered together
+	ComposeSupergraph bool
+
+	// Name of the composed supergraph spec, "Supergraph" if not set. Its file ID is derived from the name
+	SupergraphName string
+
+	// Serve files referenced by relative links of Markdown documents along with them and rewrite the links
+	BundleMarkdownAssets bool
+
+	// Serve OpenAPI specs extended by discovered Overlay documents with the overlay actions applied
+	ApplyOverlays bool
+
+	// Serve a Postman collection with example requests converted from every REST spec on '{spec path}/postman-collection'
+	PostmanExport bool
+
+	// Serving of Markdown documents rendered to HTML
+	MarkdownRendering MarkdownRenderingConfig
+
+	// Interactive documentation pages served along with the specs
+	DocumentationUI DocumentationUIConfig
+}
+
+// GraphQLStitchingConfig contains configuration for merging GraphQL SDL files into logical schemas
+type GraphQLStitchingConfig struct {
+	// Merge GraphQL SDL files of each group into a single schema
+	Enabled bool
+
+	// Glob patterns relative to the scan directory, files matching the same pattern in the same directory form one schema,
+	// so a pattern with wildcard directories forms a schema per matched directory.
+	// SDL files are grouped by directory when no patterns are configured
+	Groups []string
+}
+
+// ArchiveScanningConfig contains configuration for the discovery of specs inside archives
+type ArchiveScanningConfig struct {
+	// Identify the files of archives found in the scan directory and serve them from the archive without extracting it
+	Enabled bool
+
+	// Maximum number of files in an archive, 10000 if not set
+	MaxEntries int
+
+	// Maximum uncompressed size of a file in an archive in bytes, larger files are skipped; 16 MiB if not set
+	MaxFileSize int64
+
+	// Maximum uncompressed size of the content read from an archive in bytes, 256 MiB if not set
+	MaxTotalSize int64
+}
+
+// MarkdownRenderingConfig contains configuration for HTML rendering of Markdown documents
+type MarkdownRenderingConfig struct {
+	// Serve every Markdown document rendered to sanitized HTML on '{document path}.html'
+	// and on the document path to clients accepting text/html
+	Enabled bool
+
+	// CSS included in rendered pages instead of the default stylesheet
+	Stylesheet string
+}
+
+// DocumentationUIConfig contains configuration for documentation pages, their assets are embedded in the module
+type DocumentationUIConfig struct {
+	// Serve Swagger UI for REST specs on /swagger-ui/index.html
+	SwaggerUI bool
+
+	// Serve a GraphQL schema explorer for GraphQL specs on /graphql-explorer
+	GraphQLExplorer bool
+}
+
+// LintConfig contains configuration for API style linting
+type LintConfig struct {
+	// Run lint rules over discovered REST and GraphQL specs
+	Enabled bool
+
+	// Severity overrides by rule name, SeverityOff disables the rule
+	Rules map[string]Severity
 }
 
 // DefaultConfig returns a default discovery configuration
@@ -87,6 +396,7 @@ type ConfigURL struct {
 	Name     string `json:"name"`
 	Type     string `json:"type,omitempty"`
 	XApiKind string `json:"x-api-kind,omitempty"`
+	*SpecDetails
 }
 
 // ApiSpecConfig represents a unified configuration response
@@ -94,3 +404,29 @@ type ApiSpecConfig struct {
 	ConfigURL string      `json:"configUrl,omitempty"`
 	URLs      []ConfigURL `json:"urls"`
 }
+
+// OperationIndex represents a compact list of operations of a spec in operation index endpoints response
+type OperationIndex struct {
+	URL        string               `json:"url"`
+	Name       string               `json:"name"`
+	Type       string               `json:"type,omitempty"`
+	Operations []OperationIndexItem `json:"operations"`
+	Error      string               `json:"error,omitempty"` // reason the spec could not be indexed, in the combined index only
+}
+
+// OperationIndexItem represents a REST operation or a GraphQL root field
+type OperationIndexItem struct {
+	Method        string   `json:"method,omitempty"`
+	Path          string   `json:"path,omitempty"`
+	OperationType string   `json:"operationType,omitempty"` // GraphQL root operation type: query, mutation or subscription
+	Name          string   `json:"name,omitempty"`          // GraphQL root field name
+	OperationId   string   `json:"operationId,omitempty"`
+	Summary       string   `json:"summary,omitempty"`
+	Tags          []string `json:"tags,omitempty"`
+	Deprecated    bool     `json:"deprecated,omitempty"`
+}
+
+// CombinedOperationIndex represents the combined operation index endpoint response
+type CombinedOperationIndex struct {
+	Specs []OperationIndex `json:"specs"`
+}
@@ -3,6 +3,7 @@ package exposer
 import (
 	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
 	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/generator"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/linter"
 	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/scanner"
 )
 
@@ -27,11 +28,19 @@ func (se *specExposer) Discover() config.DiscoveryResult {
 	var discoveryResult config.DiscoveryResult
 	specScanner := scanner.New(se.config)
 
-	specs, scanWarnings, scanErrors := specScanner.Scan()
+	specs, scanDiagnostics, scanWarnings, scanErrors := specScanner.Scan()
+	discoveryResult.Diagnostics = append(discoveryResult.Diagnostics, scanDiagnostics...)
 	discoveryResult.Warnings = append(discoveryResult.Warnings, scanWarnings...)
 	discoveryResult.Errors = append(discoveryResult.Errors, scanErrors...)
 
-	gen := generator.New(specs)
+	if se.config.Lint.Enabled {
+		lintDiagnostics, lintWarnings, lintErrors := linter.New(se.config.Lint).Lint(specs)
+		discoveryResult.Diagnostics = append(discoveryResult.Diagnostics, lintDiagnostics...)
+		discoveryResult.Warnings = append(discoveryResult.Warnings, lintWarnings...)
+		discoveryResult.Errors = append(discoveryResult.Errors, lintErrors...)
+	}
+
+	gen := generator.New(specs, se.config)
 	endpoints := gen.Generate()
 	discoveryResult.Endpoints = endpoints
 
@@ -429,4 +429,131 @@ func TestSpecExposerDiscoverWithMixedTypes(t *testing.T) {
 	}
 }
 
+func TestSpecExposerDiscoverWithValidation(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "exposer-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	openapiContent := []byte(`{
+		"openapi": "3.0.0",
+		"info": {
+			"title": "Test API",
+			"version": "1.0.0"
+		},
+		"paths": {
+			"/users": {
+				"get": {
+					"responses": {
+						"200": {"$ref": "#/components/responses/Missing"}
+					}
+				}
+			}
+		}
+	}`)
+	openapiPath := filepath.Join(tempDir, "openapi.json")
+	err = os.WriteFile(openapiPath, openapiContent, 0644)
+	if err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	cfg := config.DiscoveryConfig{
+		ScanDirectory: tempDir,
+		ValidateSpecs: true,
+	}
+
+	exposer := New(cfg)
+	result := exposer.Discover()
+
+	if len(result.Endpoints) != 1 {
+		t.Fatalf("Expected 1 endpoint, got %d", len(result.Endpoints))
+	}
+
+	if len(result.Diagnostics) != 1 {
+		t.Fatalf("Expected 1 diagnostic, got %d: %v", len(result.Diagnostics), result.Diagnostics)
+	}
+
+	diagnostic := result.Diagnostics[0]
+	if diagnostic.Location != "/paths/~1users/get/responses/200/$ref" {
+		t.Errorf("Expected diagnostic location '/paths/~1users/get/responses/200/$ref', got '%s'", diagnostic.Location)
+	}
+
+	if diagnostic.Severity != config.SeverityError {
+		t.Errorf("Expected severity error, got %s", diagnostic.Severity)
+	}
+
+	if len(result.Errors) != 0 {
+		t.Errorf("Expected 0 errors, got %d", len(result.Errors))
+	}
+}
+
+func TestSpecExposerDiscoverWithLint(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "exposer-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	openapiContent := []byte(`{
+		"openapi": "3.0.0",
+		"x-api-kind": "BWC",
+		"info": {
+			"title": "Test API",
+			"version": "1.0.0"
+		},
+		"paths": {
+			"/users": {
+				"get": {
+					"operationId": "listUsers",
+					"responses": {
+						"200": {"description": "OK"}
+					}
+				}
+			}
+		}
+	}`)
+	openapiPath := filepath.Join(tempDir, "openapi.json")
+	err = os.WriteFile(openapiPath, openapiContent, 0644)
+	if err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	cfg := config.DiscoveryConfig{
+		ScanDirectory: tempDir,
+		Lint: config.LintConfig{
+			Enabled: true,
+			Rules: map[string]config.Severity{
+				"operation-error-responses": config.SeverityError,
+			},
+		},
+	}
 
+	exposer := New(cfg)
+	result := exposer.Discover()
+
+	if len(result.Endpoints) != 1 {
+		t.Fatalf("Expected 1 endpoint, got %d", len(result.Endpoints))
+	}
+
+	if len(result.Diagnostics) != 1 {
+		t.Fatalf("Expected 1 diagnostic, got %d: %v", len(result.Diagnostics), result.Diagnostics)
+	}
+
+	diagnostic := result.Diagnostics[0]
+	if diagnostic.Rule != "operation-error-responses" {
+		t.Errorf("Expected rule 'operation-error-responses', got '%s'", diagnostic.Rule)
+	}
+
+	if diagnostic.Severity != config.SeverityError {
+		t.Errorf("Expected configured severity error, got %s", diagnostic.Severity)
+	}
+
+	if len(result.Warnings) != 0 {
+		t.Errorf("Expected 0 warnings, got %d", len(result.Warnings))
+	}
+
+	if len(result.Errors) != 0 {
+		t.Errorf("Expected 0 errors, got %d", len(result.Errors))
+	}
+}
@@ -0,0 +1,298 @@
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+)
+
+// Limits protect the scanning of archives against decompression bombs
+type Limits struct {
+	MaxEntries   int   // files in an archive
+	MaxFileSize  int64 // uncompressed size of a file, larger files are skipped
+	MaxTotalSize int64 // uncompressed size of the content read from an archive
+}
+
+// DefaultLimits are used for the limits that are not configured
+var DefaultLimits = Limits{
+	MaxEntries:   10000,
+	MaxFileSize:  16 << 20,
+	MaxTotalSize: 256 << 20,
+}
+
+var errTooLarge = errors.New("size limit exceeded")
+
+// header describes an entry of an archive
+type header struct {
+	name    string
+	regular bool
+	link    bool
+	size    int64 // declared uncompressed size
+}
+
+// IsArchive reports whether a file is a supported archive (.zip, .tar, .tar.gz or .tgz) by its name
+func IsArchive(filePath string) bool {
+	name := strings.ToLower(filePath)
+	for _, ext := range []string{".zip", ".tar", ".tar.gz", ".tgz"} {
+		if strings.HasSuffix(name, ext) {
+			return true
+		}
+	}
+	return false
+}
+
+// Walk calls fn for every regular file of an archive in archive order with the slash-separated path of the file.
+// Links, files with paths leading outside the archive (zip slip), files with the path of an earlier file
+// and files larger than MaxFileSize are skipped with a warning; the walk stops with an error when the archive
+// has more than MaxEntries files or more than MaxTotalSize bytes have to be decompressed
+func Walk(filePath string, limits Limits, fn func(name string, content []byte)) ([]string, error) {
+	limits = limits.withDefaults()
+
+	var warnings []string
+	entries := 0
+	var total int64
+	// ReadFile serves the first file with a path, so later files with the same path are never passed to fn
+	names := make(map[string]bool)
+	err := forEachEntry(filePath, limits.MaxTotalSize, func(h header, open func() (io.ReadCloser, error)) (bool, error) {
+		if h.link {
+			warnings = append(warnings, fmt.Sprintf("archive %s: link %s skipped", filePath, h.name))
+			return false, nil
+		}
+		if !h.regular {
+			return false, nil
+		}
+
+		entries++
+		if entries > limits.MaxEntries {
+			return true, fmt.Errorf("more than %d files", limits.MaxEntries)
+		}
+		name, ok := cleanName(h.name)
+		if !ok {
+			warnings = append(warnings, fmt.Sprintf("archive %s: file %s points outside the archive, skipped", filePath, h.name))
+			return false, nil
+		}
+		if names[name] {
+			warnings = append(warnings, fmt.Sprintf("archive %s: file %s is duplicated, skipped", filePath, name))
+			return false, nil
+		}
+		names[name] = true
+		if h.size > limits.MaxFileSize {
+			warnings = append(warnings, fmt.Sprintf("archive %s: file %s is larger than %d bytes, skipped", filePath, name, limits.MaxFileSize))
+			return false, nil
+		}
+
+		reader, err := open()
+		if err != nil {
+			return true, err
+		}
+		defer reader.Close()
+
+		// The declared size is not trusted, the content is read up to the lower of the remaining limits
+		limit := limits.MaxFileSize
+		if remaining := limits.MaxTotalSize - total; remaining < limit {
+			limit = remaining
+		}
+		content, err := readLimited(reader, limit)
+		if errors.Is(err, errTooLarge) && limit == limits.MaxFileSize {
+			warnings = append(warnings, fmt.Sprintf("archive %s: file %s is larger than %d bytes, skipped", filePath, name, limits.MaxFileSize))
+			return false, nil
+		}
+		if err != nil {
+			return true, err
+		}
+
+		total += int64(len(content))
+		fn(name, content)
+		return false, nil
+	})
+	if errors.Is(err, errTooLarge) {
+		err = fmt.Errorf("uncompressed content is larger than %d bytes", limits.MaxTotalSize)
+	}
+	return warnings, err
+}
+
+// ReadFile returns the content of the first regular file of an archive with a slash-separated path,
+// the file that Walk passes on. The limits of Walk apply: reading fails when the file is larger than MaxFileSize
+// or when it is not found among the first MaxEntries files and MaxTotalSize decompressed bytes
+func ReadFile(filePath string, name string, limits Limits) ([]byte, error) {
+	limits = limits.withDefaults()
+
+	var content []byte
+	found := false
+	entries := 0
+	err := forEachEntry(filePath, limits.MaxTotalSize, func(h header, open func() (io.ReadCloser, error)) (bool, error) {
+		if !h.regular {
+			return false, nil
+		}
+		entries++
+		if entries > limits.MaxEntries {
+			return true, fmt.Errorf("more than %d files", limits.MaxEntries)
+		}
+		if entryName, ok := cleanName(h.name); !ok || entryName != name {
+			return false, nil
+		}
+
+		found = true
+		if h.size > limits.MaxFileSize {
+			return true, errTooLarge
+		}
+		reader, err := open()
+		if err != nil {
+			return true, err
+		}
+		defer reader.Close()
+
+		content, err = readLimited(reader, limits.MaxFileSize)
+		return true, err
+	})
+	if errors.Is(err, errTooLarge) {
+		return nil, fmt.Errorf("cannot read file %s of archive %s: size limit of %d bytes per file or %d bytes in total exceeded",
+			name, filePath, limits.MaxFileSize, limits.MaxTotalSize)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("archive %s has no file %s", filePath, name)
+	}
+	return content, nil
+}
+
+// forEachEntry calls fn for the entries of an archive until fn returns true or an error.
+// The decompressed tar stream is limited to maxStreamSize bytes unless it is negative
+func forEachEntry(filePath string, maxStreamSize int64, fn func(h header, open func() (io.ReadCloser, error)) (bool, error)) error {
+	if strings.HasSuffix(strings.ToLower(filePath), ".zip") {
+		return forEachZipEntry(filePath, fn)
+	}
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	var stream io.Reader = file
+	name := strings.ToLower(filePath)
+	if strings.HasSuffix(name, ".gz") || strings.HasSuffix(name, ".tgz") {
+		gzipReader, err := gzip.NewReader(file)
+		if err != nil {
+			return err
+		}
+		defer gzipReader.Close()
+		stream = gzipReader
+	}
+	if maxStreamSize >= 0 {
+		// Skipped entries are decompressed as well, so the limit applies to the whole stream
+		stream = &limitedReader{reader: stream, remaining: maxStreamSize}
+	}
+
+	reader := tar.NewReader(stream)
+	for {
+		entry, err := reader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		h := header{
+			name:    entry.Name,
+			regular: entry.Typeflag == tar.TypeReg,
+			link:    entry.Typeflag == tar.TypeSymlink || entry.Typeflag == tar.TypeLink,
+			size:    entry.Size,
+		}
+		stop, err := fn(h, func() (io.ReadCloser, error) {
+			return io.NopCloser(reader), nil
+		})
+		if stop || err != nil {
+			return err
+		}
+	}
+}
+
+func forEachZipEntry(filePath string, fn func(h header, open func() (io.ReadCloser, error)) (bool, error)) error {
+	reader, err := zip.OpenReader(filePath)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	for _, file := range reader.File {
+		mode := file.Mode()
+		h := header{
+			name:    file.Name,
+			regular: mode.IsRegular(),
+			link:    mode&os.ModeSymlink != 0,
+			size:    int64(file.UncompressedSize64),
+		}
+		stop, err := fn(h, file.Open)
+		if stop || err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cleanName returns the slash-separated path of an entry relative to the archive root,
+// false for absolute paths and paths leading outside the root
+func cleanName(name string) (string, bool) {
+	name = strings.ReplaceAll(name, "\\", "/")
+	if strings.HasPrefix(name, "/") || (len(name) >= 2 && name[1] == ':') {
+		return "", false
+	}
+	cleaned := path.Clean(name)
+	if cleaned == "." || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", false
+	}
+	return cleaned, true
+}
+
+// readLimited reads all content of a reader, errTooLarge if it has more than limit bytes
+func readLimited(reader io.Reader, limit int64) ([]byte, error) {
+	content, err := io.ReadAll(io.LimitReader(reader, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(content)) > limit {
+		return nil, errTooLarge
+	}
+	return content, nil
+}
+
+func (l Limits) withDefaults() Limits {
+	if l.MaxEntries <= 0 {
+		l.MaxEntries = DefaultLimits.MaxEntries
+	}
+	if l.MaxFileSize <= 0 {
+		l.MaxFileSize = DefaultLimits.MaxFileSize
+	}
+	if l.MaxTotalSize <= 0 {
+		l.MaxTotalSize = DefaultLimits.MaxTotalSize
+	}
+	return l
+}
+
+// limitedReader fails with errTooLarge instead of returning EOF when the limit is reached
+type limitedReader struct {
+	reader    io.Reader
+	remaining int64
+}
+
+func (r *limitedReader) Read(p []byte) (int, error) {
+	if r.remaining <= 0 {
+		return 0, errTooLarge
+	}
+	if int64(len(p)) > r.remaining {
+		p = p[:r.remaining]
+	}
+	n, err := r.reader.Read(p)
+	r.remaining -= int64(n)
+	return n, err
+}
@@ -0,0 +1,235 @@
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+type testEntry struct {
+	name    string
+	content string
+	symlink bool
+}
+
+func writeZip(t *testing.T, path string, entries []testEntry) {
+	t.Helper()
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("Failed to create archive: %v", err)
+	}
+	defer file.Close()
+
+	writer := zip.NewWriter(file)
+	for _, entry := range entries {
+		header := &zip.FileHeader{Name: entry.name, Method: zip.Deflate}
+		if entry.symlink {
+			header.SetMode(os.ModeSymlink | 0777)
+		}
+		w, err := writer.CreateHeader(header)
+		if err != nil {
+			t.Fatalf("Failed to add archive entry: %v", err)
+		}
+		w.Write([]byte(entry.content))
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Failed to write archive: %v", err)
+	}
+}
+
+func writeTarGz(t *testing.T, path string, entries []testEntry) {
+	t.Helper()
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("Failed to create archive: %v", err)
+	}
+	defer file.Close()
+
+	gzipWriter := gzip.NewWriter(file)
+	writer := tar.NewWriter(gzipWriter)
+	for _, entry := range entries {
+		header := &tar.Header{Name: entry.name, Mode: 0644, Size: int64(len(entry.content)), Typeflag: tar.TypeReg}
+		if entry.symlink {
+			header = &tar.Header{Name: entry.name, Linkname: entry.content, Typeflag: tar.TypeSymlink}
+		}
+		if err := writer.WriteHeader(header); err != nil {
+			t.Fatalf("Failed to add archive entry: %v", err)
+		}
+		if !entry.symlink {
+			writer.Write([]byte(entry.content))
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Failed to write archive: %v", err)
+	}
+	if err := gzipWriter.Close(); err != nil {
+		t.Fatalf("Failed to write archive: %v", err)
+	}
+}
+
+func TestWalk(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "archive-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	entries := []testEntry{
+		{name: "specs/", content: ""},
+		{name: "specs/pets.yaml", content: "openapi: 3.0.0\n"},
+		{name: "./specs/../schema.graphql", content: "type Query { a: String }"},
+		{name: "specs/./pets.yaml", content: "swagger: \"2.0\"\n"},
+		{name: "../evil.yaml", content: "openapi: 3.0.0\n"},
+		{name: "/etc/evil.yaml", content: "openapi: 3.0.0\n"},
+		{name: "link.yaml", content: "/etc/passwd", symlink: true},
+		{name: "large.json", content: strings.Repeat("x", 64)},
+	}
+	zipPath := filepath.Join(tempDir, "specs.zip")
+	writeZip(t, zipPath, entries)
+	tarPath := filepath.Join(tempDir, "specs.tar.gz")
+	writeTarGz(t, tarPath, entries[1:])
+
+	for _, path := range []string{zipPath, tarPath} {
+		t.Run(filepath.Base(path), func(t *testing.T) {
+			files := make(map[string]string)
+			var names []string
+			warnings, err := Walk(path, Limits{MaxFileSize: 32}, func(name string, content []byte) {
+				names = append(names, name)
+				files[name] = string(content)
+			})
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if strings.Join(names, ",") != "specs/pets.yaml,schema.graphql" {
+				t.Errorf("Expected files specs/pets.yaml and schema.graphql, got %v", names)
+			}
+			if files["specs/pets.yaml"] != "openapi: 3.0.0\n" {
+				t.Errorf("Unexpected content %q", files["specs/pets.yaml"])
+			}
+
+			expectedWarnings := []string{"specs/pets.yaml is duplicated", "../evil.yaml points outside the archive", "/etc/evil.yaml points outside the archive", "link link.yaml skipped", "large.json is larger than 32 bytes"}
+			if len(warnings) != len(expectedWarnings) {
+				t.Fatalf("Expected %d warnings, got %v", len(expectedWarnings), warnings)
+			}
+			for i, expected := range expectedWarnings {
+				if !strings.Contains(warnings[i], expected) {
+					t.Errorf("Expected warning containing '%s', got '%s'", expected, warnings[i])
+				}
+			}
+		})
+	}
+}
+
+func TestWalkLimits(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "archive-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	entries := []testEntry{
+		{name: "a.yaml", content: strings.Repeat("a", 40)},
+		{name: "b.yaml", content: strings.Repeat("b", 40)},
+		{name: "c.yaml", content: strings.Repeat("c", 40)},
+	}
+	zipPath := filepath.Join(tempDir, "specs.zip")
+	writeZip(t, zipPath, entries)
+	tarPath := filepath.Join(tempDir, "specs.tgz")
+	writeTarGz(t, tarPath, entries)
+
+	tests := []struct {
+		name     string
+		limits   Limits
+		expected string
+	}{
+		{"entries", Limits{MaxEntries: 2}, "more than 2 files"},
+		{"total size", Limits{MaxTotalSize: 100}, "larger than 100 bytes"},
+	}
+
+	for _, path := range []string{zipPath, tarPath} {
+		for _, tt := range tests {
+			t.Run(filepath.Base(path)+" "+tt.name, func(t *testing.T) {
+				count := 0
+				_, err := Walk(path, tt.limits, func(name string, content []byte) {
+					count++
+				})
+				if err == nil || !strings.Contains(err.Error(), tt.expected) {
+					t.Fatalf("Expected error containing '%s', got %v", tt.expected, err)
+				}
+				if count > 2 {
+					t.Errorf("Expected the walk to stop at the limit, got %d files", count)
+				}
+			})
+		}
+	}
+}
+
+func TestReadFile(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "archive-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	entries := []testEntry{
+		{name: "docs/guide.md", content: "# Guide\n"},
+		{name: "./specs/pets.yaml", content: "openapi: 3.0.0\n"},
+		{name: "specs/pets.yaml", content: "swagger: \"2.0\"\n"},
+		{name: "large.json", content: strings.Repeat("x", 64)},
+	}
+	zipPath := filepath.Join(tempDir, "specs.zip")
+	writeZip(t, zipPath, entries)
+	tarPath := filepath.Join(tempDir, "specs.tar.gz")
+	writeTarGz(t, tarPath, entries)
+
+	for _, path := range []string{zipPath, tarPath} {
+		t.Run(filepath.Base(path), func(t *testing.T) {
+			limits := Limits{MaxFileSize: 32}
+			content, err := ReadFile(path, "specs/pets.yaml", limits)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if string(content) != "openapi: 3.0.0\n" {
+				t.Errorf("Expected the first file with the path, got %q", string(content))
+			}
+
+			if _, err := ReadFile(path, "specs/missing.yaml", limits); err == nil {
+				t.Error("Expected error for missing file")
+			}
+			if _, err := ReadFile(path, "large.json", limits); err == nil || !strings.Contains(err.Error(), "size limit") {
+				t.Errorf("Expected size limit error for large file, got %v", err)
+			}
+			if _, err := ReadFile(path, "large.json", Limits{MaxEntries: 3}); err == nil || !strings.Contains(err.Error(), "more than 3 files") {
+				t.Errorf("Expected entries limit error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestIsArchive(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected bool
+	}{
+		{"/specs/api-specs.zip", true},
+		{"/specs/API-SPECS.ZIP", true},
+		{"/specs/api-specs.tar", true},
+		{"/specs/api-specs.tar.gz", true},
+		{"/specs/api-specs.tgz", true},
+		{"/specs/schema.gz", false},
+		{"/specs/api.yaml", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if result := IsArchive(tt.path); result != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
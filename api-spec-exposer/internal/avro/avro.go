@@ -0,0 +1,259 @@
+package avro
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Kinds of Avro documents, the type of a named schema or a protocol
+const (
+	KindRecord   = "record"
+	KindError    = "error"
+	KindEnum     = "enum"
+	KindFixed    = "fixed"
+	KindProtocol = "protocol"
+)
+
+// Schema describes an Avro schema or protocol
+type Schema struct {
+	Kind       string
+	Name       string // fully qualified name
+	Doc        string
+	NamedTypes int // records, errors, enums and fixed types declared in the document
+	Messages   int // messages of a protocol
+}
+
+var (
+	idlNamespacePattern   = regexp.MustCompile(`@namespace\s*\(\s*"([^"]*)"\s*\)`)
+	idlProtocolPattern    = regexp.MustCompile(`\bprotocol\s+([A-Za-z_][A-Za-z0-9_]*)\s*\{`)
+	idlNamespaceStatement = regexp.MustCompile(`(?m)^\s*namespace\s+([A-Za-z_][A-Za-z0-9_.]*)\s*;`)
+	idlSchemaStatement    = regexp.MustCompile(`(?m)^\s*schema\s+([A-Za-z_][A-Za-z0-9_.]*)\s*;`)
+	idlAnnotationPattern  = regexp.MustCompile(`@[A-Za-z_][A-Za-z0-9_.-]*\s*\(\s*(?:"[^"]*"|[^()"])*\)`)
+	idlNamedTypePattern   = regexp.MustCompile(`^(record|error|enum|fixed)\s+([A-Za-z_][A-Za-z0-9_]*)`)
+)
+
+// FromJSON returns the description of a decoded .avsc schema or .avpr protocol,
+// nil if the document is neither a named schema nor a protocol
+func FromJSON(data interface{}) *Schema {
+	object, ok := data.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if protocol, ok := object["protocol"].(string); ok && protocol != "" {
+		namespace, _ := object["namespace"].(string)
+		schema := &Schema{Kind: KindProtocol, Name: fullName(protocol, namespace)}
+		schema.Doc, _ = object["doc"].(string)
+		if types, ok := object["types"].([]interface{}); ok {
+			for _, item := range types {
+				schema.NamedTypes += countNamedTypes(item)
+			}
+		}
+		if messages, ok := object["messages"].(map[string]interface{}); ok {
+			schema.Messages = len(messages)
+		}
+		return schema
+	}
+
+	kind, _ := object["type"].(string)
+	name, _ := object["name"].(string)
+	if !isNamedKind(kind) || name == "" {
+		return nil
+	}
+	namespace, _ := object["namespace"].(string)
+	schema := &Schema{Kind: kind, Name: fullName(name, namespace), NamedTypes: countNamedTypes(object)}
+	schema.Doc, _ = object["doc"].(string)
+	return schema
+}
+
+// ParseIDL reads an Avro IDL (.avdl) document declaring a protocol or, since Avro 1.12, a main schema
+func ParseIDL(content string) (*Schema, error) {
+	text, err := stripComments(content)
+	if err != nil {
+		return nil, err
+	}
+
+	var schema *Schema
+	body := text
+	if match := idlProtocolPattern.FindStringSubmatchIndex(text); match != nil {
+		namespace := ""
+		if ns := idlNamespacePattern.FindStringSubmatch(text[:match[0]]); ns != nil {
+			namespace = ns[1]
+		}
+		schema = &Schema{Kind: KindProtocol, Name: fullName(text[match[2]:match[3]], namespace)}
+		body = text[match[1]:]
+	} else if match := idlSchemaStatement.FindStringSubmatch(text); match != nil {
+		namespace := ""
+		if ns := idlNamespaceStatement.FindStringSubmatch(text); ns != nil {
+			namespace = ns[1]
+		}
+		schema = &Schema{Name: fullName(match[1], namespace)}
+	} else {
+		return nil, fmt.Errorf("neither a protocol nor a schema declaration found")
+	}
+
+	// Only declarations at the top level of the protocol (or of the file) are counted,
+	// string literals are blanked and annotations removed so that they do not disturb the nesting
+	body = idlAnnotationPattern.ReplaceAllString(blankStrings(body), " ")
+	depth := 0
+	statement := strings.Builder{}
+	for _, r := range body {
+		switch r {
+		case '{':
+			if depth == 0 {
+				if match := idlNamedTypePattern.FindStringSubmatch(strings.TrimSpace(statement.String())); match != nil {
+					schema.NamedTypes++
+					if schema.Kind == "" && fullName(match[2], namespaceOf(schema.Name)) == schema.Name {
+						schema.Kind = match[1]
+					}
+				}
+			}
+			depth++
+			statement.Reset()
+		case '}':
+			if depth == 0 {
+				// The closing brace of the protocol
+				return schema, nil
+			}
+			depth--
+			statement.Reset()
+		case ';':
+			if depth == 0 {
+				declaration := strings.TrimSpace(statement.String())
+				if idlNamedTypePattern.MatchString(declaration) {
+					schema.NamedTypes++
+				} else if schema.Kind == KindProtocol && strings.Contains(declaration, "(") && !strings.HasPrefix(declaration, "import ") {
+					schema.Messages++
+				}
+			}
+			statement.Reset()
+		default:
+			if depth == 0 {
+				statement.WriteRune(r)
+			}
+		}
+	}
+
+	if schema.Kind == KindProtocol {
+		return nil, fmt.Errorf("closing '}' of protocol %s is missing", schema.Name)
+	}
+	return schema, nil
+}
+
+// countNamedTypes counts the named types defined by a schema, including the nested definitions
+func countNamedTypes(schema interface{}) int {
+	switch value := schema.(type) {
+	case []interface{}:
+		count := 0
+		for _, item := range value {
+			count += countNamedTypes(item)
+		}
+		return count
+	case map[string]interface{}:
+		count := 0
+		kind, _ := value["type"].(string)
+		if isNamedKind(kind) {
+			count++
+		} else {
+			// Complex or annotated types, e.g. {"type": {"type": "record", ...}}
+			count += countNamedTypes(value["type"])
+		}
+		if fields, ok := value["fields"].([]interface{}); ok {
+			for _, item := range fields {
+				if field, ok := item.(map[string]interface{}); ok {
+					count += countNamedTypes(field["type"])
+				}
+			}
+		}
+		count += countNamedTypes(value["items"])
+		count += countNamedTypes(value["values"])
+		return count
+	default:
+		return 0
+	}
+}
+
+func isNamedKind(kind string) bool {
+	return kind == KindRecord || kind == KindError || kind == KindEnum || kind == KindFixed
+}
+
+// fullName qualifies a name with a namespace unless the name already contains one
+func fullName(name string, namespace string) string {
+	if namespace == "" || strings.Contains(name, ".") {
+		return name
+	}
+	return namespace + "." + name
+}
+
+func namespaceOf(name string) string {
+	if index := strings.LastIndex(name, "."); index >= 0 {
+		return name[:index]
+	}
+	return ""
+}
+
+// stripComments removes line and block comments outside string literals
+func stripComments(content string) (string, error) {
+	var result strings.Builder
+	inString := false
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+		switch {
+		case inString:
+			if c == '\\' && i+1 < len(content) {
+				result.WriteByte(c)
+				i++
+				c = content[i]
+			} else if c == '"' {
+				inString = false
+			}
+		case c == '"':
+			inString = true
+		case c == '/' && i+1 < len(content) && content[i+1] == '/':
+			for i < len(content) && content[i] != '\n' {
+				i++
+			}
+			if i < len(content) {
+				result.WriteByte('\n')
+			}
+			continue
+		case c == '/' && i+1 < len(content) && content[i+1] == '*':
+			end := strings.Index(content[i+2:], "*/")
+			if end < 0 {
+				return "", fmt.Errorf("unterminated comment")
+			}
+			i += end + 3
+			result.WriteByte(' ')
+			continue
+		}
+		result.WriteByte(c)
+	}
+	if inString {
+		return "", fmt.Errorf("unterminated string literal")
+	}
+	return result.String(), nil
+}
+
+// blankStrings replaces the content of string literals with nothing, keeping the quotes
+func blankStrings(content string) string {
+	var result strings.Builder
+	inString := false
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+		if inString {
+			if c == '\\' {
+				i++
+			} else if c == '"' {
+				inString = false
+				result.WriteByte(c)
+			}
+			continue
+		}
+		if c == '"' {
+			inString = true
+		}
+		result.WriteByte(c)
+	}
+	return result.String()
+}
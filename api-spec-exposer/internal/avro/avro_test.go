@@ -0,0 +1,168 @@
+package avro
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFromJSON(t *testing.T) {
+	tests := []struct {
+		name               string
+		content            string
+		expectedKind       string
+		expectedName       string
+		expectedNamedTypes int
+		expectedMessages   int
+		expectedNil        bool
+	}{
+		{
+			name: "record with nested types",
+			content: `{"type": "record", "name": "OrderCreated", "namespace": "com.example.orders", "doc": "Order event",
+				"fields": [
+					{"name": "id", "type": "string"},
+					{"name": "status", "type": {"type": "enum", "name": "Status", "symbols": ["NEW", "PAID"]}},
+					{"name": "items", "type": {"type": "array", "items": {"type": "record", "name": "Item", "fields": []}}},
+					{"name": "hash", "type": ["null", {"type": "fixed", "name": "MD5", "size": 16}]}
+				]}`,
+			expectedKind:       KindRecord,
+			expectedName:       "com.example.orders.OrderCreated",
+			expectedNamedTypes: 4,
+		},
+		{
+			name:               "enum with qualified name",
+			content:            `{"type": "enum", "name": "com.example.Color", "namespace": "ignored", "symbols": ["RED"]}`,
+			expectedKind:       KindEnum,
+			expectedName:       "com.example.Color",
+			expectedNamedTypes: 1,
+		},
+		{
+			name: "protocol",
+			content: `{"protocol": "Orders", "namespace": "com.example", "doc": "Order service",
+				"types": [{"type": "record", "name": "Order", "fields": []}, {"type": "error", "name": "NotFound", "fields": []}],
+				"messages": {"get": {"request": [], "response": "Order"}, "cancel": {"request": [], "response": "null"}}}`,
+			expectedKind:       KindProtocol,
+			expectedName:       "com.example.Orders",
+			expectedNamedTypes: 2,
+			expectedMessages:   2,
+		},
+		{name: "primitive", content: `"string"`, expectedNil: true},
+		{name: "union", content: `["null", "string"]`, expectedNil: true},
+		{name: "array", content: `{"type": "array", "items": "string"}`, expectedNil: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var data interface{}
+			if err := json.Unmarshal([]byte(tt.content), &data); err != nil {
+				t.Fatalf("Failed to parse test content: %v", err)
+			}
+
+			schema := FromJSON(data)
+			if tt.expectedNil {
+				if schema != nil {
+					t.Errorf("Expected no schema, got %+v", schema)
+				}
+				return
+			}
+			if schema == nil {
+				t.Fatal("Expected schema")
+			}
+			if schema.Kind != tt.expectedKind || schema.Name != tt.expectedName {
+				t.Errorf("Expected %s %s, got %s %s", tt.expectedKind, tt.expectedName, schema.Kind, schema.Name)
+			}
+			if schema.NamedTypes != tt.expectedNamedTypes {
+				t.Errorf("Expected %d named types, got %d", tt.expectedNamedTypes, schema.NamedTypes)
+			}
+			if schema.Messages != tt.expectedMessages {
+				t.Errorf("Expected %d messages, got %d", tt.expectedMessages, schema.Messages)
+			}
+		})
+	}
+}
+
+func TestParseIDL(t *testing.T) {
+	tests := []struct {
+		name               string
+		content            string
+		expectedKind       string
+		expectedName       string
+		expectedNamedTypes int
+		expectedMessages   int
+		expectedError      bool
+	}{
+		{
+			name: "protocol",
+			content: `/**
+ * Order service, see https://example.com/orders {docs}
+ */
+@namespace("com.example.orders")
+protocol OrderService {
+  import idl "common.avdl";
+
+  // Order state; not a message()
+  enum Status { NEW, PAID }
+
+  fixed MD5(16);
+
+  record Order {
+    string id;
+    @java-class("java.math.BigDecimal") string amount = "0;{";
+    Status status = "NEW";
+  }
+
+  error NotFound { string message; }
+
+  Order get(string id) throws NotFound;
+  @deprecated("use cancel()") void remove(string id);
+  void ping() oneway;
+}
+`,
+			expectedKind:       KindProtocol,
+			expectedName:       "com.example.orders.OrderService",
+			expectedNamedTypes: 4,
+			expectedMessages:   3,
+		},
+		{
+			name: "main schema",
+			content: `namespace com.example.events;
+schema OrderCreated;
+
+record OrderCreated {
+  string id;
+}
+
+enum Channel { WEB, STORE }
+`,
+			expectedKind:       KindRecord,
+			expectedName:       "com.example.events.OrderCreated",
+			expectedNamedTypes: 2,
+		},
+		{name: "no declaration", content: "record Order { string id; }", expectedError: true},
+		{name: "unclosed protocol", content: "protocol Orders { record Order { string id; }", expectedError: true},
+		{name: "unterminated comment", content: "protocol Orders { /* }", expectedError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schema, err := ParseIDL(tt.content)
+			if tt.expectedError {
+				if err == nil {
+					t.Errorf("Expected error, got %+v", schema)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if schema.Kind != tt.expectedKind || schema.Name != tt.expectedName {
+				t.Errorf("Expected %s %s, got %s %s", tt.expectedKind, tt.expectedName, schema.Kind, schema.Name)
+			}
+			if schema.NamedTypes != tt.expectedNamedTypes {
+				t.Errorf("Expected %d named types, got %d", tt.expectedNamedTypes, schema.NamedTypes)
+			}
+			if schema.Messages != tt.expectedMessages {
+				t.Errorf("Expected %d messages, got %d", tt.expectedMessages, schema.Messages)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package charset
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"regexp"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// Encodings of content converted to UTF-8
+const (
+	UTF8BOM = "UTF-8 with BOM"
+	UTF16LE = "UTF-16LE"
+	UTF16BE = "UTF-16BE"
+	Latin1  = "ISO-8859-1"
+)
+
+var (
+	utf8BOM    = []byte{0xef, 0xbb, 0xbf}
+	utf16LEBOM = []byte{0xff, 0xfe}
+	utf16BEBOM = []byte{0xfe, 0xff}
+
+	xmlEncodingPattern = regexp.MustCompile(`^(<\?xml[^>]*?\sencoding\s*=\s*["'])[^"']*(["'])`)
+)
+
+// maximum number of bytes inspected to detect UTF-16 content without a byte order mark
+const sampleSize = 1024
+
+// Detect returns the encoding of text content that is not plain UTF-8: UTF8BOM, UTF16LE or UTF16BE for content
+// starting with a byte order mark or UTF-16 text without one in which every other byte is zero (ASCII characters),
+// Latin1 for content without zero bytes that is not valid UTF-8. An empty string is returned for UTF-8 content
+// without a byte order mark and for binary data
+func Detect(content []byte) string {
+	switch {
+	case bytes.HasPrefix(content, utf8BOM):
+		return UTF8BOM
+	case bytes.HasPrefix(content, utf16LEBOM):
+		return UTF16LE
+	case bytes.HasPrefix(content, utf16BEBOM):
+		return UTF16BE
+	}
+
+	if encoding := detectUTF16(content); encoding != "" {
+		return encoding
+	}
+	if utf8.Valid(content) || bytes.IndexByte(content, 0) >= 0 {
+		return ""
+	}
+	return Latin1
+}
+
+// Decode converts content of an encoding returned by Detect to UTF-8 without a byte order mark.
+// The encoding declaration of an XML document is changed to UTF-8 as well
+func Decode(content []byte, encoding string) ([]byte, error) {
+	var result []byte
+	switch encoding {
+	case "":
+		return content, nil
+	case UTF8BOM:
+		result = bytes.TrimPrefix(content, utf8BOM)
+	case UTF16LE, UTF16BE:
+		var order binary.ByteOrder = binary.LittleEndian
+		bom := utf16LEBOM
+		if encoding == UTF16BE {
+			order, bom = binary.BigEndian, utf16BEBOM
+		}
+		content = bytes.TrimPrefix(content, bom)
+		if len(content)%2 != 0 {
+			return nil, fmt.Errorf("%s content has an odd number of bytes", encoding)
+		}
+		units := make([]uint16, len(content)/2)
+		for i := range units {
+			units[i] = order.Uint16(content[2*i:])
+		}
+		result = []byte(string(utf16.Decode(units)))
+	case Latin1:
+		// Latin-1 bytes are the code points U+0000 to U+00FF
+		runes := make([]rune, len(content))
+		for i, b := range content {
+			runes[i] = rune(b)
+		}
+		result = []byte(string(runes))
+	default:
+		return nil, fmt.Errorf("unsupported encoding '%s'", encoding)
+	}
+
+	return xmlEncodingPattern.ReplaceAll(result, []byte("${1}UTF-8${2}")), nil
+}
+
+// Normalize converts content to UTF-8 and returns it along with its original encoding, see Detect.
+// Content that cannot be converted is returned unchanged with an empty encoding
+func Normalize(content []byte) ([]byte, string) {
+	encoding := Detect(content)
+	if encoding == "" {
+		return content, ""
+	}
+	result, err := Decode(content, encoding)
+	if err != nil {
+		return content, ""
+	}
+	return result, encoding
+}
+
+// detectUTF16 recognizes UTF-16 text without a byte order mark by the zero bytes of ASCII characters
+func detectUTF16(content []byte) string {
+	if len(content) < 2 || len(content)%2 != 0 {
+		return ""
+	}
+	sample := content
+	if len(sample) > sampleSize {
+		sample = sample[:sampleSize]
+	}
+
+	evenZeros, oddZeros := 0, 0
+	for i := 0; i+1 < len(sample); i += 2 {
+		if sample[i] == 0 {
+			evenZeros++
+		}
+		if sample[i+1] == 0 {
+			oddZeros++
+		}
+	}
+	units := len(sample) / 2
+	switch {
+	case evenZeros == 0 && oddZeros*10 >= units*7:
+		return UTF16LE
+	case oddZeros == 0 && evenZeros*10 >= units*7:
+		return UTF16BE
+	}
+	return ""
+}
@@ -0,0 +1,104 @@
+package charset
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf16"
+)
+
+func utf16Bytes(text string, bigEndian bool) []byte {
+	var result []byte
+	for _, unit := range utf16.Encode([]rune(text)) {
+		if bigEndian {
+			result = append(result, byte(unit>>8), byte(unit))
+		} else {
+			result = append(result, byte(unit), byte(unit>>8))
+		}
+	}
+	return result
+}
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  []byte
+		expected string
+	}{
+		{"utf-8", []byte("openapi: 3.0.0\ntitle: Café\n"), ""},
+		{"utf-8 with bom", []byte("\xef\xbb\xbfopenapi: 3.0.0\n"), UTF8BOM},
+		{"utf-16le with bom", append([]byte{0xff, 0xfe}, utf16Bytes(`{"openapi": "3.0.0"}`, false)...), UTF16LE},
+		{"utf-16be with bom", append([]byte{0xfe, 0xff}, utf16Bytes(`{"openapi": "3.0.0"}`, true)...), UTF16BE},
+		{"utf-16le without bom", utf16Bytes(`{"openapi": "3.0.0"}`, false), UTF16LE},
+		{"utf-16be without bom", utf16Bytes(`{"openapi": "3.0.0"}`, true), UTF16BE},
+		{"latin-1", []byte("# Caf\xe9\n"), Latin1},
+		{"binary", []byte{0x89, 'P', 'N', 'G', 0x00, 0x00, 0x1a, 0x0a}, ""},
+		{"empty", nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := Detect(tt.content); result != tt.expected {
+				t.Errorf("Expected '%s', got '%s'", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  []byte
+		encoding string
+		expected string
+		wantErr  bool
+	}{
+		{"utf-8", []byte("title: Café"), "", "title: Café", false},
+		{"utf-8 with bom", []byte("\xef\xbb\xbftitle: Café"), UTF8BOM, "title: Café", false},
+		{"utf-16le", append([]byte{0xff, 0xfe}, utf16Bytes("title: Café 😀", false)...), UTF16LE, "title: Café \U0001F600", false},
+		{"utf-16be", utf16Bytes("title: Café", true), UTF16BE, "title: Café", false},
+		{"latin-1", []byte("title: Caf\xe9"), Latin1, "title: Café", false},
+		{
+			"xml declaration",
+			[]byte("<?xml version=\"1.0\" encoding=\"ISO-8859-1\"?>\n<definitions name='Caf\xe9'/>"),
+			Latin1,
+			"<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<definitions name='Café'/>",
+			false,
+		},
+		{"odd utf-16 length", []byte{'a', 0, 'b'}, UTF16LE, "", true},
+		{"unsupported encoding", []byte("title"), "EBCDIC", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Decode(tt.content, tt.encoding)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			if string(result) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(result))
+			}
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	content := append([]byte{0xff, 0xfe}, utf16Bytes(`<?xml version="1.0" encoding="UTF-16"?><root/>`, false)...)
+	result, encoding := Normalize(content)
+	if encoding != UTF16LE {
+		t.Errorf("Expected encoding '%s', got '%s'", UTF16LE, encoding)
+	}
+	if string(result) != `<?xml version="1.0" encoding="UTF-8"?><root/>` {
+		t.Errorf("Unexpected content %q", string(result))
+	}
+
+	plain := []byte("openapi: 3.0.0\n")
+	if result, encoding := Normalize(plain); encoding != "" || string(result) != string(plain) {
+		t.Errorf("Expected UTF-8 content unchanged, got %q in '%s'", string(result), encoding)
+	}
+
+	// a UTF-16 byte order mark followed by an odd number of bytes cannot be converted
+	broken := []byte{0xff, 0xfe, 'a', 0, 'b'}
+	if result, encoding := Normalize(broken); encoding != "" || !strings.HasPrefix(string(result), "\xff\xfe") {
+		t.Errorf("Expected unconvertible content unchanged, got %q in '%s'", string(result), encoding)
+	}
+}
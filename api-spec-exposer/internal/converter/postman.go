@@ -0,0 +1,460 @@
+package converter
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/document"
+)
+
+const postmanCollectionSchema = "https://schema.getpostman.com/json/collection/v2.1.0/collection.json"
+
+// maximum nesting of schemas in generated example values, stops recursive schemas
+const maxExampleDepth = 8
+
+// OpenAPIToPostman converts an OpenAPI 3.x document (Swagger 2.0 documents are converted to OpenAPI 3.0 first)
+// into a Postman v2.1 collection with an example request for every operation. Requests are grouped into folders
+// by their first tag and use the '{{baseUrl}}' collection variable set to the first server URL
+func OpenAPIToPostman(doc map[string]interface{}) (map[string]interface{}, error) {
+	if version, _ := doc["swagger"].(string); strings.HasPrefix(version, "2.") {
+		converted, err := Swagger20ToOpenAPI30(doc)
+		if err != nil {
+			return nil, err
+		}
+		doc = converted
+	}
+	if version, _ := doc["openapi"].(string); !strings.HasPrefix(version, "3.") {
+		return nil, fmt.Errorf("document is not an OpenAPI 3.x specification")
+	}
+
+	c := &postmanConverter{source: doc}
+	return c.convert(), nil
+}
+
+type postmanConverter struct {
+	source map[string]interface{}
+}
+
+func (c *postmanConverter) convert() map[string]interface{} {
+	info, _ := c.source["info"].(map[string]interface{})
+	collectionInfo := map[string]interface{}{
+		"name":   "API",
+		"schema": postmanCollectionSchema,
+	}
+	if title, _ := info["title"].(string); title != "" {
+		collectionInfo["name"] = title
+	}
+	if description, _ := info["description"].(string); description != "" {
+		collectionInfo["description"] = description
+	}
+
+	// Folders follow the order of the root 'tags' list, then the order in which tags are first used
+	var folderNames []string
+	folderDescriptions := make(map[string]string)
+	for _, item := range listOf(c.source["tags"]) {
+		if tag, ok := item.(map[string]interface{}); ok {
+			if name, _ := tag["name"].(string); name != "" {
+				folderNames = append(folderNames, name)
+				folderDescriptions[name], _ = tag["description"].(string)
+			}
+		}
+	}
+
+	var items []interface{}
+	folders := make(map[string][]interface{})
+	paths, _ := c.source["paths"].(map[string]interface{})
+	for _, path := range sortedMapKeys(paths) {
+		pathItem, ok := paths[path].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, method := range document.OperationMethods {
+			operation, ok := pathItem[method].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			item := c.convertOperation(path, method, operation, listOf(pathItem["parameters"]))
+
+			tags := stringList(operation["tags"])
+			if len(tags) == 0 {
+				items = append(items, item)
+				continue
+			}
+			if _, ok := folders[tags[0]]; !ok && !containsName(folderNames, tags[0]) {
+				folderNames = append(folderNames, tags[0])
+			}
+			folders[tags[0]] = append(folders[tags[0]], item)
+		}
+	}
+
+	var result []interface{}
+	for _, name := range folderNames {
+		if len(folders[name]) == 0 {
+			continue
+		}
+		folder := map[string]interface{}{"name": name, "item": folders[name]}
+		if description := folderDescriptions[name]; description != "" {
+			folder["description"] = description
+		}
+		result = append(result, folder)
+	}
+	result = append(result, items...)
+	if result == nil {
+		result = []interface{}{}
+	}
+
+	return map[string]interface{}{
+		"info": collectionInfo,
+		"item": result,
+		"variable": []interface{}{
+			map[string]interface{}{"key": "baseUrl", "value": c.baseURL()},
+		},
+	}
+}
+
+// baseURL returns the first server URL with its variables replaced by their default values
+func (c *postmanConverter) baseURL() string {
+	servers := listOf(c.source["servers"])
+	if len(servers) == 0 {
+		return ""
+	}
+	server, ok := servers[0].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	serverURL, _ := server["url"].(string)
+	variables, _ := server["variables"].(map[string]interface{})
+	for name, value := range variables {
+		if variable, ok := value.(map[string]interface{}); ok {
+			serverURL = strings.ReplaceAll(serverURL, "{"+name+"}", fmt.Sprint(variable["default"]))
+		}
+	}
+	return strings.TrimSuffix(serverURL, "/")
+}
+
+func (c *postmanConverter) convertOperation(path string, method string, operation map[string]interface{}, inheritedParameters []interface{}) map[string]interface{} {
+	name, _ := operation["summary"].(string)
+	if name == "" {
+		name, _ = operation["operationId"].(string)
+	}
+	if name == "" {
+		name = fmt.Sprintf("%s %s", strings.ToUpper(method), path)
+	}
+
+	var segments []interface{}
+	for _, segment := range strings.Split(strings.Trim(path, "/"), "/") {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			segment = ":" + segment[1:len(segment)-1]
+		}
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+
+	var headers, query, variables []interface{}
+	var rawQuery []string
+	for _, param := range c.parameters(inheritedParameters, listOf(operation["parameters"])) {
+		paramName, _ := param["name"].(string)
+		entry := map[string]interface{}{"key": paramName, "value": c.parameterExample(param)}
+		if description, _ := param["description"].(string); description != "" {
+			entry["description"] = description
+		}
+		required, _ := param["required"].(bool)
+		switch param["in"] {
+		case "path":
+			variables = append(variables, entry)
+		case "query":
+			if !required {
+				entry["disabled"] = true
+			} else {
+				rawQuery = append(rawQuery, fmt.Sprintf("%s=%s", paramName, entry["value"]))
+			}
+			query = append(query, entry)
+		case "header":
+			headers = append(headers, entry)
+		}
+	}
+
+	raw := "{{baseUrl}}"
+	for _, segment := range segments {
+		raw += "/" + segment.(string)
+	}
+	if len(rawQuery) > 0 {
+		raw += "?" + strings.Join(rawQuery, "&")
+	}
+
+	requestURL := map[string]interface{}{
+		"raw":  raw,
+		"host": []interface{}{"{{baseUrl}}"},
+		"path": nonNil(segments),
+	}
+	if len(query) > 0 {
+		requestURL["query"] = query
+	}
+	if len(variables) > 0 {
+		requestURL["variable"] = variables
+	}
+
+	request := map[string]interface{}{
+		"method": strings.ToUpper(method),
+		"url":    requestURL,
+	}
+	if body, mediaType := c.requestBody(operation["requestBody"]); body != nil {
+		headers = append(headers, map[string]interface{}{"key": "Content-Type", "value": mediaType})
+		request["body"] = body
+	}
+	request["header"] = nonNil(headers)
+	if description, _ := operation["description"].(string); description != "" {
+		request["description"] = description
+	}
+
+	return map[string]interface{}{
+		"name":     name,
+		"request":  request,
+		"response": []interface{}{},
+	}
+}
+
+// parameters resolves the operation parameters, which override the path item parameters with the same name and location
+func (c *postmanConverter) parameters(inherited []interface{}, own []interface{}) []map[string]interface{} {
+	var result []map[string]interface{}
+	index := make(map[string]int)
+	for _, item := range append(append([]interface{}{}, inherited...), own...) {
+		param, ok := c.resolve(item).(map[string]interface{})
+		if !ok {
+			continue
+		}
+		key := fmt.Sprintf("%v:%v", param["in"], param["name"])
+		if i, ok := index[key]; ok {
+			result[i] = param
+			continue
+		}
+		index[key] = len(result)
+		result = append(result, param)
+	}
+	return result
+}
+
+func (c *postmanConverter) parameterExample(param map[string]interface{}) string {
+	value, ok := param["example"]
+	if !ok {
+		value, ok = firstExample(param["examples"])
+	}
+	if !ok {
+		value = c.example(param["schema"], 0)
+	}
+
+	switch v := value.(type) {
+	case string:
+		return v
+	case []interface{}:
+		parts := make([]string, len(v))
+		for i, item := range v {
+			parts[i] = fmt.Sprint(item)
+		}
+		return strings.Join(parts, ",")
+	case map[string]interface{}:
+		content, _ := json.Marshal(v)
+		return string(content)
+	case nil:
+		return ""
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// requestBody returns the Postman body of the preferred media type of a request body and the media type
+func (c *postmanConverter) requestBody(value interface{}) (map[string]interface{}, string) {
+	body, ok := c.resolve(value).(map[string]interface{})
+	if !ok {
+		return nil, ""
+	}
+	content, ok := body["content"].(map[string]interface{})
+	if !ok || len(content) == 0 {
+		return nil, ""
+	}
+
+	mediaTypes := sortedMapKeys(content)
+	mediaType := mediaTypes[0]
+	for _, candidate := range mediaTypes {
+		if candidate == defaultMediaType {
+			mediaType = candidate
+			break
+		}
+	}
+	media, _ := content[mediaType].(map[string]interface{})
+
+	example, ok := media["example"]
+	if !ok {
+		example, ok = firstExample(media["examples"])
+	}
+	if !ok {
+		example = c.example(media["schema"], 0)
+	}
+
+	switch {
+	case mediaType == "application/x-www-form-urlencoded" || strings.HasPrefix(mediaType, "multipart/"):
+		mode := "urlencoded"
+		if strings.HasPrefix(mediaType, "multipart/") {
+			mode = "formdata"
+		}
+		var fields []interface{}
+		if object, ok := example.(map[string]interface{}); ok {
+			for _, key := range sortedMapKeys(object) {
+				field := map[string]interface{}{"key": key, "value": c.parameterExample(map[string]interface{}{"example": object[key]})}
+				if mode == "formdata" {
+					field["type"] = "text"
+				}
+				fields = append(fields, field)
+			}
+		}
+		return map[string]interface{}{"mode": mode, mode: nonNil(fields)}, mediaType
+	case strings.Contains(mediaType, "json"):
+		raw, _ := json.MarshalIndent(example, "", "  ")
+		return map[string]interface{}{
+			"mode":    "raw",
+			"raw":     string(raw),
+			"options": map[string]interface{}{"raw": map[string]interface{}{"language": "json"}},
+		}, mediaType
+	default:
+		raw, _ := example.(string)
+		return map[string]interface{}{"mode": "raw", "raw": raw}, mediaType
+	}
+}
+
+// example returns an example value of a schema: its 'example', 'default' or first 'enum' value,
+// otherwise a value built from the schema structure with '<type>' placeholders for strings
+func (c *postmanConverter) example(value interface{}, depth int) interface{} {
+	schema, ok := c.resolve(value).(map[string]interface{})
+	if !ok || depth > maxExampleDepth {
+		return nil
+	}
+	if example, ok := schema["example"]; ok {
+		return example
+	}
+	if examples := listOf(schema["examples"]); len(examples) > 0 {
+		return examples[0]
+	}
+	if defaultValue, ok := schema["default"]; ok {
+		return defaultValue
+	}
+	if values := listOf(schema["enum"]); len(values) > 0 {
+		return values[0]
+	}
+
+	if parts := listOf(schema["allOf"]); len(parts) > 0 {
+		merged := make(map[string]interface{})
+		for _, part := range parts {
+			if object, ok := c.example(part, depth+1).(map[string]interface{}); ok {
+				for key, item := range object {
+					merged[key] = item
+				}
+			}
+		}
+		return merged
+	}
+	for _, keyword := range []string{"oneOf", "anyOf"} {
+		if options := listOf(schema[keyword]); len(options) > 0 {
+			return c.example(options[0], depth+1)
+		}
+	}
+
+	schemaType, _ := schema["type"].(string)
+	if types, ok := schema["type"].([]interface{}); ok && len(types) > 0 {
+		// OpenAPI 3.1 type lists, e.g. ["string", "null"]
+		schemaType, _ = types[0].(string)
+	}
+	switch {
+	case schemaType == "object" || (schemaType == "" && schema["properties"] != nil):
+		result := make(map[string]interface{})
+		properties, _ := schema["properties"].(map[string]interface{})
+		for name, property := range properties {
+			result[name] = c.example(property, depth+1)
+		}
+		return result
+	case schemaType == "array":
+		if item := c.example(schema["items"], depth+1); item != nil {
+			return []interface{}{item}
+		}
+		return []interface{}{}
+	case schemaType == "integer" || schemaType == "number":
+		return 0
+	case schemaType == "boolean":
+		return true
+	case schemaType == "string":
+		if format, _ := schema["format"].(string); format != "" {
+			return fmt.Sprintf("<%s>", format)
+		}
+		return "<string>"
+	}
+	return nil
+}
+
+// resolve follows local '$ref's to the components of the document
+func (c *postmanConverter) resolve(value interface{}) interface{} {
+	for i := 0; i < maxExampleDepth; i++ {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return value
+		}
+		ref, ok := object["$ref"].(string)
+		if !ok {
+			return value
+		}
+		if !strings.HasPrefix(ref, "#/") {
+			return nil
+		}
+		var current interface{} = c.source
+		for _, token := range strings.Split(ref[2:], "/") {
+			token, _ = url.PathUnescape(strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~"))
+			node, ok := current.(map[string]interface{})
+			if !ok {
+				return nil
+			}
+			current = node[token]
+		}
+		value = current
+	}
+	return nil
+}
+
+func firstExample(value interface{}) (interface{}, bool) {
+	examples, ok := value.(map[string]interface{})
+	if !ok || len(examples) == 0 {
+		return nil, false
+	}
+	example, ok := examples[sortedMapKeys(examples)[0]].(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+	result, ok := example["value"]
+	return result, ok
+}
+
+func sortedMapKeys(data map[string]interface{}) []string {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func containsName(names []string, name string) bool {
+	for _, item := range names {
+		if item == name {
+			return true
+		}
+	}
+	return false
+}
+
+func nonNil(items []interface{}) []interface{} {
+	if items == nil {
+		return []interface{}{}
+	}
+	return items
+}
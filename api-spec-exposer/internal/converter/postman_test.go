@@ -0,0 +1,229 @@
+package converter
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestOpenAPIToPostmanNotOpenAPI(t *testing.T) {
+	doc := parseDocument(t, `{"asyncapi": "2.6.0", "info": {"title": "Events", "version": "1.0"}}`)
+
+	_, err := OpenAPIToPostman(doc)
+	if err == nil {
+		t.Fatal("Expected error for non-OpenAPI document")
+	}
+}
+
+func TestOpenAPIToPostmanCollection(t *testing.T) {
+	doc := parseDocument(t, `{
+		"openapi": "3.0.3",
+		"info": {"title": "Pet Store", "version": "1.0", "description": "Pets"},
+		"servers": [{"url": "https://{region}.example.com/v1/", "variables": {"region": {"default": "eu"}}}],
+		"tags": [{"name": "pets", "description": "Pet operations"}],
+		"paths": {
+			"/pets/{petId}": {
+				"parameters": [{"name": "petId", "in": "path", "required": true, "schema": {"type": "integer"}, "example": 42}],
+				"get": {
+					"summary": "Get pet",
+					"tags": ["pets"],
+					"parameters": [
+						{"name": "fields", "in": "query", "schema": {"type": "array", "items": {"type": "string"}}, "example": ["id", "name"]},
+						{"name": "lang", "in": "query", "required": true, "schema": {"type": "string", "enum": ["en", "de"]}},
+						{"$ref": "#/components/parameters/RequestId"}
+					]
+				},
+				"put": {
+					"operationId": "updatePet",
+					"tags": ["pets"],
+					"requestBody": {"$ref": "#/components/requestBodies/Pet"}
+				}
+			},
+			"/health": {
+				"get": {}
+			}
+		},
+		"components": {
+			"parameters": {
+				"RequestId": {"name": "X-Request-Id", "in": "header", "schema": {"type": "string", "format": "uuid"}}
+			},
+			"requestBodies": {
+				"Pet": {"content": {"application/json": {"schema": {"$ref": "#/components/schemas/Pet"}}}}
+			},
+			"schemas": {
+				"Pet": {
+					"type": "object",
+					"properties": {
+						"name": {"type": "string", "example": "Rex"},
+						"tags": {"type": "array", "items": {"type": "string"}},
+						"parent": {"$ref": "#/components/schemas/Pet"}
+					}
+				}
+			}
+		}
+	}`)
+
+	result, err := OpenAPIToPostman(doc)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	info := result["info"].(map[string]interface{})
+	if info["name"] != "Pet Store" {
+		t.Errorf("Expected name 'Pet Store', got '%v'", info["name"])
+	}
+	if info["schema"] != postmanCollectionSchema {
+		t.Errorf("Expected schema '%s', got '%v'", postmanCollectionSchema, info["schema"])
+	}
+
+	variable := result["variable"].([]interface{})[0].(map[string]interface{})
+	if variable["value"] != "https://eu.example.com/v1" {
+		t.Errorf("Expected baseUrl 'https://eu.example.com/v1', got '%v'", variable["value"])
+	}
+
+	items := result["item"].([]interface{})
+	if len(items) != 2 {
+		t.Fatalf("Expected a folder and an untagged request, got %d items", len(items))
+	}
+	folder := items[0].(map[string]interface{})
+	if folder["name"] != "pets" || folder["description"] != "Pet operations" {
+		t.Errorf("Expected folder 'pets' with description, got %v", folder)
+	}
+	untagged := items[1].(map[string]interface{})
+	if untagged["name"] != "GET /health" {
+		t.Errorf("Expected untagged request named 'GET /health', got '%v'", untagged["name"])
+	}
+
+	requests := folder["item"].([]interface{})
+	if len(requests) != 2 {
+		t.Fatalf("Expected 2 requests in folder, got %d", len(requests))
+	}
+
+	get := requests[0].(map[string]interface{})
+	if get["name"] != "Get pet" {
+		t.Errorf("Expected request 'Get pet', got '%v'", get["name"])
+	}
+	getRequest := get["request"].(map[string]interface{})
+	getURL := getRequest["url"].(map[string]interface{})
+	if getURL["raw"] != "{{baseUrl}}/pets/:petId?lang=en" {
+		t.Errorf("Expected raw URL '{{baseUrl}}/pets/:petId?lang=en', got '%v'", getURL["raw"])
+	}
+	pathVariable := getURL["variable"].([]interface{})[0].(map[string]interface{})
+	if pathVariable["key"] != "petId" || pathVariable["value"] != "42" {
+		t.Errorf("Expected path variable petId=42, got %v", pathVariable)
+	}
+	query := getURL["query"].([]interface{})
+	if len(query) != 2 {
+		t.Fatalf("Expected 2 query parameters, got %d", len(query))
+	}
+	if fields := query[0].(map[string]interface{}); fields["value"] != "id,name" || fields["disabled"] != true {
+		t.Errorf("Expected disabled optional parameter fields=id,name, got %v", fields)
+	}
+	header := getRequest["header"].([]interface{})[0].(map[string]interface{})
+	if header["key"] != "X-Request-Id" || header["value"] != "<uuid>" {
+		t.Errorf("Expected header X-Request-Id=<uuid>, got %v", header)
+	}
+
+	put := requests[1].(map[string]interface{})
+	if put["name"] != "updatePet" {
+		t.Errorf("Expected request 'updatePet', got '%v'", put["name"])
+	}
+	putRequest := put["request"].(map[string]interface{})
+	if putRequest["method"] != "PUT" {
+		t.Errorf("Expected method 'PUT', got '%v'", putRequest["method"])
+	}
+	contentType := putRequest["header"].([]interface{})[0].(map[string]interface{})
+	if contentType["key"] != "Content-Type" || contentType["value"] != "application/json" {
+		t.Errorf("Expected Content-Type header, got %v", contentType)
+	}
+	body := putRequest["body"].(map[string]interface{})
+	if body["mode"] != "raw" {
+		t.Fatalf("Expected raw body, got '%v'", body["mode"])
+	}
+	var example map[string]interface{}
+	if err := json.Unmarshal([]byte(body["raw"].(string)), &example); err != nil {
+		t.Fatalf("Expected JSON body, got %v", err)
+	}
+	if example["name"] != "Rex" {
+		t.Errorf("Expected example name 'Rex', got '%v'", example["name"])
+	}
+	if tags, ok := example["tags"].([]interface{}); !ok || len(tags) != 1 || tags[0] != "<string>" {
+		t.Errorf("Expected example tags ['<string>'], got %v", example["tags"])
+	}
+	if _, ok := example["parent"].(map[string]interface{}); !ok {
+		t.Errorf("Expected recursive example 'parent' to be an object, got %v", example["parent"])
+	}
+}
+
+func TestOpenAPIToPostmanFormBody(t *testing.T) {
+	doc := parseDocument(t, `{
+		"openapi": "3.0.3",
+		"info": {"title": "Upload", "version": "1.0"},
+		"paths": {
+			"/files": {
+				"post": {
+					"requestBody": {"content": {"multipart/form-data": {"schema": {
+						"type": "object",
+						"properties": {"name": {"type": "string"}, "size": {"type": "integer"}}
+					}}}}
+				}
+			}
+		}
+	}`)
+
+	result, err := OpenAPIToPostman(doc)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	request := result["item"].([]interface{})[0].(map[string]interface{})["request"].(map[string]interface{})
+	body := request["body"].(map[string]interface{})
+	if body["mode"] != "formdata" {
+		t.Fatalf("Expected formdata body, got '%v'", body["mode"])
+	}
+	fields := body["formdata"].([]interface{})
+	if len(fields) != 2 {
+		t.Fatalf("Expected 2 form fields, got %d", len(fields))
+	}
+	if field := fields[1].(map[string]interface{}); field["key"] != "size" || field["value"] != "0" || field["type"] != "text" {
+		t.Errorf("Expected text field size=0, got %v", field)
+	}
+
+	variable := result["variable"].([]interface{})[0].(map[string]interface{})
+	if variable["value"] != "" {
+		t.Errorf("Expected empty baseUrl without servers, got '%v'", variable["value"])
+	}
+}
+
+func TestOpenAPIToPostmanSwagger(t *testing.T) {
+	doc := parseDocument(t, `{
+		"swagger": "2.0",
+		"info": {"title": "Legacy", "version": "1.0"},
+		"host": "api.example.com",
+		"basePath": "/v1",
+		"schemes": ["https"],
+		"paths": {
+			"/items": {
+				"post": {
+					"tags": ["items"],
+					"parameters": [{"name": "item", "in": "body", "schema": {"type": "object", "properties": {"id": {"type": "integer"}}}}]
+				}
+			}
+		}
+	}`)
+
+	result, err := OpenAPIToPostman(doc)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	variable := result["variable"].([]interface{})[0].(map[string]interface{})
+	if variable["value"] != "https://api.example.com/v1" {
+		t.Errorf("Expected baseUrl 'https://api.example.com/v1', got '%v'", variable["value"])
+	}
+	folder := result["item"].([]interface{})[0].(map[string]interface{})
+	request := folder["item"].([]interface{})[0].(map[string]interface{})["request"].(map[string]interface{})
+	body := request["body"].(map[string]interface{})
+	if body["raw"] != "{\n  \"id\": 0\n}" {
+		t.Errorf("Expected converted body example, got '%v'", body["raw"])
+	}
+}
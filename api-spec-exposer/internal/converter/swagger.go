@@ -0,0 +1,730 @@
+package converter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/document"
+)
+
+const openAPI30Version = "3.0.3"
+
+const defaultMediaType = "application/json"
+
+// schema keywords of a non-body Swagger 2.0 parameter or header that belong to the OpenAPI 3.0 schema object
+var schemaKeywords = map[string]bool{
+	"type":             true,
+	"format":           true,
+	"items":            true,
+	"default":          true,
+	"maximum":          true,
+	"exclusiveMaximum": true,
+	"minimum":          true,
+	"exclusiveMinimum": true,
+	"maxLength":        true,
+	"minLength":        true,
+	"pattern":          true,
+	"maxItems":         true,
+	"minItems":         true,
+	"uniqueItems":      true,
+	"enum":             true,
+	"multipleOf":       true,
+}
+
+// Swagger20ToOpenAPI30 converts a Swagger 2.0 document into an equivalent OpenAPI 3.0 document
+func Swagger20ToOpenAPI30(doc map[string]interface{}) (map[string]interface{}, error) {
+	version, _ := doc["swagger"].(string)
+	if !strings.HasPrefix(version, "2.") {
+		return nil, fmt.Errorf("document is not a Swagger 2.0 specification")
+	}
+
+	c := &swaggerConverter{
+		source:             doc,
+		consumes:           stringList(doc["consumes"]),
+		produces:           stringList(doc["produces"]),
+		bodyParameters:     make(map[string]bool),
+		formDataParameters: make(map[string]map[string]interface{}),
+	}
+	return c.convert(), nil
+}
+
+type swaggerConverter struct {
+	source             map[string]interface{}
+	consumes           []string
+	produces           []string
+	bodyParameters     map[string]bool
+	formDataParameters map[string]map[string]interface{}
+}
+
+func (c *swaggerConverter) convert() map[string]interface{} {
+	result := map[string]interface{}{
+		"openapi": openAPI30Version,
+	}
+
+	for key, value := range c.source {
+		switch key {
+		case "swagger", "host", "basePath", "schemes", "consumes", "produces",
+			"definitions", "parameters", "responses", "securityDefinitions", "paths":
+			continue
+		default:
+			result[key] = value
+		}
+	}
+
+	if servers := c.convertServers(); len(servers) > 0 {
+		result["servers"] = servers
+	}
+
+	if components := c.convertComponents(); len(components) > 0 {
+		result["components"] = components
+	}
+
+	result["paths"] = c.convertPaths()
+
+	return rewriteRefs(result, c.bodyParameters).(map[string]interface{})
+}
+
+func (c *swaggerConverter) convertServers() []interface{} {
+	var servers []interface{}
+	for _, url := range Swagger20ServerURLs(c.source) {
+		servers = append(servers, map[string]interface{}{"url": url})
+	}
+	return servers
+}
+
+// Swagger20ServerURLs returns the server URLs of a Swagger 2.0 document built from 'host', 'basePath' and 'schemes',
+// https is assumed when no scheme is declared and a document without host gets the base path alone
+func Swagger20ServerURLs(doc map[string]interface{}) []string {
+	host, _ := doc["host"].(string)
+	basePath, _ := doc["basePath"].(string)
+	basePath = strings.TrimSuffix(basePath, "/")
+
+	if host == "" {
+		if basePath == "" {
+			return nil
+		}
+		return []string{basePath}
+	}
+
+	schemes := stringList(doc["schemes"])
+	if len(schemes) == 0 {
+		schemes = []string{"https"}
+	}
+
+	var urls []string
+	for _, scheme := range schemes {
+		urls = append(urls, fmt.Sprintf("%s://%s%s", scheme, host, basePath))
+	}
+	return urls
+}
+
+func (c *swaggerConverter) convertComponents() map[string]interface{} {
+	components := make(map[string]interface{})
+
+	if definitions, ok := c.source["definitions"].(map[string]interface{}); ok && len(definitions) > 0 {
+		schemas := make(map[string]interface{}, len(definitions))
+		for name, schema := range definitions {
+			schemas[name] = convertSchema(schema)
+		}
+		components["schemas"] = schemas
+	}
+
+	if parameters, ok := c.source["parameters"].(map[string]interface{}); ok {
+		params := make(map[string]interface{})
+		requestBodies := make(map[string]interface{})
+		for name, value := range parameters {
+			param, ok := value.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			switch param["in"] {
+			case "body":
+				c.bodyParameters[name] = true
+				requestBodies[name] = convertBodyParameter(param, c.consumes)
+			case "formData":
+				c.formDataParameters[name] = param
+			default:
+				params[name] = convertParameter(param)
+			}
+		}
+		if len(params) > 0 {
+			components["parameters"] = params
+		}
+		if len(requestBodies) > 0 {
+			components["requestBodies"] = requestBodies
+		}
+	}
+
+	if responses, ok := c.source["responses"].(map[string]interface{}); ok && len(responses) > 0 {
+		converted := make(map[string]interface{}, len(responses))
+		for name, response := range responses {
+			converted[name] = convertResponse(response, c.produces)
+		}
+		components["responses"] = converted
+	}
+
+	if securityDefinitions, ok := c.source["securityDefinitions"].(map[string]interface{}); ok && len(securityDefinitions) > 0 {
+		schemes := make(map[string]interface{}, len(securityDefinitions))
+		for name, definition := range securityDefinitions {
+			if def, ok := definition.(map[string]interface{}); ok {
+				schemes[name] = convertSecurityScheme(def)
+			}
+		}
+		components["securitySchemes"] = schemes
+	}
+
+	return components
+}
+
+func (c *swaggerConverter) convertPaths() map[string]interface{} {
+	result := make(map[string]interface{})
+
+	paths, ok := c.source["paths"].(map[string]interface{})
+	if !ok {
+		return result
+	}
+
+	for path, value := range paths {
+		pathItem, ok := value.(map[string]interface{})
+		if !ok {
+			result[path] = value
+			continue
+		}
+
+		convertedItem := make(map[string]interface{})
+		var inheritedParameters []interface{}
+
+		for key, itemValue := range pathItem {
+			if isHttpMethod(key) {
+				continue
+			}
+			if key == "parameters" {
+				var parameters []interface{}
+				for _, param := range listOf(itemValue) {
+					if c.isRequestBodyParameter(param) {
+						inheritedParameters = append(inheritedParameters, param)
+					} else {
+						parameters = append(parameters, c.convertParameterOrRef(param))
+					}
+				}
+				if len(parameters) > 0 {
+					convertedItem["parameters"] = parameters
+				}
+				continue
+			}
+			convertedItem[key] = itemValue
+		}
+
+		for _, method := range document.Swagger20OperationMethods {
+			if operation, ok := pathItem[method].(map[string]interface{}); ok {
+				convertedItem[method] = c.convertOperation(operation, inheritedParameters)
+			}
+		}
+
+		result[path] = convertedItem
+	}
+
+	return result
+}
+
+func (c *swaggerConverter) convertOperation(operation map[string]interface{}, inheritedParameters []interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+
+	consumes := c.consumes
+	if value, ok := operation["consumes"]; ok {
+		consumes = stringList(value)
+	}
+	produces := c.produces
+	if value, ok := operation["produces"]; ok {
+		produces = stringList(value)
+	}
+
+	for key, value := range operation {
+		switch key {
+		case "consumes", "produces", "parameters", "responses", "schemes":
+			continue
+		default:
+			result[key] = value
+		}
+	}
+
+	var parameters []interface{}
+	var formParameters []map[string]interface{}
+	hasBody := false
+
+	allParameters := append([]interface{}{}, listOf(operation["parameters"])...)
+	allParameters = append(allParameters, inheritedParameters...)
+
+	for _, param := range allParameters {
+		if name := parameterRefName(param); name != "" {
+			if c.bodyParameters[name] {
+				if !hasBody {
+					result["requestBody"] = map[string]interface{}{"$ref": "#/parameters/" + name}
+					hasBody = true
+				}
+				continue
+			}
+			if formParam, ok := c.formDataParameters[name]; ok {
+				formParameters = append(formParameters, formParam)
+				continue
+			}
+			parameters = append(parameters, param)
+			continue
+		}
+
+		paramMap, ok := param.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		switch paramMap["in"] {
+		case "body":
+			if !hasBody {
+				result["requestBody"] = convertBodyParameter(paramMap, consumes)
+				hasBody = true
+			}
+		case "formData":
+			formParameters = append(formParameters, paramMap)
+		default:
+			parameters = append(parameters, convertParameter(paramMap))
+		}
+	}
+
+	if len(parameters) > 0 {
+		result["parameters"] = parameters
+	}
+	if len(formParameters) > 0 && !hasBody {
+		result["requestBody"] = convertFormParameters(formParameters, consumes)
+	}
+
+	if responses, ok := operation["responses"].(map[string]interface{}); ok {
+		converted := make(map[string]interface{}, len(responses))
+		for code, response := range responses {
+			converted[code] = convertResponse(response, produces)
+		}
+		result["responses"] = converted
+	}
+
+	return result
+}
+
+func (c *swaggerConverter) isRequestBodyParameter(param interface{}) bool {
+	if name := parameterRefName(param); name != "" {
+		_, isForm := c.formDataParameters[name]
+		return c.bodyParameters[name] || isForm
+	}
+	if paramMap, ok := param.(map[string]interface{}); ok {
+		return paramMap["in"] == "body" || paramMap["in"] == "formData"
+	}
+	return false
+}
+
+func (c *swaggerConverter) convertParameterOrRef(param interface{}) interface{} {
+	if paramMap, ok := param.(map[string]interface{}); ok && !hasRef(paramMap) {
+		return convertParameter(paramMap)
+	}
+	return param
+}
+
+func convertParameter(param map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	schema := make(map[string]interface{})
+
+	for key, value := range param {
+		switch {
+		case schemaKeywords[key]:
+			schema[key] = value
+		case key == "collectionFormat":
+			continue
+		case key == "x-example":
+			result["example"] = value
+		case key == "allowEmptyValue" && param["in"] != "query":
+			continue
+		default:
+			result[key] = value
+		}
+	}
+
+	if len(schema) > 0 {
+		result["schema"] = convertSchema(schema)
+	}
+
+	if schema["type"] == "array" {
+		collectionFormat, _ := param["collectionFormat"].(string)
+		applyCollectionFormat(result, collectionFormat)
+	}
+
+	return result
+}
+
+func applyCollectionFormat(param map[string]interface{}, collectionFormat string) {
+	location, _ := param["in"].(string)
+
+	switch collectionFormat {
+	case "multi":
+		param["style"] = "form"
+		param["explode"] = true
+	case "ssv":
+		param["style"] = "spaceDelimited"
+		param["explode"] = false
+	case "pipes":
+		param["style"] = "pipeDelimited"
+		param["explode"] = false
+	default:
+		// csv is the Swagger 2.0 default, tsv has no OpenAPI 3.0 equivalent
+		if location == "query" || location == "cookie" {
+			param["style"] = "form"
+		} else {
+			param["style"] = "simple"
+		}
+		param["explode"] = false
+	}
+}
+
+func convertBodyParameter(param map[string]interface{}, consumes []string) map[string]interface{} {
+	schema := convertSchema(param["schema"])
+
+	content := make(map[string]interface{})
+	for _, mediaType := range mediaTypesOrDefault(consumes) {
+		content[mediaType] = map[string]interface{}{"schema": schema}
+	}
+
+	result := map[string]interface{}{
+		"content": content,
+	}
+	if description, ok := param["description"]; ok {
+		result["description"] = description
+	}
+	if required, ok := param["required"]; ok {
+		result["required"] = required
+	}
+	copyExtensions(param, result)
+
+	return result
+}
+
+func convertFormParameters(params []map[string]interface{}, consumes []string) map[string]interface{} {
+	properties := make(map[string]interface{})
+	var required []interface{}
+	hasFile := false
+
+	for _, param := range params {
+		name, _ := param["name"].(string)
+		if name == "" {
+			continue
+		}
+
+		property := make(map[string]interface{})
+		for key, value := range param {
+			if schemaKeywords[key] || key == "description" {
+				property[key] = value
+			}
+		}
+		if property["type"] == "file" {
+			hasFile = true
+		}
+		properties[name] = convertSchema(property)
+
+		if isRequired, _ := param["required"].(bool); isRequired {
+			required = append(required, name)
+		}
+	}
+
+	schema := map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+
+	var mediaTypes []string
+	for _, mediaType := range consumes {
+		if mediaType == "multipart/form-data" || mediaType == "application/x-www-form-urlencoded" {
+			mediaTypes = append(mediaTypes, mediaType)
+		}
+	}
+	if len(mediaTypes) == 0 {
+		if hasFile {
+			mediaTypes = []string{"multipart/form-data"}
+		} else {
+			mediaTypes = []string{"application/x-www-form-urlencoded"}
+		}
+	}
+
+	content := make(map[string]interface{})
+	for _, mediaType := range mediaTypes {
+		content[mediaType] = map[string]interface{}{"schema": schema}
+	}
+
+	return map[string]interface{}{
+		"content": content,
+	}
+}
+
+func convertResponse(value interface{}, produces []string) interface{} {
+	response, ok := value.(map[string]interface{})
+	if !ok || hasRef(response) {
+		return value
+	}
+
+	result := make(map[string]interface{})
+	description, _ := response["description"].(string)
+	result["description"] = description
+
+	content := make(map[string]interface{})
+	if schema, ok := response["schema"]; ok {
+		converted := convertSchema(schema)
+		for _, mediaType := range mediaTypesOrDefault(produces) {
+			content[mediaType] = map[string]interface{}{"schema": converted}
+		}
+	}
+	if examples, ok := response["examples"].(map[string]interface{}); ok {
+		for mediaType, example := range examples {
+			mediaTypeObject, ok := content[mediaType].(map[string]interface{})
+			if !ok {
+				mediaTypeObject = make(map[string]interface{})
+				content[mediaType] = mediaTypeObject
+			}
+			mediaTypeObject["example"] = example
+		}
+	}
+	if len(content) > 0 {
+		result["content"] = content
+	}
+
+	if headers, ok := response["headers"].(map[string]interface{}); ok && len(headers) > 0 {
+		converted := make(map[string]interface{}, len(headers))
+		for name, header := range headers {
+			converted[name] = convertHeader(header)
+		}
+		result["headers"] = converted
+	}
+
+	copyExtensions(response, result)
+
+	return result
+}
+
+func convertHeader(value interface{}) interface{} {
+	header, ok := value.(map[string]interface{})
+	if !ok {
+		return value
+	}
+
+	result := make(map[string]interface{})
+	schema := make(map[string]interface{})
+	for key, headerValue := range header {
+		switch {
+		case schemaKeywords[key]:
+			schema[key] = headerValue
+		case key == "collectionFormat":
+			continue
+		default:
+			result[key] = headerValue
+		}
+	}
+	if len(schema) > 0 {
+		result["schema"] = convertSchema(schema)
+	}
+
+	return result
+}
+
+func convertSecurityScheme(definition map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+
+	switch definition["type"] {
+	case "basic":
+		result["type"] = "http"
+		result["scheme"] = "basic"
+	case "apiKey":
+		result["type"] = "apiKey"
+		result["name"] = definition["name"]
+		result["in"] = definition["in"]
+	case "oauth2":
+		result["type"] = "oauth2"
+		flow := make(map[string]interface{})
+		if authorizationUrl, ok := definition["authorizationUrl"]; ok {
+			flow["authorizationUrl"] = authorizationUrl
+		}
+		if tokenUrl, ok := definition["tokenUrl"]; ok {
+			flow["tokenUrl"] = tokenUrl
+		}
+		if scopes, ok := definition["scopes"]; ok {
+			flow["scopes"] = scopes
+		} else {
+			flow["scopes"] = map[string]interface{}{}
+		}
+
+		var flowName string
+		switch definition["flow"] {
+		case "implicit":
+			flowName = "implicit"
+		case "password":
+			flowName = "password"
+		case "application":
+			flowName = "clientCredentials"
+		default:
+			flowName = "authorizationCode"
+		}
+		result["flows"] = map[string]interface{}{flowName: flow}
+	default:
+		result["type"] = definition["type"]
+	}
+
+	if description, ok := definition["description"]; ok {
+		result["description"] = description
+	}
+	copyExtensions(definition, result)
+
+	return result
+}
+
+// convertSchema replaces Swagger 2.0 specific schema constructs with their OpenAPI 3.0 counterparts
+func convertSchema(value interface{}) interface{} {
+	schema, ok := value.(map[string]interface{})
+	if !ok {
+		return value
+	}
+
+	result := make(map[string]interface{}, len(schema))
+	for key, schemaValue := range schema {
+		switch key {
+		case "type":
+			if schemaValue == "file" {
+				result["type"] = "string"
+				result["format"] = "binary"
+			} else {
+				result["type"] = schemaValue
+			}
+		case "format":
+			if schema["type"] != "file" {
+				result["format"] = schemaValue
+			}
+		case "x-nullable":
+			result["nullable"] = schemaValue
+		case "discriminator":
+			if propertyName, ok := schemaValue.(string); ok {
+				result["discriminator"] = map[string]interface{}{"propertyName": propertyName}
+			} else {
+				result["discriminator"] = schemaValue
+			}
+		case "items", "additionalProperties", "not":
+			result[key] = convertSchema(schemaValue)
+		case "allOf", "anyOf", "oneOf":
+			var converted []interface{}
+			for _, item := range listOf(schemaValue) {
+				converted = append(converted, convertSchema(item))
+			}
+			result[key] = converted
+		case "properties":
+			if properties, ok := schemaValue.(map[string]interface{}); ok {
+				converted := make(map[string]interface{}, len(properties))
+				for name, property := range properties {
+					converted[name] = convertSchema(property)
+				}
+				result[key] = converted
+			} else {
+				result[key] = schemaValue
+			}
+		default:
+			result[key] = schemaValue
+		}
+	}
+
+	return result
+}
+
+// rewriteRefs points local references to their new locations in the components object
+func rewriteRefs(value interface{}, bodyParameters map[string]bool) interface{} {
+	switch x := value.(type) {
+	case map[string]interface{}:
+		for key, item := range x {
+			if ref, ok := item.(string); ok && key == "$ref" {
+				x[key] = rewriteRef(ref, bodyParameters)
+			} else {
+				x[key] = rewriteRefs(item, bodyParameters)
+			}
+		}
+	case []interface{}:
+		for i, item := range x {
+			x[i] = rewriteRefs(item, bodyParameters)
+		}
+	}
+	return value
+}
+
+func rewriteRef(ref string, bodyParameters map[string]bool) string {
+	switch {
+	case strings.HasPrefix(ref, "#/definitions/"):
+		return "#/components/schemas/" + strings.TrimPrefix(ref, "#/definitions/")
+	case strings.HasPrefix(ref, "#/parameters/"):
+		name := strings.TrimPrefix(ref, "#/parameters/")
+		if bodyParameters[name] {
+			return "#/components/requestBodies/" + name
+		}
+		return "#/components/parameters/" + name
+	case strings.HasPrefix(ref, "#/responses/"):
+		return "#/components/responses/" + strings.TrimPrefix(ref, "#/responses/")
+	default:
+		return ref
+	}
+}
+
+func parameterRefName(param interface{}) string {
+	paramMap, ok := param.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	ref, _ := paramMap["$ref"].(string)
+	if !strings.HasPrefix(ref, "#/parameters/") {
+		return ""
+	}
+	return strings.TrimPrefix(ref, "#/parameters/")
+}
+
+func hasRef(data map[string]interface{}) bool {
+	_, ok := data["$ref"]
+	return ok
+}
+
+func copyExtensions(from map[string]interface{}, to map[string]interface{}) {
+	for key, value := range from {
+		if strings.HasPrefix(key, "x-") {
+			to[key] = value
+		}
+	}
+}
+
+func mediaTypesOrDefault(mediaTypes []string) []string {
+	if len(mediaTypes) == 0 {
+		return []string{defaultMediaType}
+	}
+	return mediaTypes
+}
+
+func isHttpMethod(key string) bool {
+	for _, method := range document.Swagger20OperationMethods {
+		if key == method {
+			return true
+		}
+	}
+	return false
+}
+
+func listOf(value interface{}) []interface{} {
+	if list, ok := value.([]interface{}); ok {
+		return list
+	}
+	return nil
+}
+
+func stringList(value interface{}) []string {
+	var result []string
+	for _, item := range listOf(value) {
+		if s, ok := item.(string); ok {
+			result = append(result, s)
+		}
+	}
+	return result
+}
@@ -0,0 +1,324 @@
+package converter
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func parseDocument(t *testing.T, content string) map[string]interface{} {
+	t.Helper()
+	var doc map[string]interface{}
+	if err := json.Unmarshal([]byte(content), &doc); err != nil {
+		t.Fatalf("Failed to parse test document: %v", err)
+	}
+	return doc
+}
+
+func TestSwagger20ToOpenAPI30NotSwagger(t *testing.T) {
+	doc := parseDocument(t, `{"openapi": "3.0.0", "info": {"title": "API", "version": "1.0"}}`)
+
+	_, err := Swagger20ToOpenAPI30(doc)
+	if err == nil {
+		t.Fatal("Expected error for non-Swagger document")
+	}
+}
+
+func TestSwagger20ToOpenAPI30Servers(t *testing.T) {
+	doc := parseDocument(t, `{
+		"swagger": "2.0",
+		"info": {"title": "API", "version": "1.0"},
+		"host": "api.example.com",
+		"basePath": "/v1",
+		"schemes": ["https", "http"],
+		"paths": {}
+	}`)
+
+	result, err := Swagger20ToOpenAPI30(doc)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if result["openapi"] != "3.0.3" {
+		t.Errorf("Expected openapi '3.0.3', got '%v'", result["openapi"])
+	}
+
+	for _, key := range []string{"swagger", "host", "basePath", "schemes"} {
+		if _, ok := result[key]; ok {
+			t.Errorf("Expected '%s' to be removed", key)
+		}
+	}
+
+	servers, ok := result["servers"].([]interface{})
+	if !ok || len(servers) != 2 {
+		t.Fatalf("Expected 2 servers, got %v", result["servers"])
+	}
+
+	if url := servers[0].(map[string]interface{})["url"]; url != "https://api.example.com/v1" {
+		t.Errorf("Expected first server 'https://api.example.com/v1', got '%v'", url)
+	}
+
+	if url := servers[1].(map[string]interface{})["url"]; url != "http://api.example.com/v1" {
+		t.Errorf("Expected second server 'http://api.example.com/v1', got '%v'", url)
+	}
+}
+
+func TestSwagger20ServerURLs(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{"host, base path and schemes", `{"host": "api.example.com", "basePath": "/v1/", "schemes": ["http", "https"]}`, "http://api.example.com/v1,https://api.example.com/v1"},
+		{"https by default", `{"host": "api.example.com"}`, "https://api.example.com"},
+		{"base path without host", `{"basePath": "/v1"}`, "/v1"},
+		{"no host and base path", `{}`, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			urls := Swagger20ServerURLs(parseDocument(t, tt.content))
+			if result := strings.Join(urls, ","); result != tt.expected {
+				t.Errorf("Expected '%s', got '%s'", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestSwagger20ToOpenAPI30Definitions(t *testing.T) {
+	doc := parseDocument(t, `{
+		"swagger": "2.0",
+		"info": {"title": "API", "version": "1.0"},
+		"paths": {},
+		"definitions": {
+			"User": {
+				"type": "object",
+				"properties": {
+					"avatar": {"type": "file"},
+					"manager": {"$ref": "#/definitions/User"},
+					"nickname": {"type": "string", "x-nullable": true}
+				}
+			}
+		}
+	}`)
+
+	result, err := Swagger20ToOpenAPI30(doc)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if _, ok := result["definitions"]; ok {
+		t.Error("Expected 'definitions' to be removed")
+	}
+
+	components := result["components"].(map[string]interface{})
+	user := components["schemas"].(map[string]interface{})["User"].(map[string]interface{})
+	properties := user["properties"].(map[string]interface{})
+
+	avatar := properties["avatar"].(map[string]interface{})
+	if avatar["type"] != "string" || avatar["format"] != "binary" {
+		t.Errorf("Expected file type converted to binary string, got %v", avatar)
+	}
+
+	manager := properties["manager"].(map[string]interface{})
+	if manager["$ref"] != "#/components/schemas/User" {
+		t.Errorf("Expected rewritten reference, got '%v'", manager["$ref"])
+	}
+
+	nickname := properties["nickname"].(map[string]interface{})
+	if nickname["nullable"] != true {
+		t.Errorf("Expected x-nullable converted to nullable, got %v", nickname)
+	}
+}
+
+func TestSwagger20ToOpenAPI30BodyParameter(t *testing.T) {
+	doc := parseDocument(t, `{
+		"swagger": "2.0",
+		"info": {"title": "API", "version": "1.0"},
+		"consumes": ["application/json", "application/xml"],
+		"paths": {
+			"/users/{id}": {
+				"parameters": [
+					{"name": "id", "in": "path", "required": true, "type": "string"}
+				],
+				"put": {
+					"operationId": "updateUser",
+					"parameters": [
+						{"name": "body", "in": "body", "required": true, "schema": {"$ref": "#/definitions/User"}},
+						{"name": "tags", "in": "query", "type": "array", "items": {"type": "string"}, "collectionFormat": "multi"}
+					],
+					"responses": {
+						"200": {"description": "OK", "schema": {"$ref": "#/definitions/User"}}
+					}
+				}
+			}
+		},
+		"definitions": {"User": {"type": "object"}}
+	}`)
+
+	result, err := Swagger20ToOpenAPI30(doc)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	pathItem := result["paths"].(map[string]interface{})["/users/{id}"].(map[string]interface{})
+	pathParameters := pathItem["parameters"].([]interface{})
+	idSchema := pathParameters[0].(map[string]interface{})["schema"].(map[string]interface{})
+	if idSchema["type"] != "string" {
+		t.Errorf("Expected path parameter type moved to schema, got %v", pathParameters[0])
+	}
+
+	operation := pathItem["put"].(map[string]interface{})
+	if operation["operationId"] != "updateUser" {
+		t.Errorf("Expected operationId to be preserved, got '%v'", operation["operationId"])
+	}
+
+	requestBody, ok := operation["requestBody"].(map[string]interface{})
+	if !ok {
+		t.Fatal("Expected requestBody to be created from body parameter")
+	}
+
+	if requestBody["required"] != true {
+		t.Error("Expected requestBody to be required")
+	}
+
+	content := requestBody["content"].(map[string]interface{})
+	if len(content) != 2 {
+		t.Errorf("Expected 2 request media types, got %d", len(content))
+	}
+
+	schema := content["application/json"].(map[string]interface{})["schema"].(map[string]interface{})
+	if schema["$ref"] != "#/components/schemas/User" {
+		t.Errorf("Expected rewritten body schema reference, got '%v'", schema["$ref"])
+	}
+
+	parameters := operation["parameters"].([]interface{})
+	if len(parameters) != 1 {
+		t.Fatalf("Expected 1 operation parameter, got %d", len(parameters))
+	}
+
+	tags := parameters[0].(map[string]interface{})
+	if tags["style"] != "form" || tags["explode"] != true {
+		t.Errorf("Expected multi collection format converted to exploded form style, got %v", tags)
+	}
+
+	response := operation["responses"].(map[string]interface{})["200"].(map[string]interface{})
+	responseContent := response["content"].(map[string]interface{})
+	if _, ok := responseContent["application/json"]; !ok {
+		t.Error("Expected default response media type 'application/json'")
+	}
+}
+
+func TestSwagger20ToOpenAPI30FormDataParameters(t *testing.T) {
+	doc := parseDocument(t, `{
+		"swagger": "2.0",
+		"info": {"title": "API", "version": "1.0"},
+		"paths": {
+			"/upload": {
+				"post": {
+					"parameters": [
+						{"name": "file", "in": "formData", "type": "file", "required": true},
+						{"name": "comment", "in": "formData", "type": "string"}
+					],
+					"responses": {"204": {"description": "Uploaded"}}
+				}
+			}
+		}
+	}`)
+
+	result, err := Swagger20ToOpenAPI30(doc)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	operation := result["paths"].(map[string]interface{})["/upload"].(map[string]interface{})["post"].(map[string]interface{})
+	if _, ok := operation["parameters"]; ok {
+		t.Error("Expected formData parameters to be moved to requestBody")
+	}
+
+	content := operation["requestBody"].(map[string]interface{})["content"].(map[string]interface{})
+	multipart, ok := content["multipart/form-data"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("Expected multipart/form-data content, got %v", content)
+	}
+
+	schema := multipart["schema"].(map[string]interface{})
+	properties := schema["properties"].(map[string]interface{})
+	if len(properties) != 2 {
+		t.Errorf("Expected 2 form properties, got %d", len(properties))
+	}
+
+	required := schema["required"].([]interface{})
+	if len(required) != 1 || required[0] != "file" {
+		t.Errorf("Expected required ['file'], got %v", required)
+	}
+}
+
+func TestSwagger20ToOpenAPI30SharedComponents(t *testing.T) {
+	doc := parseDocument(t, `{
+		"swagger": "2.0",
+		"info": {"title": "API", "version": "1.0"},
+		"paths": {
+			"/items": {
+				"post": {
+					"parameters": [
+						{"$ref": "#/parameters/ItemBody"},
+						{"$ref": "#/parameters/Limit"}
+					],
+					"responses": {"default": {"$ref": "#/responses/Error"}}
+				}
+			}
+		},
+		"parameters": {
+			"ItemBody": {"name": "item", "in": "body", "schema": {"type": "object"}},
+			"Limit": {"name": "limit", "in": "query", "type": "integer"}
+		},
+		"responses": {
+			"Error": {"description": "Error"}
+		},
+		"securityDefinitions": {
+			"basicAuth": {"type": "basic"},
+			"oauth": {"type": "oauth2", "flow": "application", "tokenUrl": "https://auth/token", "scopes": {}}
+		}
+	}`)
+
+	result, err := Swagger20ToOpenAPI30(doc)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	components := result["components"].(map[string]interface{})
+	if _, ok := components["requestBodies"].(map[string]interface{})["ItemBody"]; !ok {
+		t.Error("Expected body parameter to become a request body component")
+	}
+	if _, ok := components["parameters"].(map[string]interface{})["Limit"]; !ok {
+		t.Error("Expected query parameter to stay a parameter component")
+	}
+
+	operation := result["paths"].(map[string]interface{})["/items"].(map[string]interface{})["post"].(map[string]interface{})
+	requestBody := operation["requestBody"].(map[string]interface{})
+	if requestBody["$ref"] != "#/components/requestBodies/ItemBody" {
+		t.Errorf("Expected request body reference, got '%v'", requestBody["$ref"])
+	}
+
+	parameters := operation["parameters"].([]interface{})
+	if len(parameters) != 1 || parameters[0].(map[string]interface{})["$ref"] != "#/components/parameters/Limit" {
+		t.Errorf("Expected parameter reference, got %v", parameters)
+	}
+
+	defaultResponse := operation["responses"].(map[string]interface{})["default"].(map[string]interface{})
+	if defaultResponse["$ref"] != "#/components/responses/Error" {
+		t.Errorf("Expected response reference, got '%v'", defaultResponse["$ref"])
+	}
+
+	securitySchemes := components["securitySchemes"].(map[string]interface{})
+	basic := securitySchemes["basicAuth"].(map[string]interface{})
+	if basic["type"] != "http" || basic["scheme"] != "basic" {
+		t.Errorf("Expected basic auth converted to http scheme, got %v", basic)
+	}
+
+	flows := securitySchemes["oauth"].(map[string]interface{})["flows"].(map[string]interface{})
+	if _, ok := flows["clientCredentials"]; !ok {
+		t.Errorf("Expected application flow converted to clientCredentials, got %v", flows)
+	}
+}
@@ -0,0 +1,298 @@
+package document
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+	"gopkg.in/yaml.v3"
+)
+
+// Decode parses JSON or YAML content into a generic map, the root of the document must be an object
+func Decode(content []byte, format config.Format) (map[string]interface{}, error) {
+	data, err := Parse(content, format)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, fmt.Errorf("document root is not an object")
+	}
+	return data, nil
+}
+
+// Parse parses JSON or YAML content into a generic map like Decode, but an empty document or a null root gives a nil map
+func Parse(content []byte, format config.Format) (map[string]interface{}, error) {
+	switch format {
+	case config.FormatJSON:
+		var data map[string]interface{}
+		if err := json.Unmarshal(content, &data); err != nil {
+			return nil, err
+		}
+		return data, nil
+	case config.FormatYAML:
+		var yamlData interface{}
+		if err := yaml.Unmarshal(content, &yamlData); err != nil {
+			return nil, err
+		}
+		if yamlData == nil {
+			return nil, nil
+		}
+		data, ok := normalize(yamlData).(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("document root is not an object")
+		}
+		return data, nil
+	default:
+		return nil, fmt.Errorf("unsupported document format '%s'", format)
+	}
+}
+
+// Encode serializes a generic map into JSON or YAML. Object keys keep the order they have in the reference document,
+// usually the content the data was decoded from, and the keys it does not have follow in ascending order;
+// without a reference all keys are sorted. A version key missing from the reference, e.g. the openapi key
+// of a converted Swagger 2.0 document, is written first
+func Encode(data map[string]interface{}, format config.Format, reference []byte) ([]byte, error) {
+	var root yaml.Node
+	if len(reference) > 0 && yaml.Unmarshal(reference, &root) != nil {
+		root = yaml.Node{}
+	}
+	var referenceRoot *yaml.Node
+	if root.Kind == yaml.DocumentNode && len(root.Content) > 0 {
+		referenceRoot = root.Content[0]
+	}
+	ordered := orderKeys(data, referenceRoot)
+	if object, ok := ordered.(orderedObject); ok {
+		ordered = object.withLeadingKey(versionKeys)
+	}
+
+	switch format {
+	case config.FormatJSON:
+		return json.MarshalIndent(ordered, "", "  ")
+	case config.FormatYAML:
+		var buf bytes.Buffer
+		encoder := yaml.NewEncoder(&buf)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(ordered); err != nil {
+			return nil, err
+		}
+		if err := encoder.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	default:
+		return nil, fmt.Errorf("unsupported document format '%s'", format)
+	}
+}
+
+// orderedObject is an object of a generic document encoded with its keys in a fixed order
+type orderedObject struct {
+	keys   []string
+	values map[string]interface{}
+}
+
+// orderKeys replaces the maps of a generic value by ordered objects following the key order of the reference node
+func orderKeys(value interface{}, reference *yaml.Node) interface{} {
+	switch node := value.(type) {
+	case map[string]interface{}:
+		children := make(map[string]*yaml.Node)
+		var keys []string
+		if reference != nil && reference.Kind == yaml.MappingNode {
+			for i := 0; i+1 < len(reference.Content); i += 2 {
+				key := reference.Content[i].Value
+				if _, ok := node[key]; ok && children[key] == nil {
+					children[key] = reference.Content[i+1]
+					keys = append(keys, key)
+				}
+			}
+		}
+		var missing []string
+		for key := range node {
+			if children[key] == nil {
+				missing = append(missing, key)
+			}
+		}
+		sort.Strings(missing)
+		keys = append(keys, missing...)
+
+		values := make(map[string]interface{}, len(node))
+		for _, key := range keys {
+			values[key] = orderKeys(node[key], children[key])
+		}
+		return orderedObject{keys: keys, values: values}
+	case []interface{}:
+		items := make([]interface{}, len(node))
+		for i, item := range node {
+			var child *yaml.Node
+			if reference != nil && reference.Kind == yaml.SequenceNode && i < len(reference.Content) {
+				child = reference.Content[i]
+			}
+			items[i] = orderKeys(item, child)
+		}
+		return items
+	default:
+		return value
+	}
+}
+
+// versionKeys are the root keys declaring the specification version of a document
+var versionKeys = []string{"openapi", "swagger", "asyncapi", "arazzo", "overlay"}
+
+// withLeadingKey moves the first of the given keys that is present to the front
+func (o orderedObject) withLeadingKey(candidates []string) orderedObject {
+	for _, candidate := range candidates {
+		for i, key := range o.keys {
+			if key != candidate {
+				continue
+			}
+			keys := append([]string{key}, o.keys[:i]...)
+			o.keys = append(keys, o.keys[i+1:]...)
+			return o
+		}
+	}
+	return o
+}
+
+func (o orderedObject) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range o.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		encodedKey, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		encodedValue, err := json.Marshal(o.values[key])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(encodedKey)
+		buf.WriteByte(':')
+		buf.Write(encodedValue)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+func (o orderedObject) MarshalYAML() (interface{}, error) {
+	mapping := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	for _, key := range o.keys {
+		var value yaml.Node
+		if err := value.Encode(o.values[key]); err != nil {
+			return nil, err
+		}
+		mapping.Content = append(mapping.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, &value)
+	}
+	return mapping, nil
+}
+
+// normalize converts YAML maps with non-string keys (e.g. response codes) into JSON-compatible maps
+func normalize(v interface{}) interface{} {
+	switch x := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(x))
+		for k, v2 := range x {
+			m[fmt.Sprint(k)] = normalize(v2)
+		}
+		return m
+	case map[string]interface{}:
+		for k, v2 := range x {
+			x[k] = normalize(v2)
+		}
+	case []interface{}:
+		for i, v2 := range x {
+			x[i] = normalize(v2)
+		}
+	}
+	return v
+}
+
+// SplitYAML splits a YAML stream into its documents at the '---' and '...' markers starting a line.
+// Every document keeps its original text, including the leading '---' marker;
+// documents containing nothing but comments and directives are skipped
+func SplitYAML(content []byte) [][]byte {
+	var documents [][]byte
+	var current []byte
+	flush := func() {
+		if hasYAMLContent(current) {
+			documents = append(documents, current)
+		}
+		current = nil
+	}
+
+	for _, line := range bytes.SplitAfter(content, []byte("\n")) {
+		trimmed := bytes.TrimRight(line, "\r\n")
+		switch {
+		case isDocumentMarker(trimmed, "---"):
+			flush()
+			current = append(current, line...)
+		case isDocumentMarker(trimmed, "..."):
+			current = append(current, line...)
+			flush()
+		default:
+			current = append(current, line...)
+		}
+	}
+	flush()
+
+	return documents
+}
+
+// hasYAMLContent reports whether a document has content other than markers, directives and comments
+func hasYAMLContent(document []byte) bool {
+	for _, line := range bytes.Split(document, []byte("\n")) {
+		line = bytes.TrimRight(line, " \t\r")
+		if isDocumentMarker(line, "---") || isDocumentMarker(line, "...") {
+			line = line[3:]
+		} else if bytes.HasPrefix(line, []byte("%")) {
+			continue
+		}
+		line = bytes.TrimLeft(line, " \t")
+		if len(line) > 0 && line[0] != '#' {
+			return true
+		}
+	}
+	return false
+}
+
+func isDocumentMarker(line []byte, marker string) bool {
+	return bytes.HasPrefix(line, []byte(marker)) && (len(line) == len(marker) || line[len(marker)] == ' ' || line[len(marker)] == '\t')
+}
+
+// OperationMethods are the HTTP methods of the operations of an OpenAPI 3.x path item
+var OperationMethods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+// Swagger20OperationMethods are the HTTP methods of the operations of a Swagger 2.0 path item, which has no trace operation
+var Swagger20OperationMethods = []string{"get", "put", "post", "delete", "options", "head", "patch"}
+
+// OperationMethodsOf returns the HTTP methods of the operations of a path item in a REST document of the given type
+func OperationMethodsOf(docType config.DocumentType) []string {
+	if docType == config.DocTypeOpenAPI20 {
+		return Swagger20OperationMethods
+	}
+	return OperationMethods
+}
+
+// SortedKeys returns the keys of a generic map in ascending order
+func SortedKeys(data map[string]interface{}) []string {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Pointer builds a JSON pointer (RFC 6901) from unescaped reference tokens
+func Pointer(tokens ...string) string {
+	var sb strings.Builder
+	for _, token := range tokens {
+		sb.WriteString("/")
+		sb.WriteString(strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1"))
+	}
+	return sb.String()
+}
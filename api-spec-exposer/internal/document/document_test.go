@@ -0,0 +1,292 @@
+package document
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+)
+
+func TestDecodeYAMLNonStringKeys(t *testing.T) {
+	content := []byte(`responses:
+  200:
+    description: OK
+`)
+
+	data, err := Decode(content, config.FormatYAML)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	responses, ok := data["responses"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("Expected responses to be a string-keyed map, got %T", data["responses"])
+	}
+
+	if _, ok := responses["200"]; !ok {
+		t.Error("Expected response code key '200'")
+	}
+}
+
+func TestDecodeNonObjectRoot(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		format  config.Format
+	}{
+		{"json array", `[1, 2]`, config.FormatJSON},
+		{"json null", `null`, config.FormatJSON},
+		{"yaml scalar", `text`, config.FormatYAML},
+		{"unknown format", `{}`, config.FormatUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Decode([]byte(tt.content), tt.format)
+			if err == nil {
+				t.Error("Expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestParseEmptyDocument(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		format  config.Format
+	}{
+		{"json null", `null`, config.FormatJSON},
+		{"empty yaml", ``, config.FormatYAML},
+		{"yaml comment", "# nothing here\n", config.FormatYAML},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := Parse([]byte(tt.content), tt.format)
+			if err != nil || data != nil {
+				t.Errorf("Expected nil map and no error, got %v and %v", data, err)
+			}
+		})
+	}
+}
+
+func TestNormalizeYAMLMap(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    map[interface{}]interface{}
+		validate func(*testing.T, map[string]interface{})
+	}{
+		{
+			name: "Simple conversion",
+			input: map[interface{}]interface{}{
+				"name":    "test",
+				"version": "1.0",
+			},
+			validate: func(t *testing.T, result map[string]interface{}) {
+				if result["name"] != "test" {
+					t.Errorf("Expected name 'test', got %v", result["name"])
+				}
+				if result["version"] != "1.0" {
+					t.Errorf("Expected version '1.0', got %v", result["version"])
+				}
+			},
+		},
+		{
+			name: "Nested conversion",
+			input: map[interface{}]interface{}{
+				"info": map[interface{}]interface{}{
+					"title": "API",
+				},
+			},
+			validate: func(t *testing.T, result map[string]interface{}) {
+				info, ok := result["info"].(map[string]interface{})
+				if !ok {
+					t.Error("Expected 'info' to be a map")
+					return
+				}
+				if info["title"] != "API" {
+					t.Errorf("Expected title 'API', got %v", info["title"])
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, _ := normalize(tt.input).(map[string]interface{})
+			if result == nil {
+				t.Error("Expected non-nil result")
+				return
+			}
+			tt.validate(t, result)
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    interface{}
+		expected interface{}
+	}{
+		{
+			name:     "String value",
+			input:    "test",
+			expected: "test",
+		},
+		{
+			name:     "Integer value",
+			input:    42,
+			expected: 42,
+		},
+		{
+			name: "Map with string keys",
+			input: map[interface{}]interface{}{
+				"key": "value",
+			},
+			expected: map[string]interface{}{
+				"key": "value",
+			},
+		},
+		{
+			name: "Map with integer keys",
+			input: map[interface{}]interface{}{
+				1: "value",
+			},
+			expected: map[string]interface{}{
+				"1": "value",
+			},
+		},
+		{
+			name:     "Slice of values",
+			input:    []interface{}{"a", "b", "c"},
+			expected: []interface{}{"a", "b", "c"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := normalize(tt.input)
+			if result == nil && tt.expected != nil {
+				t.Error("Expected non-nil result")
+			}
+		})
+	}
+}
+
+func TestEncodeRoundTrip(t *testing.T) {
+	data := map[string]interface{}{"openapi": "3.0.3", "paths": map[string]interface{}{}}
+
+	for _, format := range []config.Format{config.FormatJSON, config.FormatYAML} {
+		t.Run(string(format), func(t *testing.T) {
+			content, err := Encode(data, format, nil)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			decoded, err := Decode(content, format)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if decoded["openapi"] != "3.0.3" {
+				t.Errorf("Expected openapi '3.0.3', got '%v'", decoded["openapi"])
+			}
+		})
+	}
+}
+
+func TestEncodeKeyOrder(t *testing.T) {
+	reference := []byte("swagger: '2.0'\ninfo:\n  version: 1.0.0\n  title: Pets\ntags:\n  - name: pets\n    description: Pets\n")
+	data := map[string]interface{}{
+		"openapi": "3.0.0",
+		"info":    map[string]interface{}{"version": "1.0.0", "title": "Pets", "contact": map[string]interface{}{}},
+		"tags":    []interface{}{map[string]interface{}{"name": "pets", "description": "Pets"}},
+		"servers": []interface{}{},
+	}
+
+	tests := []struct {
+		name      string
+		format    config.Format
+		reference []byte
+		expected  string
+	}{
+		{"yaml reference order", config.FormatYAML, reference, "openapi: 3.0.0\ninfo:\n  version: 1.0.0\n  title: Pets\n  contact: {}\ntags:\n  - name: pets\n    description: Pets\nservers: []\n"},
+		{"json reference order", config.FormatJSON, reference, `{"openapi":"3.0.0","info":{"version":"1.0.0","title":"Pets","contact":{}},"tags":[{"name":"pets","description":"Pets"}],"servers":[]}`},
+		{"sorted without reference", config.FormatJSON, nil, `{"openapi":"3.0.0","info":{"contact":{},"title":"Pets","version":"1.0.0"},"servers":[],"tags":[{"description":"Pets","name":"pets"}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, err := Encode(data, tt.format, tt.reference)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			result := string(content)
+			if tt.format == config.FormatJSON {
+				result = strings.Join(strings.Fields(result), "")
+			}
+			if result != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestSplitYAML(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected []string
+	}{
+		{"single document", "openapi: 3.0.0\n", []string{"openapi: 3.0.0\n"}},
+		{"leading marker", "---\nopenapi: 3.0.0\n", []string{"---\nopenapi: 3.0.0\n"}},
+		{"several documents", "kind: ConfigMap\n---\nopenapi: 3.0.0\n--- # second\nswagger: '2.0'\n", []string{"kind: ConfigMap\n", "---\nopenapi: 3.0.0\n", "--- # second\nswagger: '2.0'\n"}},
+		{"end markers", "a: 1\n...\n---\nb: 2\n...\n", []string{"a: 1\n...\n", "---\nb: 2\n...\n"}},
+		{"empty and comment documents", "# header\n---\n---\n# nothing\n---\na: 1\n", []string{"---\na: 1\n"}},
+		{"directives", "%YAML 1.2\n---\na: 1\n", []string{"---\na: 1\n"}},
+		{"marker inside a block scalar", "a: |\n  ---\n  text\n", []string{"a: |\n  ---\n  text\n"}},
+		{"marker prefixed text", "---x: 1\n", []string{"---x: 1\n"}},
+		{"windows line endings", "a: 1\r\n---\r\nb: 2\r\n", []string{"a: 1\r\n", "---\r\nb: 2\r\n"}},
+		{"empty", "", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			documents := SplitYAML([]byte(tt.content))
+			if len(documents) != len(tt.expected) {
+				t.Fatalf("Expected %d documents, got %d: %q", len(tt.expected), len(documents), documents)
+			}
+			for i, doc := range documents {
+				if string(doc) != tt.expected[i] {
+					t.Errorf("Expected document %d %q, got %q", i+1, tt.expected[i], string(doc))
+				}
+			}
+		})
+	}
+}
+
+func TestPointer(t *testing.T) {
+	tests := []struct {
+		tokens   []string
+		expected string
+	}{
+		{nil, ""},
+		{[]string{"paths", "/users/{id}", "get"}, "/paths/~1users~1{id}/get"},
+		{[]string{"a~b", "c/d"}, "/a~0b/c~1d"},
+	}
+
+	for _, tt := range tests {
+		if result := Pointer(tt.tokens...); result != tt.expected {
+			t.Errorf("Expected '%s', got '%s'", tt.expected, result)
+		}
+	}
+}
+
+func TestSortedKeys(t *testing.T) {
+	keys := SortedKeys(map[string]interface{}{"post": 1, "get": 2, "delete": 3})
+	if strings.Join(keys, ",") != "delete,get,post" {
+		t.Errorf("Expected sorted keys, got %v", keys)
+	}
+}
@@ -0,0 +1,138 @@
+package generator
+
+import (
+	"fmt"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/converter"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/document"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/graphql"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/loader"
+)
+
+// applySwaggerConversion returns REST specs according to the configured Swagger 2.0 conversion mode
+// along with the indexes of the specs that must be served converted to OpenAPI 3.0
+func (g *Generator) applySwaggerConversion(specs []config.SpecMetadata) ([]config.SpecMetadata, map[int]bool) {
+	converted := make(map[int]bool)
+	mode := g.config.Swagger2Conversion
+	if mode != config.ConversionReplace && mode != config.ConversionAlongside {
+		return specs, converted
+	}
+
+	var result []config.SpecMetadata
+	for _, spec := range specs {
+		if spec.Type != config.DocTypeOpenAPI20 {
+			result = append(result, spec)
+			continue
+		}
+
+		convertedSpec := spec
+		convertedSpec.Type = config.DocTypeOpenAPI30
+		if mode == config.ConversionAlongside {
+			result = append(result, spec)
+			convertedSpec.Name = fmt.Sprintf("%s (OpenAPI 3.0)", spec.Name)
+			convertedSpec.FileId = fmt.Sprintf("%s-%s", spec.FileId, config.DocTypeOpenAPI30)
+		}
+		converted[len(result)] = true
+		result = append(result, convertedSpec)
+	}
+
+	return result, converted
+}
+
+// swaggerConversionRenderer converts the Swagger 2.0 content produced by source, or the spec file when source is nil, to OpenAPI 3.0
+func (g *Generator) swaggerConversionRenderer(spec config.SpecMetadata, source renderFunc) renderFunc {
+	if source == nil {
+		source = func() ([]byte, error) {
+			return loader.Read(&spec)
+		}
+	}
+	return func() ([]byte, error) {
+		content, err := source()
+		if err != nil {
+			return nil, err
+		}
+
+		decoded, err := document.Decode(content, spec.Format)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := converter.Swagger20ToOpenAPI30(decoded)
+		if err != nil {
+			return nil, err
+		}
+
+		return document.Encode(result, spec.Format, content)
+	}
+}
+
+// deriveGraphQLSpec serves the introspection result derived from a single GraphQL schema
+// or the schema derived from a single introspection result on the conventional path of the missing spec
+func (g *Generator) deriveGraphQLSpec(spec config.SpecMetadata, specMap map[string]*config.SpecMetadata) {
+	derived := spec
+	var path string
+	var render renderFunc
+	if spec.Type == config.DocTypeIntrospection {
+		path = "/api/graphql-server/schema"
+		derived.Type = config.DocTypeGraphQL
+		derived.Format = config.FormatGraphQL
+		render = sdlFromIntrospectionRenderer(spec)
+	} else {
+		path = "/graphql/introspection"
+		derived.Type = config.DocTypeIntrospection
+		derived.Format = config.FormatJSON
+		render = introspectionFromSDLRenderer(spec)
+	}
+
+	specMap[path] = &derived
+	g.renderers[path] = render
+	g.derivedPaths[path] = true
+}
+
+func introspectionFromSDLRenderer(spec config.SpecMetadata) renderFunc {
+	return func() ([]byte, error) {
+		content, err := loader.Read(&spec)
+		if err != nil {
+			return nil, err
+		}
+
+		schema, err := graphql.Parse(string(content))
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := graphql.ToIntrospection(schema)
+		if err != nil {
+			return nil, err
+		}
+
+		return document.Encode(result, config.FormatJSON, nil)
+	}
+}
+
+func sdlFromIntrospectionRenderer(spec config.SpecMetadata) renderFunc {
+	return func() ([]byte, error) {
+		content, err := loader.Read(&spec)
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := document.Decode(content, config.FormatJSON)
+		if err != nil {
+			return nil, err
+		}
+
+		introspection := graphql.IntrospectionSchema(data)
+		if introspection == nil {
+			return nil, fmt.Errorf("file %s does not contain an introspection schema", spec.FilePath)
+		}
+
+		schema, err := graphql.FromIntrospection(introspection)
+		if err != nil {
+			return nil, err
+		}
+
+		return []byte(graphql.Print(schema)), nil
+	}
+}
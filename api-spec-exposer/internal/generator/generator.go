@@ -6,21 +6,38 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"sort"
 
 	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/loader"
 )
 
+// renderFunc produces the content served for a spec instead of the raw file content
+type renderFunc func() ([]byte, error)
+
 // Generator generates endpoint configurations (@config.EndpointConfig) based on discovered specs
 type Generator struct {
-	specs       []config.SpecMetadata
-	usedFileIds map[string]bool
+	specs        []config.SpecMetadata
+	config       config.DiscoveryConfig
+	usedFileIds  map[string]bool
+	renderers    map[string]renderFunc
+	derivedPaths map[string]bool
+	// convertedPaths marks paths serving Swagger 2.0 specs converted to OpenAPI 3.0
+	convertedPaths map[string]bool
+	// markdownPages holds the handlers of rendered Markdown pages by document path
+	markdownPages map[string]func(w http.ResponseWriter, r *http.Request)
 }
 
 // New creates a new generator
-func New(specs []config.SpecMetadata) *Generator {
+func New(specs []config.SpecMetadata, cfg config.DiscoveryConfig) *Generator {
 	return &Generator{
-		specs:       specs,
-		usedFileIds: make(map[string]bool),
+		specs:          specs,
+		config:         cfg,
+		usedFileIds:    make(map[string]bool),
+		renderers:      make(map[string]renderFunc),
+		derivedPaths:   make(map[string]bool),
+		convertedPaths: make(map[string]bool),
+		markdownPages:  make(map[string]func(w http.ResponseWriter, r *http.Request)),
 	}
 }
 
@@ -41,14 +58,48 @@ func (g *Generator) Generate() []config.EndpointConfig {
 		g.generateGraphQLEndpoints(specsByType[config.ApiTypeGraphQL], specMap, configMap)
 	}
 
-	otherTypesLen := len(specsByType[config.ApiTypeMarkdown]) + len(specsByType[config.ApiTypeUnknown])
+	if len(specsByType[config.ApiTypeOData]) > 0 {
+		g.generateODataEndpoints(specsByType[config.ApiTypeOData], specMap)
+	}
+
+	if len(specsByType[config.ApiTypeAvro]) > 0 {
+		g.generateAvroEndpoints(specsByType[config.ApiTypeAvro], specMap, configMap)
+	}
+
+	otherTypesLen := len(g.specs) - restSpecsLen - gqlSpecsLen
 	if otherTypesLen > 0 {
 		g.generateOtherEndpoints(specsByType, specMap)
 
 		g.generateApihubConfig(specMap, configMap)
 	}
 
-	return g.generateEndpoints(specMap, configMap)
+	if g.config.ApplyOverlays {
+		g.applyOverlays(specMap)
+	}
+
+	if g.config.PostmanExport {
+		g.generatePostmanCollections(specMap)
+	}
+
+	assetEndpoints := g.generateWSDLImports(specMap)
+	if g.config.BundleMarkdownAssets {
+		assetEndpoints = append(assetEndpoints, g.generateMarkdownBundles(specMap)...)
+	}
+
+	endpoints := g.generateEndpoints(specMap, configMap)
+	endpoints = append(endpoints, assetEndpoints...)
+
+	if g.config.MarkdownRendering.Enabled {
+		endpoints = append(endpoints, g.generateMarkdownPages(specMap)...)
+	}
+
+	endpoints = append(endpoints, g.generateDocumentationUI(specMap, configMap)...)
+
+	if g.config.ExposeOperationIndex {
+		endpoints = append(endpoints, g.generateOperationIndexEndpoints(specMap)...)
+	}
+
+	return endpoints
 }
 
 func (g *Generator) generateEndpoints(specMap map[string]*config.SpecMetadata, configMap map[string][]config.ConfigURL) []config.EndpointConfig {
@@ -57,19 +108,18 @@ func (g *Generator) generateEndpoints(specMap map[string]*config.SpecMetadata, c
 	for path, spec := range specMap {
 		specCopy := spec
 		pathCopy := path
-		handler := func(w http.ResponseWriter, r *http.Request) {
-			file, err := os.Open(specCopy.FilePath)
-			if err != nil {
-				http.Error(w, "Failed to read spec file", http.StatusInternalServerError)
-				return
-			}
-			defer file.Close()
-
-			contentType := g.getContentType(specCopy.Format)
-
-			w.Header().Set("Content-Type", contentType)
-			w.WriteHeader(http.StatusOK)
-			io.Copy(w, file)
+		var handler func(w http.ResponseWriter, r *http.Request)
+		if render, ok := g.renderers[path]; ok {
+			handler = g.renderedContentHandler(specCopy, render)
+		} else if !loader.IsPlainFile(specCopy) {
+			handler = g.renderedContentHandler(specCopy, func() ([]byte, error) {
+				return loader.Read(specCopy)
+			})
+		} else {
+			handler = g.fileContentHandler(specCopy)
+		}
+		if g.config.MarkdownRendering.Enabled && specCopy.ApiType == config.ApiTypeMarkdown {
+			handler = negotiateMarkdown(handler, g.markdownPageHandler(pathCopy, specCopy))
 		}
 		endpoints = append(endpoints, config.EndpointConfig{SpecMetadata: *specCopy, Path: pathCopy, Handler: handler})
 	}
@@ -93,16 +143,64 @@ func (g *Generator) generateEndpoints(specMap map[string]*config.SpecMetadata, c
 	return endpoints
 }
 
+func (g *Generator) fileContentHandler(spec *config.SpecMetadata) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		file, err := os.Open(spec.FilePath)
+		if err != nil {
+			http.Error(w, "Failed to read spec file", http.StatusInternalServerError)
+			return
+		}
+		defer file.Close()
+
+		contentType := g.specContentType(spec)
+
+		w.Header().Set("Content-Type", contentType)
+		w.WriteHeader(http.StatusOK)
+		io.Copy(w, file)
+	}
+}
+
+func (g *Generator) renderedContentHandler(spec *config.SpecMetadata, render renderFunc) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		content, err := render()
+		if err != nil {
+			http.Error(w, "Failed to render spec", http.StatusInternalServerError)
+			return
+		}
+
+		contentType := g.specContentType(spec)
+
+		w.Header().Set("Content-Type", contentType)
+		w.WriteHeader(http.StatusOK)
+		w.Write(content)
+	}
+}
+
+// specContentType returns the content type of a spec, including the charset of specs converted to UTF-8
+func (g *Generator) specContentType(spec *config.SpecMetadata) string {
+	contentType := g.getContentType(spec.Format)
+	if spec.Encoding != "" {
+		contentType += "; charset=utf-8"
+	}
+	return contentType
+}
+
 func (g *Generator) getContentType(format config.Format) string {
 	switch format {
 	case config.FormatJSON:
 		return "application/json"
 	case config.FormatYAML:
 		return "application/yaml"
-	case config.FormatGraphQL:
+	case config.FormatGraphQL, config.FormatAvroIDL:
 		return "text/plain"
 	case config.FormatMarkdown:
 		return "text/markdown"
+	case config.FormatXML:
+		return "application/xml"
+	case config.FormatRAML:
+		return "application/raml+yaml"
+	case config.FormatAPIBlueprint:
+		return "text/vnd.apiblueprint"
 	default:
 		return "application/octet-stream"
 	}
@@ -123,9 +221,15 @@ func (g *Generator) generateRestEndpoints(specs []config.SpecMetadata, specMap m
 		return
 	}
 
+	specs, converted := g.applySwaggerConversion(specs)
+
 	if len(specs) == 1 {
 		spec := specs[0]
 		specMap["/v3/api-docs"] = &spec
+		if converted[0] {
+			g.renderers["/v3/api-docs"] = g.swaggerConversionRenderer(spec, nil)
+			g.convertedPaths["/v3/api-docs"] = true
+		}
 		return
 	}
 
@@ -135,11 +239,21 @@ func (g *Generator) generateRestEndpoints(specs []config.SpecMetadata, specMap m
 		path := fmt.Sprintf("/v3/api-docs/%s", g.makeUnique(spec.FileId))
 
 		specMap[path] = spec
+		if converted[i] {
+			g.renderers[path] = g.swaggerConversionRenderer(*spec, nil)
+			g.convertedPaths[path] = true
+		}
 
-		configURLs = append(configURLs, config.ConfigURL{
-			URL:  path,
-			Name: spec.Name,
-		})
+		configURL := config.ConfigURL{
+			URL:         path,
+			Name:        spec.Name,
+			SpecDetails: g.specDetails(spec),
+		}
+		// The type tells the converted specs apart from the original ones
+		if g.config.Swagger2Conversion != config.ConversionDisabled {
+			configURL.Type = string(spec.Type)
+		}
+		configURLs = append(configURLs, configURL)
 	}
 
 	if len(specs) > 1 {
@@ -159,6 +273,9 @@ func (g *Generator) generateGraphQLEndpoints(specs []config.SpecMetadata, specMa
 		} else {
 			specMap["/api/graphql-server/schema"] = &spec
 		}
+		if g.config.DeriveGraphQLSpecs {
+			g.deriveGraphQLSpec(spec, specMap)
+		}
 		return
 	}
 
@@ -194,8 +311,9 @@ func (g *Generator) generateGraphQLEndpoints(specs []config.SpecMetadata, specMa
 		specMap[path] = spec
 
 		configURLs = append(configURLs, config.ConfigURL{
-			URL:  path,
-			Name: spec.Name,
+			URL:         path,
+			Name:        spec.Name,
+			SpecDetails: g.specDetails(spec),
 		})
 	}
 
@@ -204,9 +322,50 @@ func (g *Generator) generateGraphQLEndpoints(specs []config.SpecMetadata, specMa
 	}
 }
 
+// generateODataEndpoints exposes CSDL documents on the conventional OData metadata path,
+// '/odata/$metadata' for a single document and '/odata/{fileId}/$metadata' otherwise
+func (g *Generator) generateODataEndpoints(specs []config.SpecMetadata, specMap map[string]*config.SpecMetadata) {
+	if len(specs) == 1 {
+		spec := specs[0]
+		specMap["/odata/$metadata"] = &spec
+		return
+	}
+
+	for i := range specs {
+		spec := &specs[i]
+		specMap[fmt.Sprintf("/odata/%s/$metadata", g.makeUnique(spec.FileId))] = spec
+	}
+}
+
+// generateAvroEndpoints exposes Avro schemas on '/avro/schemas/{fileId}' and lists them,
+// named by their fully qualified names, on '/avro/schemas/domains'. The id of the listing is reserved among the schemas only,
+// so that a schema with the FileId 'domains' gets a unique path while specs of other types keep it
+func (g *Generator) generateAvroEndpoints(specs []config.SpecMetadata, specMap map[string]*config.SpecMetadata, configMap map[string][]config.ConfigURL) {
+	var configURLs []config.ConfigURL
+
+	domainsUsed := g.usedFileIds["domains"]
+	g.usedFileIds["domains"] = true
+	defer func() { g.usedFileIds["domains"] = domainsUsed }()
+
+	for i := range specs {
+		spec := &specs[i]
+		path := fmt.Sprintf("/avro/schemas/%s", g.makeUnique(spec.FileId))
+		specMap[path] = spec
+
+		configURLs = append(configURLs, config.ConfigURL{
+			URL:         path,
+			Name:        spec.Name,
+			Type:        string(spec.Type),
+			SpecDetails: g.specDetails(spec),
+		})
+	}
+
+	configMap["/avro/schemas/domains"] = configURLs
+}
+
 func (g *Generator) generateOtherEndpoints(specsByType map[config.ApiType][]config.SpecMetadata, specMap map[string]*config.SpecMetadata) {
 	for apiType, specs := range specsByType {
-		if apiType == config.ApiTypeMarkdown || apiType == config.ApiTypeUnknown {
+		if apiType != config.ApiTypeRest && apiType != config.ApiTypeGraphQL && apiType != config.ApiTypeOData && apiType != config.ApiTypeAvro {
 			for i := range specs {
 				spec := &specs[i]
 				path := fmt.Sprintf("/v3/api-docs/%s", g.makeUnique(spec.FileId))
@@ -218,20 +377,45 @@ func (g *Generator) generateOtherEndpoints(specsByType map[config.ApiType][]conf
 
 func (g *Generator) generateApihubConfig(specMap map[string]*config.SpecMetadata, configMap map[string][]config.ConfigURL) {
 	var configURLs []config.ConfigURL
+	orders := make(map[string]int)
 
 	for path, spec := range specMap {
 		url := config.ConfigURL{
-			URL:      path,
-			Name:     spec.Name,
-			Type:     string(spec.Type),
-			XApiKind: spec.XApiKind,
+			URL:         path,
+			Name:        spec.Name,
+			Type:        string(spec.Type),
+			XApiKind:    spec.XApiKind,
+			SpecDetails: g.specDetails(spec),
+		}
+		if url.SpecDetails != nil && len(spec.LinkedSpecs) > 0 {
+			url.LinkedSpecURLs = linkedSpecURLs(spec, specMap)
 		}
 
 		configURLs = append(configURLs, url)
+		orders[path] = spec.Order
 	}
 
+	// Documents with an order go first, the rest are sorted by URL
+	sort.Slice(configURLs, func(i, j int) bool {
+		left, right := orders[configURLs[i].URL], orders[configURLs[j].URL]
+		if left != right {
+			return right == 0 || (left != 0 && left < right)
+		}
+		return configURLs[i].URL < configURLs[j].URL
+	})
+
 	configMap["/v3/api-docs/apihub-swagger-config"] = configURLs
 }
+
+// specDetails returns the spec details to include in config endpoint responses, nil if metadata exposure is disabled
+func (g *Generator) specDetails(spec *config.SpecMetadata) *config.SpecDetails {
+	if !g.config.ExposeMetadata {
+		return nil
+	}
+	details := spec.SpecDetails
+	return &details
+}
+
 func (g *Generator) makeUnique(fileId string) string {
 	if !g.usedFileIds[fileId] {
 		g.usedFileIds[fileId] = true
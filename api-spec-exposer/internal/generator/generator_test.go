@@ -1,11 +1,13 @@
 package generator
 
 import (
+	"archive/zip"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
@@ -19,7 +21,7 @@ func TestGeneratorGroupSpecsByType(t *testing.T) {
 		{ApiType: config.ApiTypeMarkdown, Name: "Doc 1"},
 	}
 
-	gen := New(specs)
+	gen := New(specs, config.DiscoveryConfig{})
 	grouped := gen.groupSpecsByType()
 
 	if len(grouped[config.ApiTypeRest]) != 2 {
@@ -36,7 +38,7 @@ func TestGeneratorGroupSpecsByType(t *testing.T) {
 }
 
 func TestGeneratorGetContentType(t *testing.T) {
-	gen := New([]config.SpecMetadata{})
+	gen := New([]config.SpecMetadata{}, config.DiscoveryConfig{})
 
 	tests := []struct {
 		format   config.Format
@@ -46,6 +48,10 @@ func TestGeneratorGetContentType(t *testing.T) {
 		{config.FormatYAML, "application/yaml"},
 		{config.FormatGraphQL, "text/plain"},
 		{config.FormatMarkdown, "text/markdown"},
+		{config.FormatXML, "application/xml"},
+		{config.FormatRAML, "application/raml+yaml"},
+		{config.FormatAPIBlueprint, "text/vnd.apiblueprint"},
+		{config.FormatAvroIDL, "text/plain"},
 		{config.FormatUnknown, "application/octet-stream"},
 	}
 
@@ -66,7 +72,7 @@ func TestGeneratorMakeUnique(t *testing.T) {
 		{FileId: "other-spec"},
 	}
 
-	gen := New(specs)
+	gen := New(specs, config.DiscoveryConfig{})
 
 	tests := []struct {
 		fileId   string
@@ -109,7 +115,7 @@ func TestGeneratorGenerateGraphQLWithIntrospection(t *testing.T) {
 		},
 	}
 
-	gen := New(specs)
+	gen := New(specs, config.DiscoveryConfig{})
 	endpoints := gen.Generate()
 
 	if len(endpoints) != 2 {
@@ -161,7 +167,7 @@ func TestGeneratorEndpointHandlerJSON(t *testing.T) {
 		},
 	}
 
-	gen := New(specs)
+	gen := New(specs, config.DiscoveryConfig{})
 	endpoints := gen.Generate()
 
 	if len(endpoints) == 0 {
@@ -208,7 +214,7 @@ func TestGeneratorConfigEndpointHandler(t *testing.T) {
 		},
 	}
 
-	gen := New(specs)
+	gen := New(specs, config.DiscoveryConfig{})
 	endpoints := gen.Generate()
 
 	var configEndpoint *config.EndpointConfig
@@ -286,7 +292,7 @@ func TestGeneratorMixedSpecs(t *testing.T) {
 		},
 	}
 
-	gen := New(specs)
+	gen := New(specs, config.DiscoveryConfig{})
 	endpoints := gen.Generate()
 
 	// Should have: 1 REST + 1 GraphQL + 1 Markdown + 1 apihub-config = 4 endpoints
@@ -338,7 +344,7 @@ func TestGeneratorEndpointHandlerFileNotFound(t *testing.T) {
 		},
 	}
 
-	gen := New(specs)
+	gen := New(specs, config.DiscoveryConfig{})
 	endpoints := gen.Generate()
 
 	if len(endpoints) == 0 {
@@ -389,7 +395,7 @@ func TestGeneratorMultipleGraphQLEndpoints(t *testing.T) {
 		},
 	}
 
-	gen := New(specs)
+	gen := New(specs, config.DiscoveryConfig{})
 	endpoints := gen.Generate()
 
 	// Should have 3 schema endpoints + 1 domains config endpoint = 4 endpoints
@@ -409,4 +415,785 @@ func TestGeneratorMultipleGraphQLEndpoints(t *testing.T) {
 	}
 }
 
+func TestGeneratorSwagger2ConversionReplace(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "generator-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	content := []byte(`{"swagger": "2.0", "info": {"title": "Legacy API", "version": "1.0"}, "host": "example.com", "paths": {}}`)
+	filePath := filepath.Join(tempDir, "legacy.json")
+	err = os.WriteFile(filePath, content, 0644)
+	if err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	specs := []config.SpecMetadata{
+		{
+			Name:     "Legacy API",
+			FilePath: filePath,
+			Type:     config.DocTypeOpenAPI20,
+			ApiType:  config.ApiTypeRest,
+			Format:   config.FormatJSON,
+			FileId:   "legacy-json",
+			XApiKind: "BWC",
+		},
+	}
+
+	gen := New(specs, config.DiscoveryConfig{Swagger2Conversion: config.ConversionReplace})
+	endpoints := gen.Generate()
+
+	if len(endpoints) != 1 {
+		t.Fatalf("Expected 1 endpoint, got %d", len(endpoints))
+	}
+
+	if endpoints[0].Type != config.DocTypeOpenAPI30 {
+		t.Errorf("Expected type DocTypeOpenAPI30, got %v", endpoints[0].Type)
+	}
+
+	req := httptest.NewRequest("GET", endpoints[0].Path, nil)
+	w := httptest.NewRecorder()
+
+	endpoints[0].Handler(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+	}
+
+	var converted map[string]interface{}
+	err = json.NewDecoder(resp.Body).Decode(&converted)
+	if err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+
+	if converted["openapi"] != "3.0.3" {
+		t.Errorf("Expected converted document, got openapi '%v'", converted["openapi"])
+	}
+
+	if _, ok := converted["swagger"]; ok {
+		t.Error("Expected 'swagger' field to be removed")
+	}
+}
+
+func TestGeneratorSwagger2ConversionAlongside(t *testing.T) {
+	specs := []config.SpecMetadata{
+		{
+			Name:     "Legacy API",
+			FilePath: "legacy.yaml",
+			Type:     config.DocTypeOpenAPI20,
+			ApiType:  config.ApiTypeRest,
+			Format:   config.FormatYAML,
+			FileId:   "legacy-yaml",
+			XApiKind: "BWC",
+		},
+		{
+			Name:     "Documentation",
+			FilePath: "doc.md",
+			Type:     config.DocTypeMarkdown,
+			ApiType:  config.ApiTypeMarkdown,
+			Format:   config.FormatMarkdown,
+			FileId:   "doc-md",
+			XApiKind: "BWC",
+		},
+	}
+
+	gen := New(specs, config.DiscoveryConfig{Swagger2Conversion: config.ConversionAlongside})
+	endpoints := gen.Generate()
+
+	// Should have: original + converted + swagger-config + markdown + apihub-config = 5 endpoints
+	if len(endpoints) != 5 {
+		t.Fatalf("Expected 5 endpoints, got %d", len(endpoints))
+	}
+
+	endpointsByPath := make(map[string]config.EndpointConfig)
+	for _, endpoint := range endpoints {
+		endpointsByPath[endpoint.Path] = endpoint
+	}
+
+	if endpointsByPath["/v3/api-docs/legacy-yaml"].Type != config.DocTypeOpenAPI20 {
+		t.Error("Expected original OpenAPI 2.0 endpoint")
+	}
+
+	if endpointsByPath["/v3/api-docs/legacy-yaml-openapi-3-0"].Type != config.DocTypeOpenAPI30 {
+		t.Error("Expected converted OpenAPI 3.0 endpoint")
+	}
+
+	apihubConfig, ok := endpointsByPath["/v3/api-docs/apihub-swagger-config"]
+	if !ok {
+		t.Fatal("Expected apihub-swagger-config endpoint")
+	}
+
+	req := httptest.NewRequest("GET", apihubConfig.Path, nil)
+	w := httptest.NewRecorder()
+
+	apihubConfig.Handler(w, req)
+
+	var apiConfig config.ApiSpecConfig
+	err := json.NewDecoder(w.Result().Body).Decode(&apiConfig)
+	if err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+
+	var convertedURL *config.ConfigURL
+	for i := range apiConfig.URLs {
+		if apiConfig.URLs[i].URL == "/v3/api-docs/legacy-yaml-openapi-3-0" {
+			convertedURL = &apiConfig.URLs[i]
+		}
+	}
+
+	if convertedURL == nil {
+		t.Fatal("Expected converted spec in apihub-swagger-config")
+	}
+
+	if convertedURL.Type != string(config.DocTypeOpenAPI30) {
+		t.Errorf("Expected type '%s', got '%s'", config.DocTypeOpenAPI30, convertedURL.Type)
+	}
+}
+
+func TestGeneratorSwagger2ConversionSwaggerConfigTypes(t *testing.T) {
+	specs := []config.SpecMetadata{
+		{Name: "Legacy API", FilePath: "legacy.yaml", Type: config.DocTypeOpenAPI20, ApiType: config.ApiTypeRest, Format: config.FormatYAML, FileId: "legacy-yaml"},
+		{Name: "Pets API", FilePath: "pets.yaml", Type: config.DocTypeOpenAPI31, ApiType: config.ApiTypeRest, Format: config.FormatYAML, FileId: "pets-yaml"},
+	}
+
+	tests := []struct {
+		mode     config.ConversionMode
+		expected map[string]string
+	}{
+		{
+			mode: config.ConversionReplace,
+			expected: map[string]string{
+				"/v3/api-docs/legacy-yaml": string(config.DocTypeOpenAPI30),
+				"/v3/api-docs/pets-yaml":   string(config.DocTypeOpenAPI31),
+			},
+		},
+		{
+			mode: config.ConversionAlongside,
+			expected: map[string]string{
+				"/v3/api-docs/legacy-yaml":             string(config.DocTypeOpenAPI20),
+				"/v3/api-docs/legacy-yaml-openapi-3-0": string(config.DocTypeOpenAPI30),
+				"/v3/api-docs/pets-yaml":               string(config.DocTypeOpenAPI31),
+			},
+		},
+		{
+			mode: config.ConversionDisabled,
+			expected: map[string]string{
+				"/v3/api-docs/legacy-yaml": "",
+				"/v3/api-docs/pets-yaml":   "",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.mode), func(t *testing.T) {
+			endpoints := New(specs, config.DiscoveryConfig{Swagger2Conversion: tt.mode}).Generate()
+
+			var swaggerConfig *config.EndpointConfig
+			for i := range endpoints {
+				if endpoints[i].Path == "/v3/api-docs/swagger-config" {
+					swaggerConfig = &endpoints[i]
+				}
+			}
+			if swaggerConfig == nil {
+				t.Fatal("Expected swagger-config endpoint")
+			}
+
+			w := httptest.NewRecorder()
+			swaggerConfig.Handler(w, httptest.NewRequest("GET", swaggerConfig.Path, nil))
+
+			var apiConfig config.ApiSpecConfig
+			if err := json.NewDecoder(w.Result().Body).Decode(&apiConfig); err != nil {
+				t.Fatalf("Failed to decode response: %v", err)
+			}
+			if len(apiConfig.URLs) != len(tt.expected) {
+				t.Fatalf("Expected %d URLs, got %d", len(tt.expected), len(apiConfig.URLs))
+			}
+			for _, url := range apiConfig.URLs {
+				if expected, ok := tt.expected[url.URL]; !ok || url.Type != expected {
+					t.Errorf("Expected type '%s' for %s, got '%s'", expected, url.URL, url.Type)
+				}
+			}
+		})
+	}
+}
+
+func TestGeneratorConfigEndpointExposeMetadata(t *testing.T) {
+	specs := []config.SpecMetadata{
+		{
+			Name:     "API 1",
+			FilePath: "api1.json",
+			Type:     config.DocTypeOpenAPI30,
+			ApiType:  config.ApiTypeRest,
+			Format:   config.FormatJSON,
+			FileId:   "api-1",
+			XApiKind: "BWC",
+			SpecDetails: config.SpecDetails{
+				Version:        "1.0.0",
+				Description:    "First API",
+				Tags:           []string{"users"},
+				OperationCount: 3,
+			},
+		},
+		{
+			Name:     "API 2",
+			FilePath: "api2.json",
+			Type:     config.DocTypeOpenAPI30,
+			ApiType:  config.ApiTypeRest,
+			Format:   config.FormatJSON,
+			FileId:   "api-2",
+			XApiKind: "BWC",
+		},
+	}
+
+	tests := []struct {
+		name           string
+		exposeMetadata bool
+	}{
+		{"metadata exposed", true},
+		{"metadata hidden", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gen := New(specs, config.DiscoveryConfig{ExposeMetadata: tt.exposeMetadata})
+			endpoints := gen.Generate()
+
+			var configEndpoint *config.EndpointConfig
+			for i := range endpoints {
+				if endpoints[i].Path == "/v3/api-docs/swagger-config" {
+					configEndpoint = &endpoints[i]
+				}
+			}
+
+			if configEndpoint == nil {
+				t.Fatal("Expected swagger-config endpoint")
+			}
+
+			req := httptest.NewRequest("GET", configEndpoint.Path, nil)
+			w := httptest.NewRecorder()
+
+			configEndpoint.Handler(w, req)
+
+			var response map[string]interface{}
+			err := json.NewDecoder(w.Result().Body).Decode(&response)
+			if err != nil {
+				t.Fatalf("Failed to decode response: %v", err)
+			}
+
+			var first map[string]interface{}
+			for _, item := range response["urls"].([]interface{}) {
+				if url := item.(map[string]interface{}); url["url"] == "/v3/api-docs/api-1" {
+					first = url
+				}
+			}
+
+			if first == nil {
+				t.Fatal("Expected config URL for API 1")
+			}
+
+			if tt.exposeMetadata {
+				if first["version"] != "1.0.0" || first["description"] != "First API" || first["operationCount"] != float64(3) {
+					t.Errorf("Expected spec details in config URL, got %v", first)
+				}
+			} else if _, ok := first["version"]; ok {
+				t.Errorf("Expected no spec details in config URL, got %v", first)
+			}
+		})
+	}
+}
+
+func TestGeneratorDeriveGraphQLSpecs(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "generator-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	schemaPath := filepath.Join(tempDir, "schema.graphql")
+	err = os.WriteFile(schemaPath, []byte("type Query {\n  users: [String]\n}\n"), 0644)
+	if err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	introspectionPath := filepath.Join(tempDir, "introspection.json")
+	err = os.WriteFile(introspectionPath, []byte(`{"__schema": {"queryType": {"name": "Query"}, "types": [
+		{"kind": "OBJECT", "name": "Query", "fields": [{"name": "users", "args": [], "type": {"kind": "LIST", "ofType": {"kind": "SCALAR", "name": "String"}}}]},
+		{"kind": "SCALAR", "name": "String"}
+	]}}`), 0644)
+	if err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		spec        config.SpecMetadata
+		derivedPath string
+		contentType string
+		expected    string
+	}{
+		{
+			name:        "introspection from schema",
+			spec:        config.SpecMetadata{Name: "schema", FilePath: schemaPath, Type: config.DocTypeGraphQL, ApiType: config.ApiTypeGraphQL, Format: config.FormatGraphQL, FileId: "schema-graphql"},
+			derivedPath: "/graphql/introspection",
+			contentType: "application/json",
+		},
+		{
+			name:        "schema from introspection",
+			spec:        config.SpecMetadata{Name: "introspection", FilePath: introspectionPath, Type: config.DocTypeIntrospection, ApiType: config.ApiTypeGraphQL, Format: config.FormatJSON, FileId: "introspection-json"},
+			derivedPath: "/api/graphql-server/schema",
+			contentType: "text/plain",
+			expected:    "type Query {\n  users: [String]\n}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			endpoints := New([]config.SpecMetadata{tt.spec}, config.DiscoveryConfig{}).Generate()
+			if len(endpoints) != 1 {
+				t.Errorf("Expected 1 endpoint without derivation, got %d", len(endpoints))
+			}
+
+			endpoints = New([]config.SpecMetadata{tt.spec}, config.DiscoveryConfig{DeriveGraphQLSpecs: true, ExposeOperationIndex: true}).Generate()
+
+			var derived *config.EndpointConfig
+			indexEndpoints := 0
+			for i := range endpoints {
+				if endpoints[i].Path == tt.derivedPath {
+					derived = &endpoints[i]
+				}
+				if endpoints[i].Path == "/api-index/"+tt.spec.FileId {
+					indexEndpoints++
+				}
+			}
+
+			if derived == nil {
+				t.Fatalf("Expected derived endpoint %s", tt.derivedPath)
+			}
+
+			if indexEndpoints != 1 {
+				t.Errorf("Expected derived spec to be excluded from operation index, got %d index endpoints", indexEndpoints)
+			}
+
+			req := httptest.NewRequest("GET", derived.Path, nil)
+			w := httptest.NewRecorder()
+
+			derived.Handler(w, req)
+
+			if w.Code != http.StatusOK {
+				t.Fatalf("Expected status 200, got %d", w.Code)
+			}
+
+			if contentType := w.Header().Get("Content-Type"); contentType != tt.contentType {
+				t.Errorf("Expected Content-Type '%s', got '%s'", tt.contentType, contentType)
+			}
+
+			if tt.expected != "" && w.Body.String() != tt.expected {
+				t.Errorf("Expected body %q, got %q", tt.expected, w.Body.String())
+			}
 
+			if tt.contentType == "application/json" {
+				var response map[string]interface{}
+				if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+					t.Fatalf("Failed to decode response: %v", err)
+				}
+				schema := response["data"].(map[string]interface{})["__schema"].(map[string]interface{})
+				if schema["queryType"].(map[string]interface{})["name"] != "Query" {
+					t.Errorf("Expected query type 'Query', got %v", schema["queryType"])
+				}
+			}
+		})
+	}
+}
+
+func TestGeneratorStitchedGraphQLSchema(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "generator-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	files := map[string]string{
+		"query.graphqls":  "type Query { users: [User] }",
+		"users.graphqls":  "type User { id: ID! }",
+		"orders.graphqls": "extend type Query { orders: [String] }",
+	}
+	var sourceFiles []string
+	for _, name := range []string{"query.graphqls", "users.graphqls", "orders.graphqls"} {
+		path := filepath.Join(tempDir, name)
+		if err := os.WriteFile(path, []byte(files[name]), 0644); err != nil {
+			t.Fatalf("Failed to write test file: %v", err)
+		}
+		sourceFiles = append(sourceFiles, path)
+	}
+
+	specs := []config.SpecMetadata{
+		{
+			Name:        "schema",
+			FilePath:    tempDir,
+			Type:        config.DocTypeGraphQL,
+			ApiType:     config.ApiTypeGraphQL,
+			Format:      config.FormatGraphQL,
+			FileId:      "schema",
+			SourceFiles: sourceFiles,
+		},
+	}
+
+	endpoints := New(specs, config.DiscoveryConfig{}).Generate()
+	if len(endpoints) != 1 || endpoints[0].Path != "/api/graphql-server/schema" {
+		t.Fatalf("Expected single schema endpoint, got %v", endpoints)
+	}
+
+	req := httptest.NewRequest("GET", endpoints[0].Path, nil)
+	w := httptest.NewRecorder()
+
+	endpoints[0].Handler(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", w.Code)
+	}
+
+	expected := "type Query {\n  users: [User]\n  orders: [String]\n}\n\ntype User {\n  id: ID!\n}\n"
+	if w.Body.String() != expected {
+		t.Errorf("Expected merged schema %q, got %q", expected, w.Body.String())
+	}
+}
+
+func TestGeneratorApihubConfigOrder(t *testing.T) {
+	specs := []config.SpecMetadata{
+		{Name: "API", FilePath: "api.json", Type: config.DocTypeOpenAPI30, ApiType: config.ApiTypeRest, Format: config.FormatJSON, FileId: "api"},
+		{Name: "Appendix", FilePath: "appendix.md", Type: config.DocTypeMarkdown, ApiType: config.ApiTypeMarkdown, Format: config.FormatMarkdown, FileId: "appendix"},
+		{Name: "Overview", FilePath: "overview.md", Type: config.DocTypeMarkdown, ApiType: config.ApiTypeMarkdown, Format: config.FormatMarkdown, FileId: "overview", SpecDetails: config.SpecDetails{MarkdownDetails: config.MarkdownDetails{Order: 1}}},
+		{Name: "Guide", FilePath: "guide.md", Type: config.DocTypeMarkdown, ApiType: config.ApiTypeMarkdown, Format: config.FormatMarkdown, FileId: "guide", SpecDetails: config.SpecDetails{MarkdownDetails: config.MarkdownDetails{Order: 2}}},
+	}
+
+	endpoints := New(specs, config.DiscoveryConfig{}).Generate()
+
+	var apihubConfig *config.EndpointConfig
+	for i := range endpoints {
+		if endpoints[i].Path == "/v3/api-docs/apihub-swagger-config" {
+			apihubConfig = &endpoints[i]
+		}
+	}
+	if apihubConfig == nil {
+		t.Fatal("Expected apihub-swagger-config endpoint")
+	}
+
+	req := httptest.NewRequest("GET", apihubConfig.Path, nil)
+	w := httptest.NewRecorder()
+
+	apihubConfig.Handler(w, req)
+
+	var apiConfig config.ApiSpecConfig
+	if err := json.NewDecoder(w.Result().Body).Decode(&apiConfig); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+
+	var names []string
+	for _, url := range apiConfig.URLs {
+		names = append(names, url.Name)
+	}
+
+	expected := []string{"Overview", "Guide", "API", "Appendix"}
+	if strings.Join(names, ",") != strings.Join(expected, ",") {
+		t.Errorf("Expected order %v, got %v", expected, names)
+	}
+}
+
+func TestGeneratorJSONSchemaSpecs(t *testing.T) {
+	specs := []config.SpecMetadata{
+		{Name: "API", FilePath: "api.json", Type: config.DocTypeOpenAPI30, ApiType: config.ApiTypeRest, Format: config.FormatJSON, FileId: "api"},
+		{Name: "Order Created", FilePath: "order-created.json", Type: config.DocTypeJSONSchema, ApiType: config.ApiTypeJSONSchema, Format: config.FormatJSON, FileId: "order-created-json"},
+	}
+
+	endpoints := New(specs, config.DiscoveryConfig{}).Generate()
+
+	var apihubConfig *config.EndpointConfig
+	schemaExposed := false
+	for i := range endpoints {
+		switch endpoints[i].Path {
+		case "/v3/api-docs/apihub-swagger-config":
+			apihubConfig = &endpoints[i]
+		case "/v3/api-docs/order-created-json":
+			schemaExposed = true
+		}
+	}
+	if !schemaExposed {
+		t.Error("Expected JSON Schema endpoint")
+	}
+	if apihubConfig == nil {
+		t.Fatal("Expected apihub-swagger-config endpoint")
+	}
+
+	w := httptest.NewRecorder()
+	apihubConfig.Handler(w, httptest.NewRequest("GET", apihubConfig.Path, nil))
+
+	var apiConfig config.ApiSpecConfig
+	if err := json.NewDecoder(w.Result().Body).Decode(&apiConfig); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+
+	var types []string
+	for _, url := range apiConfig.URLs {
+		types = append(types, url.Type)
+	}
+	expected := []string{"openapi-3-0", "json-schema"}
+	if strings.Join(types, ",") != strings.Join(expected, ",") {
+		t.Errorf("Expected types %v, got %v", expected, types)
+	}
+}
+
+func TestGeneratorODataSpecs(t *testing.T) {
+	single := []config.SpecMetadata{
+		{Name: "Sales", FilePath: "metadata.xml", Type: config.DocTypeODataCSDLXML, ApiType: config.ApiTypeOData, Format: config.FormatXML, FileId: "metadata-xml"},
+	}
+	multiple := []config.SpecMetadata{
+		{Name: "Sales", FilePath: "sales.xml", Type: config.DocTypeODataCSDLXML, ApiType: config.ApiTypeOData, Format: config.FormatXML, FileId: "sales-xml"},
+		{Name: "Billing", FilePath: "billing.json", Type: config.DocTypeODataCSDLJSON, ApiType: config.ApiTypeOData, Format: config.FormatJSON, FileId: "billing-json"},
+	}
+
+	tests := []struct {
+		name     string
+		specs    []config.SpecMetadata
+		expected []string
+	}{
+		{"single document", single, []string{"/odata/$metadata"}},
+		{"multiple documents", multiple, []string{"/odata/billing-json/$metadata", "/odata/sales-xml/$metadata"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			endpoints := New(tt.specs, config.DiscoveryConfig{}).Generate()
+
+			var apihubConfig *config.EndpointConfig
+			for i := range endpoints {
+				if endpoints[i].Path == "/v3/api-docs/apihub-swagger-config" {
+					apihubConfig = &endpoints[i]
+				}
+			}
+			if apihubConfig == nil {
+				t.Fatal("Expected apihub-swagger-config endpoint")
+			}
+
+			w := httptest.NewRecorder()
+			apihubConfig.Handler(w, httptest.NewRequest("GET", apihubConfig.Path, nil))
+
+			var apiConfig config.ApiSpecConfig
+			if err := json.NewDecoder(w.Result().Body).Decode(&apiConfig); err != nil {
+				t.Fatalf("Failed to decode response: %v", err)
+			}
+
+			var urls []string
+			for _, url := range apiConfig.URLs {
+				urls = append(urls, url.URL)
+			}
+			if strings.Join(urls, ",") != strings.Join(tt.expected, ",") {
+				t.Errorf("Expected URLs %v, got %v", tt.expected, urls)
+			}
+		})
+	}
+}
+
+func TestGeneratorAvroSpecs(t *testing.T) {
+	specs := []config.SpecMetadata{
+		{Name: "com.example.orders.OrderCreated", FilePath: "order-created.avsc", Type: config.DocTypeAvroSchema, ApiType: config.ApiTypeAvro, Format: config.FormatJSON, FileId: "order-created-avsc"},
+		{Name: "com.example.Orders", FilePath: "orders.avdl", Type: config.DocTypeAvroIDL, ApiType: config.ApiTypeAvro, Format: config.FormatAvroIDL, FileId: "orders-avdl"},
+	}
+
+	endpoints := New(specs, config.DiscoveryConfig{}).Generate()
+
+	endpointsByPath := make(map[string]config.EndpointConfig)
+	for _, endpoint := range endpoints {
+		endpointsByPath[endpoint.Path] = endpoint
+	}
+
+	for _, path := range []string{"/avro/schemas/order-created-avsc", "/avro/schemas/orders-avdl", "/avro/schemas/domains", "/v3/api-docs/apihub-swagger-config"} {
+		if _, ok := endpointsByPath[path]; !ok {
+			t.Errorf("Expected endpoint %s", path)
+		}
+	}
+	if len(endpoints) != 4 {
+		t.Errorf("Expected 4 endpoints, got %d", len(endpoints))
+	}
+
+	domains := endpointsByPath["/avro/schemas/domains"]
+	w := httptest.NewRecorder()
+	domains.Handler(w, httptest.NewRequest("GET", domains.Path, nil))
+
+	var apiConfig config.ApiSpecConfig
+	if err := json.NewDecoder(w.Result().Body).Decode(&apiConfig); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if len(apiConfig.URLs) != 2 {
+		t.Fatalf("Expected 2 URLs, got %d", len(apiConfig.URLs))
+	}
+	if apiConfig.URLs[0].URL != "/avro/schemas/order-created-avsc" || apiConfig.URLs[0].Name != "com.example.orders.OrderCreated" || apiConfig.URLs[0].Type != "avro-schema" {
+		t.Errorf("Unexpected first URL: %+v", apiConfig.URLs[0])
+	}
+
+	idl := endpointsByPath["/avro/schemas/orders-avdl"]
+	if idl.Format != config.FormatAvroIDL {
+		t.Errorf("Expected IDL format, got %s", idl.Format)
+	}
+}
+
+func TestGeneratorMultiDocumentSpecs(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "generator-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	bundle := filepath.Join(tempDir, "bundle.yaml")
+	content := "kind: ConfigMap\n---\nopenapi: 3.0.0\ninfo:\n  title: Pets\n---\nswagger: \"2.0\"\ninfo:\n  title: Legacy\n  version: 1.0.0\nhost: legacy.example.com\npaths: {}\n"
+	if err := os.WriteFile(bundle, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	specs := []config.SpecMetadata{
+		{Name: "Pets", FilePath: bundle, Type: config.DocTypeOpenAPI30, ApiType: config.ApiTypeRest, Format: config.FormatYAML, FileId: "bundle-yaml-doc-2", DocumentIndex: 2},
+		{Name: "Legacy", FilePath: bundle, Type: config.DocTypeOpenAPI20, ApiType: config.ApiTypeRest, Format: config.FormatYAML, FileId: "bundle-yaml-doc-3", DocumentIndex: 3},
+	}
+	cfg := config.DiscoveryConfig{ScanDirectory: tempDir, Swagger2Conversion: config.ConversionReplace}
+	endpoints := New(specs, cfg).Generate()
+
+	endpointsByPath := make(map[string]config.EndpointConfig)
+	for _, endpoint := range endpoints {
+		endpointsByPath[endpoint.Path] = endpoint
+	}
+
+	tests := []struct {
+		path     string
+		expected string
+	}{
+		{"/v3/api-docs/bundle-yaml-doc-2", "---\nopenapi: 3.0.0\ninfo:\n  title: Pets\n"},
+		{"/v3/api-docs/bundle-yaml-doc-3", "openapi: 3.0.3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			endpoint, ok := endpointsByPath[tt.path]
+			if !ok {
+				t.Fatalf("Expected endpoint %s", tt.path)
+			}
+			w := httptest.NewRecorder()
+			endpoint.Handler(w, httptest.NewRequest("GET", endpoint.Path, nil))
+			if w.Code != http.StatusOK {
+				t.Fatalf("Expected status 200, got %d", w.Code)
+			}
+			body := w.Body.String()
+			if !strings.Contains(body, tt.expected) {
+				t.Errorf("Expected document containing %q, got %q", tt.expected, body)
+			}
+			if strings.Contains(body, "ConfigMap") {
+				t.Errorf("Expected only the spec document to be served, got %q", body)
+			}
+		})
+	}
+}
+
+func TestGeneratorArchiveSpecs(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "generator-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	archivePath := filepath.Join(tempDir, "api-specs.zip")
+	file, err := os.Create(archivePath)
+	if err != nil {
+		t.Fatalf("Failed to create archive: %v", err)
+	}
+	writer := zip.NewWriter(file)
+	entry, err := writer.Create("openapi/pets.yaml")
+	if err != nil {
+		t.Fatalf("Failed to add archive entry: %v", err)
+	}
+	content := "openapi: 3.0.0\ninfo:\n  title: Pets\n"
+	entry.Write([]byte(content))
+	writer.Close()
+	file.Close()
+
+	specs := []config.SpecMetadata{
+		{Name: "Pets", FilePath: filepath.Join(archivePath, "openapi", "pets.yaml"), Archive: archivePath, Type: config.DocTypeOpenAPI30, ApiType: config.ApiTypeRest, Format: config.FormatYAML, FileId: "pets-yaml"},
+	}
+	endpoints := New(specs, config.DiscoveryConfig{ScanDirectory: tempDir}).Generate()
+	if len(endpoints) != 1 || endpoints[0].Path != "/v3/api-docs" {
+		t.Fatalf("Expected a single REST endpoint, got %v", endpoints)
+	}
+
+	w := httptest.NewRecorder()
+	endpoints[0].Handler(w, httptest.NewRequest("GET", endpoints[0].Path, nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", w.Code)
+	}
+	if w.Body.String() != content {
+		t.Errorf("Expected content %q, got %q", content, w.Body.String())
+	}
+}
+
+func TestGeneratorConvertedEncodings(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "generator-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	content := `{"openapi": "3.0.0", "info": {"title": "Café"}}`
+	utf16BE := []byte{0xfe, 0xff}
+	for _, r := range content {
+		utf16BE = append(utf16BE, byte(r>>8), byte(r))
+	}
+	filePath := filepath.Join(tempDir, "pets.json")
+	if err := os.WriteFile(filePath, utf16BE, 0644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	specs := []config.SpecMetadata{
+		{Name: "Café", FilePath: filePath, Encoding: "UTF-16BE", Type: config.DocTypeOpenAPI30, ApiType: config.ApiTypeRest, Format: config.FormatJSON, FileId: "pets-json"},
+	}
+	endpoints := New(specs, config.DiscoveryConfig{ScanDirectory: tempDir}).Generate()
+	if len(endpoints) != 1 || endpoints[0].Path != "/v3/api-docs" {
+		t.Fatalf("Expected a single REST endpoint, got %v", endpoints)
+	}
+
+	w := httptest.NewRecorder()
+	endpoints[0].Handler(w, httptest.NewRequest("GET", endpoints[0].Path, nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", w.Code)
+	}
+	if contentType := w.Header().Get("Content-Type"); contentType != "application/json; charset=utf-8" {
+		t.Errorf("Expected Content-Type 'application/json; charset=utf-8', got '%s'", contentType)
+	}
+	if w.Body.String() != content {
+		t.Errorf("Expected content %q, got %q", content, w.Body.String())
+	}
+}
+
+func TestGeneratorAvroDomainsFileId(t *testing.T) {
+	specs := []config.SpecMetadata{
+		{Name: "com.example.Domains", FilePath: "domains", Type: config.DocTypeAvroSchema, ApiType: config.ApiTypeAvro, Format: config.FormatJSON, FileId: "domains"},
+		{Name: "domains", FilePath: "domains.md", Type: config.DocTypeMarkdown, ApiType: config.ApiTypeMarkdown, Format: config.FormatMarkdown, FileId: "domains"},
+	}
+
+	endpoints := New(specs, config.DiscoveryConfig{}).Generate()
+
+	mux := http.NewServeMux()
+	paths := make(map[string]bool)
+	for _, endpoint := range endpoints {
+		if paths[endpoint.Path] {
+			t.Fatalf("Expected unique endpoint paths, got %s twice", endpoint.Path)
+		}
+		paths[endpoint.Path] = true
+		mux.HandleFunc(endpoint.Path, endpoint.Handler)
+	}
+	if !paths["/avro/schemas/domains-1"] || !paths["/avro/schemas/domains"] {
+		t.Errorf("Expected the schema on /avro/schemas/domains-1 and the listing on /avro/schemas/domains, got %v", paths)
+	}
+	if !paths["/v3/api-docs/domains"] {
+		t.Errorf("Expected the Markdown document to keep the 'domains' id, got %v", paths)
+	}
+}
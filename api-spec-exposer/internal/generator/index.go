@@ -0,0 +1,196 @@
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/document"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/graphql"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/loader"
+)
+
+const operationIndexPath = "/api-index"
+
+// generateOperationIndexEndpoints generates operation index endpoints for REST and GraphQL specs exposed in specMap
+func (g *Generator) generateOperationIndexEndpoints(specMap map[string]*config.SpecMetadata) []config.EndpointConfig {
+	var paths []string
+	for path, spec := range specMap {
+		if (spec.ApiType == config.ApiTypeRest || spec.ApiType == config.ApiTypeGraphQL) && !g.derivedPaths[path] {
+			paths = append(paths, path)
+		}
+	}
+	if len(paths) == 0 {
+		return nil
+	}
+	sort.Strings(paths)
+
+	var endpoints []config.EndpointConfig
+	for _, path := range paths {
+		specPath := path
+		spec := specMap[path]
+		indexPath := fmt.Sprintf("%s/%s", operationIndexPath, g.indexId(specPath, spec))
+		endpoints = append(endpoints, config.EndpointConfig{
+			Path: indexPath,
+			Handler: g.jsonHandler(func() (interface{}, error) {
+				return g.buildOperationIndex(specPath, spec)
+			}),
+		})
+	}
+
+	endpoints = append(endpoints, config.EndpointConfig{
+		Path: operationIndexPath,
+		Handler: g.jsonHandler(func() (interface{}, error) {
+			combined := config.CombinedOperationIndex{Specs: make([]config.OperationIndex, 0, len(paths))}
+			for _, path := range paths {
+				// A spec that cannot be read or parsed is reported in its entry instead of failing the whole index
+				index, err := g.buildOperationIndex(path, specMap[path])
+				if err != nil {
+					index.Error = err.Error()
+				}
+				combined.Specs = append(combined.Specs, index)
+			}
+			return combined, nil
+		}),
+	})
+
+	return endpoints
+}
+
+func (g *Generator) jsonHandler(build func() (interface{}, error)) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		response, err := build()
+		if err != nil {
+			http.Error(w, "Failed to build operation index", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(response)
+	}
+}
+
+// indexId returns the id of the spec in its path, e.g. 'pets-yaml' of '/v3/api-docs/pets-yaml'.
+// Specs served on a path without an id, such as a single REST spec on '/v3/api-docs', get a unique id from their FileId
+func (g *Generator) indexId(specPath string, spec *config.SpecMetadata) string {
+	for _, prefix := range []string{"/v3/api-docs/", "/api/graphql-server/schema/", "/graphql/introspection/"} {
+		if id := strings.TrimPrefix(specPath, prefix); id != specPath && !strings.Contains(id, "/") {
+			return id
+		}
+	}
+	return g.makeUnique(spec.FileId)
+}
+
+// buildOperationIndex lists the REST operations or GraphQL root fields of the spec served on path,
+// converted specs and specs with overlays applied are indexed as they are served
+func (g *Generator) buildOperationIndex(path string, spec *config.SpecMetadata) (config.OperationIndex, error) {
+	index := config.OperationIndex{
+		URL:        path,
+		Name:       spec.Name,
+		Type:       string(spec.Type),
+		Operations: []config.OperationIndexItem{},
+	}
+
+	var content []byte
+	var err error
+	if render, ok := g.renderers[path]; ok {
+		content, err = render()
+	} else {
+		content, err = loader.Read(spec)
+	}
+	if err != nil {
+		return index, err
+	}
+
+	switch spec.Type {
+	case config.DocTypeGraphQL:
+		schema, err := graphql.Parse(string(content))
+		if err != nil {
+			return index, err
+		}
+		index.Operations = append(index.Operations, graphQLIndexItems(schema.RootTypes(), schema.ObjectFields)...)
+	case config.DocTypeIntrospection:
+		data, err := document.Decode(content, config.FormatJSON)
+		if err != nil {
+			return index, err
+		}
+		schema := graphql.IntrospectionSchema(data)
+		index.Operations = append(index.Operations, graphQLIndexItems(graphql.IntrospectionRootTypes(schema), func(typeName string) []graphql.Field {
+			return graphql.IntrospectionFields(schema, typeName)
+		})...)
+	default:
+		data, err := document.Decode(content, spec.Format)
+		if err != nil {
+			return index, err
+		}
+		index.Operations = append(index.Operations, restIndexItems(data, spec.Type)...)
+	}
+
+	return index, nil
+}
+
+func restIndexItems(data map[string]interface{}, docType config.DocumentType) []config.OperationIndexItem {
+	var items []config.OperationIndexItem
+
+	paths, _ := data["paths"].(map[string]interface{})
+	for _, path := range document.SortedKeys(paths) {
+		pathItem, ok := paths[path].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, method := range document.OperationMethodsOf(docType) {
+			operation, ok := pathItem[method].(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			item := config.OperationIndexItem{
+				Method: strings.ToUpper(method),
+				Path:   path,
+			}
+			item.OperationId, _ = operation["operationId"].(string)
+			item.Summary, _ = operation["summary"].(string)
+			item.Deprecated, _ = operation["deprecated"].(bool)
+			if tags, ok := operation["tags"].([]interface{}); ok {
+				for _, tag := range tags {
+					if name, ok := tag.(string); ok {
+						item.Tags = append(item.Tags, name)
+					}
+				}
+			}
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+func graphQLIndexItems(roots graphql.RootTypes, fields func(typeName string) []graphql.Field) []config.OperationIndexItem {
+	var items []config.OperationIndexItem
+
+	operationTypes := []struct {
+		operationType string
+		typeName      string
+	}{
+		{"query", roots.Query},
+		{"mutation", roots.Mutation},
+		{"subscription", roots.Subscription},
+	}
+
+	for _, operationType := range operationTypes {
+		for _, field := range fields(operationType.typeName) {
+			items = append(items, config.OperationIndexItem{
+				OperationType: operationType.operationType,
+				Name:          field.Name,
+				Summary:       field.Description,
+				Deprecated:    field.Deprecated,
+			})
+		}
+	}
+
+	return items
+}
@@ -0,0 +1,302 @@
+package generator
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+)
+
+func TestGeneratorOperationIndexDisabled(t *testing.T) {
+	specs := []config.SpecMetadata{
+		{Name: "API", FilePath: "api.json", Type: config.DocTypeOpenAPI30, ApiType: config.ApiTypeRest, Format: config.FormatJSON, FileId: "api"},
+	}
+
+	endpoints := New(specs, config.DiscoveryConfig{}).Generate()
+	for _, endpoint := range endpoints {
+		if endpoint.Path == "/api-index" || endpoint.Path == "/api-index/api" {
+			t.Errorf("Expected no operation index endpoints, got %s", endpoint.Path)
+		}
+	}
+}
+
+func TestGeneratorOperationIndex(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "generator-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	restContent := `openapi: 3.0.0
+info:
+  title: Users API
+  version: 1.0.0
+paths:
+  /users:
+    post:
+      operationId: createUser
+      tags: [users]
+    get:
+      operationId: listUsers
+      summary: List users
+      tags: [users]
+  /users/{id}:
+    delete:
+      operationId: deleteUser
+      deprecated: true
+`
+	graphqlContent := `type Query {
+	users: [User]
+	user(id: ID!): User @deprecated(reason: "Use users")
+}
+
+type Mutation {
+	createUser(name: String!): User
+}
+
+type User {
+	id: ID!
+}
+`
+	restPath := filepath.Join(tempDir, "users.yaml")
+	graphqlPath := filepath.Join(tempDir, "schema.graphql")
+	if err := os.WriteFile(restPath, []byte(restContent), 0644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+	if err := os.WriteFile(graphqlPath, []byte(graphqlContent), 0644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	specs := []config.SpecMetadata{
+		{Name: "Users API", FilePath: restPath, Type: config.DocTypeOpenAPI30, ApiType: config.ApiTypeRest, Format: config.FormatYAML, FileId: "users-yaml"},
+		{Name: "schema", FilePath: graphqlPath, Type: config.DocTypeGraphQL, ApiType: config.ApiTypeGraphQL, Format: config.FormatGraphQL, FileId: "schema-graphql"},
+		{Name: "Guide", FilePath: "guide.md", Type: config.DocTypeMarkdown, ApiType: config.ApiTypeMarkdown, Format: config.FormatMarkdown, FileId: "guide-md"},
+	}
+
+	endpoints := New(specs, config.DiscoveryConfig{ExposeOperationIndex: true}).Generate()
+
+	endpointMap := make(map[string]config.EndpointConfig)
+	for _, endpoint := range endpoints {
+		endpointMap[endpoint.Path] = endpoint
+	}
+
+	if _, ok := endpointMap["/api-index/guide-md"]; ok {
+		t.Error("Expected no operation index for markdown spec")
+	}
+
+	t.Run("REST index", func(t *testing.T) {
+		var index config.OperationIndex
+		callIndexEndpoint(t, endpointMap, "/api-index/users-yaml", &index)
+
+		if index.URL != "/v3/api-docs" {
+			t.Errorf("Expected URL '/v3/api-docs', got '%s'", index.URL)
+		}
+
+		expected := []config.OperationIndexItem{
+			{Method: "GET", Path: "/users", OperationId: "listUsers", Summary: "List users", Tags: []string{"users"}},
+			{Method: "POST", Path: "/users", OperationId: "createUser", Tags: []string{"users"}},
+			{Method: "DELETE", Path: "/users/{id}", OperationId: "deleteUser", Deprecated: true},
+		}
+
+		if len(index.Operations) != len(expected) {
+			t.Fatalf("Expected %d operations, got %d: %+v", len(expected), len(index.Operations), index.Operations)
+		}
+
+		for i, operation := range index.Operations {
+			if operation.Method != expected[i].Method || operation.Path != expected[i].Path ||
+				operation.OperationId != expected[i].OperationId || operation.Summary != expected[i].Summary ||
+				operation.Deprecated != expected[i].Deprecated || len(operation.Tags) != len(expected[i].Tags) {
+				t.Errorf("Expected operation %+v, got %+v", expected[i], operation)
+			}
+		}
+	})
+
+	t.Run("GraphQL index", func(t *testing.T) {
+		var index config.OperationIndex
+		callIndexEndpoint(t, endpointMap, "/api-index/schema-graphql", &index)
+
+		if index.URL != "/api/graphql-server/schema" {
+			t.Errorf("Expected URL '/api/graphql-server/schema', got '%s'", index.URL)
+		}
+
+		expected := []config.OperationIndexItem{
+			{OperationType: "query", Name: "users"},
+			{OperationType: "query", Name: "user", Deprecated: true},
+			{OperationType: "mutation", Name: "createUser"},
+		}
+
+		if len(index.Operations) != len(expected) {
+			t.Fatalf("Expected %d operations, got %d: %+v", len(expected), len(index.Operations), index.Operations)
+		}
+
+		for i, operation := range index.Operations {
+			if operation.OperationType != expected[i].OperationType || operation.Name != expected[i].Name ||
+				operation.Deprecated != expected[i].Deprecated {
+				t.Errorf("Expected operation %+v, got %+v", expected[i], operation)
+			}
+		}
+	})
+
+	t.Run("combined index", func(t *testing.T) {
+		var combined config.CombinedOperationIndex
+		callIndexEndpoint(t, endpointMap, "/api-index", &combined)
+
+		if len(combined.Specs) != 2 {
+			t.Fatalf("Expected 2 indexed specs, got %d", len(combined.Specs))
+		}
+
+		if combined.Specs[0].Name != "schema" || combined.Specs[1].Name != "Users API" {
+			t.Errorf("Expected specs ordered by URL, got '%s', '%s'", combined.Specs[0].Name, combined.Specs[1].Name)
+		}
+	})
+}
+
+func TestGeneratorOperationIndexFileNotFound(t *testing.T) {
+	specs := []config.SpecMetadata{
+		{Name: "API", FilePath: "/nonexistent/api.json", Type: config.DocTypeOpenAPI30, ApiType: config.ApiTypeRest, Format: config.FormatJSON, FileId: "api"},
+	}
+
+	endpoints := New(specs, config.DiscoveryConfig{ExposeOperationIndex: true}).Generate()
+
+	for _, endpoint := range endpoints {
+		if endpoint.Path != "/api-index/api" {
+			continue
+		}
+
+		req := httptest.NewRequest("GET", endpoint.Path, nil)
+		w := httptest.NewRecorder()
+
+		endpoint.Handler(w, req)
+
+		if w.Code != http.StatusInternalServerError {
+			t.Errorf("Expected status 500, got %d", w.Code)
+		}
+		return
+	}
+
+	t.Fatal("Expected operation index endpoint")
+}
+
+func TestGeneratorCombinedOperationIndexUnreadableSpec(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "generator-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	usersPath := filepath.Join(tempDir, "users.json")
+	if err := os.WriteFile(usersPath, []byte(`{"openapi": "3.0.0", "paths": {"/users": {"get": {"operationId": "listUsers"}}}}`), 0644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	specs := []config.SpecMetadata{
+		{Name: "Missing API", FilePath: filepath.Join(tempDir, "missing.json"), Type: config.DocTypeOpenAPI30, ApiType: config.ApiTypeRest, Format: config.FormatJSON, FileId: "missing"},
+		{Name: "Users API", FilePath: usersPath, Type: config.DocTypeOpenAPI30, ApiType: config.ApiTypeRest, Format: config.FormatJSON, FileId: "users"},
+	}
+
+	endpointMap := make(map[string]config.EndpointConfig)
+	for _, endpoint := range New(specs, config.DiscoveryConfig{ExposeOperationIndex: true}).Generate() {
+		endpointMap[endpoint.Path] = endpoint
+	}
+
+	var combined config.CombinedOperationIndex
+	callIndexEndpoint(t, endpointMap, "/api-index", &combined)
+
+	if len(combined.Specs) != 2 {
+		t.Fatalf("Expected 2 specs, got %d", len(combined.Specs))
+	}
+	if combined.Specs[0].Error == "" || len(combined.Specs[0].Operations) != 0 {
+		t.Errorf("Expected error entry for the missing spec, got %+v", combined.Specs[0])
+	}
+	if combined.Specs[1].Error != "" || len(combined.Specs[1].Operations) != 1 {
+		t.Errorf("Expected indexed users spec, got %+v", combined.Specs[1])
+	}
+}
+
+func callIndexEndpoint(t *testing.T, endpointMap map[string]config.EndpointConfig, path string, response interface{}) {
+	t.Helper()
+
+	endpoint, ok := endpointMap[path]
+	if !ok {
+		t.Fatalf("Expected endpoint %s", path)
+	}
+
+	req := httptest.NewRequest("GET", path, nil)
+	w := httptest.NewRecorder()
+
+	endpoint.Handler(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", w.Code)
+	}
+
+	if err := json.NewDecoder(w.Result().Body).Decode(response); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+}
+
+func TestGeneratorOperationIndexIdsAndRenderers(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "generator-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	files := map[string]string{
+		"users.yaml":            "openapi: 3.0.0\ninfo:\n  title: Users\n  version: 1.0.0\npaths:\n  /users:\n    get:\n      operationId: listUsers\n",
+		"orders.json":           `{"swagger": "2.0", "info": {"title": "Orders", "version": "1.0"}, "paths": {"/orders": {"get": {"operationId": "listOrders", "responses": {"200": {"description": "OK"}}}}}}`,
+		"admin.yaml":            "overlay: 1.0.0\nactions:\n  - target: $.paths\n    update:\n      /admin:\n        get:\n          operationId: admin\n",
+		"users/schema.graphql":  "type Query { users: [String] }",
+		"people/schema.graphql": "type Query { people: [String] }",
+	}
+	for name, content := range files {
+		path := filepath.Join(tempDir, name)
+		os.MkdirAll(filepath.Dir(path), 0755)
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write test file: %v", err)
+		}
+	}
+
+	usersPath := filepath.Join(tempDir, "users.yaml")
+	specs := []config.SpecMetadata{
+		{Name: "Users", FilePath: usersPath, Type: config.DocTypeOpenAPI30, ApiType: config.ApiTypeRest, Format: config.FormatYAML, FileId: "users"},
+		{Name: "Orders", FilePath: filepath.Join(tempDir, "orders.json"), Type: config.DocTypeOpenAPI20, ApiType: config.ApiTypeRest, Format: config.FormatJSON, FileId: "orders"},
+		{Name: "Admin", FilePath: filepath.Join(tempDir, "admin.yaml"), Type: config.DocTypeOverlay10, ApiType: config.ApiTypeOverlay, Format: config.FormatYAML, FileId: "admin-yaml", LinkedSpecs: []string{usersPath}},
+		{Name: "Users graph", FilePath: filepath.Join(tempDir, "users", "schema.graphql"), Type: config.DocTypeGraphQL, ApiType: config.ApiTypeGraphQL, Format: config.FormatGraphQL, FileId: "users"},
+		{Name: "People graph", FilePath: filepath.Join(tempDir, "people", "schema.graphql"), Type: config.DocTypeGraphQL, ApiType: config.ApiTypeGraphQL, Format: config.FormatGraphQL, FileId: "users"},
+	}
+
+	cfg := config.DiscoveryConfig{ExposeOperationIndex: true, ApplyOverlays: true, Swagger2Conversion: config.ConversionReplace}
+	endpoints := New(specs, cfg).Generate()
+
+	endpointMap := make(map[string]config.EndpointConfig)
+	for _, endpoint := range endpoints {
+		endpointMap[endpoint.Path] = endpoint
+	}
+
+	// Every index is served on the id of the spec path
+	for _, specPath := range []string{"/v3/api-docs/users", "/v3/api-docs/orders", "/api/graphql-server/schema/users-1", "/api/graphql-server/schema/users-2"} {
+		indexPath := "/api-index/" + filepath.Base(specPath)
+		var index config.OperationIndex
+		callIndexEndpoint(t, endpointMap, indexPath, &index)
+		if index.URL != specPath {
+			t.Errorf("Expected index %s of %s, got URL '%s'", indexPath, specPath, index.URL)
+		}
+	}
+
+	var users config.OperationIndex
+	callIndexEndpoint(t, endpointMap, "/api-index/users", &users)
+	if len(users.Operations) != 2 || users.Operations[0].OperationId != "admin" || users.Operations[1].OperationId != "listUsers" {
+		t.Errorf("Expected operations of the spec with the overlay applied, got %+v", users.Operations)
+	}
+
+	var orders config.OperationIndex
+	callIndexEndpoint(t, endpointMap, "/api-index/orders", &orders)
+	if orders.Type != string(config.DocTypeOpenAPI30) || len(orders.Operations) != 1 || orders.Operations[0].OperationId != "listOrders" {
+		t.Errorf("Expected operations of the converted spec, got %s %+v", orders.Type, orders.Operations)
+	}
+}
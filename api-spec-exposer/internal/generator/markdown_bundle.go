@@ -0,0 +1,122 @@
+package generator
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/loader"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/markdown"
+)
+
+// generateMarkdownBundles registers renderers rewriting relative links of Markdown documents in specMap
+// and generates endpoints serving their assets under '{document path}/assets/'
+func (g *Generator) generateMarkdownBundles(specMap map[string]*config.SpecMetadata) []config.EndpointConfig {
+	specPaths := make(map[string]string)
+	for specPath, spec := range specMap {
+		if len(spec.SourceFiles) == 0 && !g.derivedPaths[specPath] {
+			specPaths[filepath.Clean(spec.FilePath)] = specPath
+		}
+	}
+
+	var endpoints []config.EndpointConfig
+	for docPath, spec := range specMap {
+		if spec.ApiType != config.ApiTypeMarkdown || len(spec.Assets) == 0 {
+			continue
+		}
+
+		targets := make(map[string]string)
+		for _, asset := range spec.Assets {
+			asset = filepath.Clean(asset)
+			if specPath, ok := specPaths[asset]; ok {
+				targets[asset] = specPath
+				continue
+			}
+			assetPath := fmt.Sprintf("%s/assets/%s", docPath, g.assetName(asset))
+			targets[asset] = assetPath
+			endpoints = append(endpoints, config.EndpointConfig{
+				SpecMetadata: config.SpecMetadata{
+					Name:     filepath.Base(asset),
+					FilePath: asset,
+					Type:     config.DocTypeUnknown,
+					ApiType:  config.ApiTypeUnknown,
+					Format:   config.FormatUnknown,
+				},
+				Path:    assetPath,
+				Handler: assetHandler(asset),
+			})
+		}
+
+		g.renderers[docPath] = markdownBundleRenderer(docPath, *spec, targets)
+	}
+
+	return endpoints
+}
+
+// assetName returns the URL path of an asset relative to the scan directory
+func (g *Generator) assetName(asset string) string {
+	name := filepath.Base(asset)
+	if relPath, err := filepath.Rel(g.config.ScanDirectory, asset); err == nil && !strings.HasPrefix(relPath, "..") {
+		name = relPath
+	}
+	segments := strings.Split(filepath.ToSlash(name), "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+func markdownBundleRenderer(docPath string, spec config.SpecMetadata, targets map[string]string) renderFunc {
+	return func() ([]byte, error) {
+		content, err := loader.Read(&spec)
+		if err != nil {
+			return nil, err
+		}
+
+		return markdown.RewriteLinks(content, func(destination string) (string, bool) {
+			linkPath, suffix, ok := markdown.SplitDestination(destination)
+			if !ok {
+				return "", false
+			}
+			target, ok := targets[filepath.Join(filepath.Dir(spec.FilePath), filepath.FromSlash(linkPath))]
+			if !ok {
+				return "", false
+			}
+			return relativeURL(docPath, target) + suffix, true
+		}), nil
+	}
+}
+
+// relativeURL returns the URL of target relative to the document served on from,
+// targets outside the parent path of the document are returned as absolute paths
+func relativeURL(from string, target string) string {
+	parent := path.Dir(from) + "/"
+	if strings.HasPrefix(target, parent) {
+		return strings.TrimPrefix(target, parent)
+	}
+	return target
+}
+
+func assetHandler(filePath string) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		file, err := os.Open(filePath)
+		if err != nil {
+			http.Error(w, "Failed to read asset file", http.StatusInternalServerError)
+			return
+		}
+		defer file.Close()
+
+		info, err := file.Stat()
+		if err != nil {
+			http.Error(w, "Failed to read asset file", http.StatusInternalServerError)
+			return
+		}
+
+		http.ServeContent(w, r, filepath.Base(filePath), info.ModTime(), file)
+	}
+}
@@ -0,0 +1,95 @@
+package generator
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+)
+
+func TestGeneratorMarkdownBundles(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "generator-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	files := map[string]string{
+		"docs/guide.md":        "# Guide\n\n![flow](./images/flow.png \"Flow\")\n[Other](other.md#top) [Spec](../api.yaml) [Home](https://example.com)\n",
+		"docs/other.md":        "# Other\n",
+		"docs/images/flow.png": "\x89PNG\r\n\x1a\n",
+		"api.yaml":             "openapi: 3.0.0",
+	}
+	for name, content := range files {
+		path := filepath.Join(tempDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("Failed to create directory: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write test file: %v", err)
+		}
+	}
+
+	specs := []config.SpecMetadata{
+		{Name: "API", FilePath: filepath.Join(tempDir, "api.yaml"), Type: config.DocTypeOpenAPI30, ApiType: config.ApiTypeRest, Format: config.FormatYAML, FileId: "api-yaml"},
+		{
+			Name:     "Guide",
+			FilePath: filepath.Join(tempDir, "docs", "guide.md"),
+			Type:     config.DocTypeMarkdown,
+			ApiType:  config.ApiTypeMarkdown,
+			Format:   config.FormatMarkdown,
+			FileId:   "guide-md",
+			Assets: []string{
+				filepath.Join(tempDir, "docs", "images", "flow.png"),
+				filepath.Join(tempDir, "docs", "other.md"),
+				filepath.Join(tempDir, "api.yaml"),
+			},
+		},
+		{Name: "Other", FilePath: filepath.Join(tempDir, "docs", "other.md"), Type: config.DocTypeMarkdown, ApiType: config.ApiTypeMarkdown, Format: config.FormatMarkdown, FileId: "other-md"},
+	}
+
+	cfg := config.DiscoveryConfig{ScanDirectory: tempDir, BundleMarkdownAssets: true}
+	endpoints := New(specs, cfg).Generate()
+
+	endpointsByPath := make(map[string]config.EndpointConfig)
+	for _, endpoint := range endpoints {
+		endpointsByPath[endpoint.Path] = endpoint
+	}
+
+	// REST spec + 2 markdown docs + apihub-swagger-config + 1 asset
+	if len(endpoints) != 5 {
+		t.Fatalf("Expected 5 endpoints, got %d", len(endpoints))
+	}
+
+	guide, ok := endpointsByPath["/v3/api-docs/guide-md"]
+	if !ok {
+		t.Fatal("Expected guide endpoint")
+	}
+	w := httptest.NewRecorder()
+	guide.Handler(w, httptest.NewRequest("GET", guide.Path, nil))
+
+	expected := "# Guide\n\n![flow](guide-md/assets/docs/images/flow.png \"Flow\")\n[Other](other-md#top) [Spec](/v3/api-docs) [Home](https://example.com)\n"
+	if w.Body.String() != expected {
+		t.Errorf("Expected rewritten document %q, got %q", expected, w.Body.String())
+	}
+
+	asset, ok := endpointsByPath["/v3/api-docs/guide-md/assets/docs/images/flow.png"]
+	if !ok {
+		t.Fatal("Expected asset endpoint")
+	}
+	w = httptest.NewRecorder()
+	asset.Handler(w, httptest.NewRequest("GET", asset.Path, nil))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", w.Code)
+	}
+	if w.Header().Get("Content-Type") != "image/png" {
+		t.Errorf("Expected content type 'image/png', got '%s'", w.Header().Get("Content-Type"))
+	}
+	if w.Body.String() != files["docs/images/flow.png"] {
+		t.Errorf("Expected asset content, got %q", w.Body.String())
+	}
+}
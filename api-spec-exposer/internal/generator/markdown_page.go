@@ -0,0 +1,109 @@
+package generator
+
+import (
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/loader"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/markdown"
+)
+
+// markdownPageCSP restricts rendered pages to inline styles and images, scripts are never allowed
+const markdownPageCSP = "default-src 'none'; img-src 'self' https: data:; style-src 'unsafe-inline'"
+
+// generateMarkdownPages generates endpoints serving Markdown documents of specMap rendered to HTML on '{document path}.html'.
+// Pages are siblings of the raw documents so relative links resolve the same way. Documents are rendered once here
+// and the pages are served from memory
+func (g *Generator) generateMarkdownPages(specMap map[string]*config.SpecMetadata) []config.EndpointConfig {
+	var endpoints []config.EndpointConfig
+	for docPath, spec := range specMap {
+		if spec.ApiType != config.ApiTypeMarkdown {
+			continue
+		}
+		endpoints = append(endpoints, config.EndpointConfig{
+			SpecMetadata: *spec,
+			Path:         docPath + ".html",
+			Handler:      g.markdownPageHandler(docPath, spec),
+		})
+	}
+	return endpoints
+}
+
+// markdownSource returns the Markdown served on docPath, including rewritten links of bundled documents
+func (g *Generator) markdownSource(docPath string, spec *config.SpecMetadata) renderFunc {
+	if render, ok := g.renderers[docPath]; ok {
+		return render
+	}
+	return func() ([]byte, error) {
+		return loader.Read(spec)
+	}
+}
+
+// markdownPageHandler returns the handler serving the page of the document on docPath, the document is rendered
+// on the first call only
+func (g *Generator) markdownPageHandler(docPath string, spec *config.SpecMetadata) func(w http.ResponseWriter, r *http.Request) {
+	if handler, ok := g.markdownPages[docPath]; ok {
+		return handler
+	}
+
+	var page []byte
+	content, err := g.markdownSource(docPath, spec)()
+	if err == nil {
+		page = []byte(markdown.Page(spec.Name, g.config.MarkdownRendering.Stylesheet, markdown.ToHTML(content)))
+	}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if err != nil {
+			http.Error(w, "Failed to render spec", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Header().Set("Content-Security-Policy", markdownPageCSP)
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.WriteHeader(http.StatusOK)
+		w.Write(page)
+	}
+	g.markdownPages[docPath] = handler
+	return handler
+}
+
+// negotiateMarkdown serves the rendered page to clients preferring text/html and the raw document otherwise
+func negotiateMarkdown(raw func(w http.ResponseWriter, r *http.Request), page func(w http.ResponseWriter, r *http.Request)) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", "Accept")
+		if prefersHTML(r.Header.Get("Accept")) {
+			page(w, r)
+			return
+		}
+		raw(w, r)
+	}
+}
+
+// prefersHTML reports whether text/html is explicitly accepted with a quality not lower than text/markdown.
+// Wildcards are ignored so that clients without preferences get the raw document
+func prefersHTML(accept string) bool {
+	htmlQuality, markdownQuality := 0.0, 0.0
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(mediaRange))
+		if err != nil {
+			continue
+		}
+		quality := 1.0
+		if value, ok := params["q"]; ok {
+			if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+				quality = parsed
+			}
+		}
+		switch mediaType {
+		case "text/html":
+			htmlQuality = quality
+		case "text/markdown":
+			markdownQuality = quality
+		}
+	}
+	return htmlQuality > 0 && htmlQuality >= markdownQuality
+}
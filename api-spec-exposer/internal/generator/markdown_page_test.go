@@ -0,0 +1,96 @@
+package generator
+
+import (
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+)
+
+func TestGeneratorMarkdownPages(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "generator-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	filePath := filepath.Join(tempDir, "guide.md")
+	if err := os.WriteFile(filePath, []byte("# Guide\n\n<script>alert(1)</script>\n"), 0644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	specs := []config.SpecMetadata{
+		{Name: "Guide", FilePath: filePath, Type: config.DocTypeMarkdown, ApiType: config.ApiTypeMarkdown, Format: config.FormatMarkdown, FileId: "guide-md"},
+	}
+	cfg := config.DiscoveryConfig{
+		ScanDirectory:     tempDir,
+		MarkdownRendering: config.MarkdownRenderingConfig{Enabled: true, Stylesheet: "body { color: red; }"},
+	}
+	endpoints := New(specs, cfg).Generate()
+
+	endpointsByPath := make(map[string]config.EndpointConfig)
+	for _, endpoint := range endpoints {
+		endpointsByPath[endpoint.Path] = endpoint
+	}
+
+	page, ok := endpointsByPath["/v3/api-docs/guide-md.html"]
+	if !ok {
+		t.Fatal("Expected rendered page endpoint")
+	}
+	w := httptest.NewRecorder()
+	page.Handler(w, httptest.NewRequest("GET", page.Path, nil))
+
+	if w.Header().Get("Content-Type") != "text/html; charset=utf-8" {
+		t.Errorf("Expected HTML content type, got %s", w.Header().Get("Content-Type"))
+	}
+	if w.Header().Get("Content-Security-Policy") == "" {
+		t.Error("Expected Content-Security-Policy header")
+	}
+	body := w.Body.String()
+	if !strings.Contains(body, "<h1 id=\"guide\">Guide</h1>") || !strings.Contains(body, "body { color: red; }") {
+		t.Errorf("Expected rendered document with the configured stylesheet, got %q", body)
+	}
+	if strings.Contains(body, "<script>") {
+		t.Errorf("Expected sanitized document, got %q", body)
+	}
+
+	// The page is rendered when the endpoints are generated
+	if err := os.WriteFile(filePath, []byte("# Changed\n"), 0644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+	w = httptest.NewRecorder()
+	page.Handler(w, httptest.NewRequest("GET", page.Path, nil))
+	if w.Body.String() != body {
+		t.Errorf("Expected the rendered page to be served from memory, got %q", w.Body.String())
+	}
+
+	tests := []struct {
+		name        string
+		accept      string
+		contentType string
+	}{
+		{name: "no accept header", contentType: "text/markdown"},
+		{name: "any type", accept: "*/*", contentType: "text/markdown"},
+		{name: "browser", accept: "text/html,application/xhtml+xml,*/*;q=0.8", contentType: "text/html; charset=utf-8"},
+		{name: "markdown preferred", accept: "text/markdown, text/html;q=0.5", contentType: "text/markdown"},
+	}
+
+	raw := endpointsByPath["/v3/api-docs/guide-md"]
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest("GET", raw.Path, nil)
+			if tt.accept != "" {
+				request.Header.Set("Accept", tt.accept)
+			}
+			w := httptest.NewRecorder()
+			raw.Handler(w, request)
+
+			if w.Header().Get("Content-Type") != tt.contentType {
+				t.Errorf("Expected content type %s, got %s", tt.contentType, w.Header().Get("Content-Type"))
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package generator
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/document"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/loader"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/overlay"
+)
+
+// applyOverlays registers renderers serving the REST specs in specMap that are extended by discovered Overlay documents
+// with the overlay actions applied. Overlays are applied in file path order to the spec file they were written for,
+// so Swagger 2.0 specs served converted to OpenAPI 3.0 are converted after the overlays are applied
+func (g *Generator) applyOverlays(specMap map[string]*config.SpecMetadata) {
+	overlays := make(map[string][]config.SpecMetadata)
+	for _, spec := range g.specs {
+		if spec.ApiType != config.ApiTypeOverlay {
+			continue
+		}
+		for _, linked := range spec.LinkedSpecs {
+			overlays[filepath.Clean(linked)] = append(overlays[filepath.Clean(linked)], spec)
+		}
+	}
+	for _, specs := range overlays {
+		sort.Slice(specs, func(i, j int) bool { return specs[i].FilePath < specs[j].FilePath })
+	}
+
+	for path, spec := range specMap {
+		if spec.ApiType != config.ApiTypeRest || len(spec.SourceFiles) > 0 {
+			continue
+		}
+		specOverlays := overlays[filepath.Clean(spec.FilePath)]
+		if len(specOverlays) == 0 {
+			continue
+		}
+
+		specCopy := *spec
+		render := overlayRenderer(specCopy, func() ([]byte, error) {
+			return loader.Read(&specCopy)
+		}, specOverlays)
+		if g.convertedPaths[path] {
+			render = g.swaggerConversionRenderer(specCopy, render)
+		}
+		g.renderers[path] = render
+	}
+}
+
+func overlayRenderer(spec config.SpecMetadata, base renderFunc, overlays []config.SpecMetadata) renderFunc {
+	return func() ([]byte, error) {
+		content, err := base()
+		if err != nil {
+			return nil, err
+		}
+		result, err := document.Decode(content, spec.Format)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, overlaySpec := range overlays {
+			overlayContent, err := loader.Read(&overlaySpec)
+			if err != nil {
+				return nil, err
+			}
+			overlayDocument, err := document.Decode(overlayContent, overlaySpec.Format)
+			if err != nil {
+				return nil, fmt.Errorf("overlay %s: %w", overlaySpec.FilePath, err)
+			}
+			if result, err = overlay.Apply(result, overlayDocument); err != nil {
+				return nil, fmt.Errorf("overlay %s: %w", overlaySpec.FilePath, err)
+			}
+		}
+
+		return document.Encode(result, spec.Format, content)
+	}
+}
+
+// linkedSpecURLs returns the endpoint paths of the REST specs linked to an Arazzo or Overlay document
+func linkedSpecURLs(spec *config.SpecMetadata, specMap map[string]*config.SpecMetadata) []string {
+	if len(spec.LinkedSpecs) == 0 {
+		return nil
+	}
+
+	paths := make(map[string]string)
+	for path, restSpec := range specMap {
+		if restSpec.ApiType != config.ApiTypeRest {
+			continue
+		}
+		// A spec served converted alongside the original is linked by its original path
+		key := filepath.Clean(restSpec.FilePath)
+		if existing, ok := paths[key]; !ok || len(path) < len(existing) || (len(path) == len(existing) && path < existing) {
+			paths[key] = path
+		}
+	}
+
+	var urls []string
+	for _, linked := range spec.LinkedSpecs {
+		if path, ok := paths[filepath.Clean(linked)]; ok {
+			urls = append(urls, path)
+		}
+	}
+	return urls
+}
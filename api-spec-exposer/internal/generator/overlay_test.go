@@ -0,0 +1,203 @@
+package generator
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+)
+
+func TestGeneratorApplyOverlays(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "generator-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	files := map[string]string{
+		"pets.yaml":    "openapi: 3.0.0\ninfo:\n  title: Pets\n  version: 1.0.0\npaths:\n  /pets:\n    get:\n      x-internal: true\n",
+		"store.json":   `{"openapi": "3.0.0", "info": {"title": "Store", "version": "1.0.0"}, "paths": {}}`,
+		"b-title.yaml": "overlay: 1.0.0\nactions:\n  - target: $.info\n    update:\n      title: Public pets\n",
+		"a-clean.json": `{"overlay": "1.0.0", "actions": [{"target": "$.paths.*.get['x-internal']", "remove": true}, {"target": "$.info", "update": {"title": "Cleaned pets"}}]}`,
+		"flow.yaml":    "arazzo: 1.0.0\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(tempDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write test file: %v", err)
+		}
+	}
+
+	pets := filepath.Join(tempDir, "pets.yaml")
+	specs := []config.SpecMetadata{
+		{Name: "Pets", FilePath: pets, Type: config.DocTypeOpenAPI30, ApiType: config.ApiTypeRest, Format: config.FormatYAML, FileId: "pets-yaml"},
+		{Name: "Store", FilePath: filepath.Join(tempDir, "store.json"), Type: config.DocTypeOpenAPI30, ApiType: config.ApiTypeRest, Format: config.FormatJSON, FileId: "store-json"},
+		{Name: "Title", FilePath: filepath.Join(tempDir, "b-title.yaml"), Type: config.DocTypeOverlay10, ApiType: config.ApiTypeOverlay, Format: config.FormatYAML, FileId: "b-title-yaml", LinkedSpecs: []string{pets}},
+		{Name: "Clean", FilePath: filepath.Join(tempDir, "a-clean.json"), Type: config.DocTypeOverlay10, ApiType: config.ApiTypeOverlay, Format: config.FormatJSON, FileId: "a-clean-json", LinkedSpecs: []string{pets}},
+		{Name: "Flow", FilePath: filepath.Join(tempDir, "flow.yaml"), Type: config.DocTypeArazzo10, ApiType: config.ApiTypeArazzo, Format: config.FormatYAML, FileId: "flow-yaml", LinkedSpecs: []string{pets, filepath.Join(tempDir, "store.json")}},
+	}
+
+	tests := []struct {
+		name          string
+		applyOverlays bool
+		expected      string
+	}{
+		{"overlays applied", true, "openapi: 3.0.0\ninfo:\n  title: Public pets\n  version: 1.0.0\npaths:\n  /pets:\n    get: {}\n"},
+		{"overlays disabled", false, files["pets.yaml"]},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.DiscoveryConfig{ScanDirectory: tempDir, ApplyOverlays: tt.applyOverlays, ExposeMetadata: true}
+			endpoints := New(specs, cfg).Generate()
+
+			endpointsByPath := make(map[string]config.EndpointConfig)
+			for _, endpoint := range endpoints {
+				endpointsByPath[endpoint.Path] = endpoint
+			}
+
+			endpoint, ok := endpointsByPath["/v3/api-docs/pets-yaml"]
+			if !ok {
+				t.Fatal("Expected pets endpoint")
+			}
+			w := httptest.NewRecorder()
+			endpoint.Handler(w, httptest.NewRequest("GET", endpoint.Path, nil))
+			if w.Code != http.StatusOK {
+				t.Fatalf("Expected status 200, got %d", w.Code)
+			}
+			if w.Body.String() != tt.expected {
+				t.Errorf("Expected document %q, got %q", tt.expected, w.Body.String())
+			}
+
+			store := endpointsByPath["/v3/api-docs/store-json"]
+			w = httptest.NewRecorder()
+			store.Handler(w, httptest.NewRequest("GET", store.Path, nil))
+			if w.Body.String() != files["store.json"] {
+				t.Errorf("Expected store spec unchanged, got %q", w.Body.String())
+			}
+
+			apihubConfig := endpointsByPath["/v3/api-docs/apihub-swagger-config"]
+			w = httptest.NewRecorder()
+			apihubConfig.Handler(w, httptest.NewRequest("GET", apihubConfig.Path, nil))
+
+			var apiConfig config.ApiSpecConfig
+			if err := json.NewDecoder(w.Result().Body).Decode(&apiConfig); err != nil {
+				t.Fatalf("Failed to decode response: %v", err)
+			}
+			for _, url := range apiConfig.URLs {
+				if url.URL == "/v3/api-docs/flow-yaml" {
+					if strings.Join(url.LinkedSpecURLs, ",") != "/v3/api-docs/pets-yaml,/v3/api-docs/store-json" {
+						t.Errorf("Expected linked spec URLs, got %v", url.LinkedSpecURLs)
+					}
+					return
+				}
+			}
+			t.Error("Expected Arazzo document in apihub-swagger-config")
+		})
+	}
+}
+
+func TestGeneratorApplyOverlaysError(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "generator-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	pets := filepath.Join(tempDir, "pets.json")
+	overlayPath := filepath.Join(tempDir, "broken.json")
+	if err := os.WriteFile(pets, []byte(`{"openapi": "3.0.0"}`), 0644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+	if err := os.WriteFile(overlayPath, []byte(`{"overlay": "1.0.0", "actions": [{"target": "info"}]}`), 0644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	specs := []config.SpecMetadata{
+		{Name: "Pets", FilePath: pets, Type: config.DocTypeOpenAPI30, ApiType: config.ApiTypeRest, Format: config.FormatJSON, FileId: "pets-json"},
+		{Name: "Broken", FilePath: overlayPath, Type: config.DocTypeOverlay10, ApiType: config.ApiTypeOverlay, Format: config.FormatJSON, FileId: "broken-json", LinkedSpecs: []string{pets}},
+	}
+
+	for _, endpoint := range New(specs, config.DiscoveryConfig{ApplyOverlays: true}).Generate() {
+		if endpoint.Path != "/v3/api-docs" {
+			continue
+		}
+		w := httptest.NewRecorder()
+		endpoint.Handler(w, httptest.NewRequest("GET", endpoint.Path, nil))
+		if w.Code != http.StatusInternalServerError {
+			t.Errorf("Expected status 500, got %d", w.Code)
+		}
+		return
+	}
+	t.Error("Expected REST spec endpoint")
+}
+
+func TestGeneratorApplyOverlaysSwaggerConversion(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "generator-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	pets := filepath.Join(tempDir, "pets.json")
+	overlayPath := filepath.Join(tempDir, "pet-docs.json")
+	files := map[string]string{
+		pets:        `{"swagger": "2.0", "info": {"title": "Pets", "version": "1.0.0"}, "paths": {}, "definitions": {"Pet": {"type": "object"}}}`,
+		overlayPath: `{"overlay": "1.0.0", "actions": [{"target": "$.definitions.Pet", "update": {"description": "A pet"}}]}`,
+	}
+	for path, content := range files {
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write test file: %v", err)
+		}
+	}
+
+	specs := []config.SpecMetadata{
+		{Name: "Pets", FilePath: pets, Type: config.DocTypeOpenAPI20, ApiType: config.ApiTypeRest, Format: config.FormatJSON, FileId: "pets-json"},
+		{Name: "Pet docs", FilePath: overlayPath, Type: config.DocTypeOverlay10, ApiType: config.ApiTypeOverlay, Format: config.FormatJSON, FileId: "pet-docs-json", LinkedSpecs: []string{pets}},
+	}
+
+	tests := []struct {
+		mode    config.ConversionMode
+		path    string
+		pointer []string
+	}{
+		{config.ConversionAlongside, "/v3/api-docs/pets-json", []string{"definitions", "Pet"}},
+		{config.ConversionAlongside, "/v3/api-docs/pets-json-openapi-3-0", []string{"components", "schemas", "Pet"}},
+		{config.ConversionReplace, "/v3/api-docs", []string{"components", "schemas", "Pet"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.mode)+tt.path, func(t *testing.T) {
+			cfg := config.DiscoveryConfig{ApplyOverlays: true, Swagger2Conversion: tt.mode}
+			for _, endpoint := range New(specs, cfg).Generate() {
+				if endpoint.Path != tt.path {
+					continue
+				}
+				w := httptest.NewRecorder()
+				endpoint.Handler(w, httptest.NewRequest("GET", endpoint.Path, nil))
+				if w.Code != http.StatusOK {
+					t.Fatalf("Expected status 200, got %d", w.Code)
+				}
+
+				var node interface{}
+				if err := json.Unmarshal(w.Body.Bytes(), &node); err != nil {
+					t.Fatalf("Failed to decode response: %v", err)
+				}
+				for _, key := range tt.pointer {
+					object, _ := node.(map[string]interface{})
+					node = object[key]
+				}
+				schema, _ := node.(map[string]interface{})
+				if schema["description"] != "A pet" {
+					t.Errorf("Expected overlay applied to %s, got %s", strings.Join(tt.pointer, "."), w.Body.String())
+				}
+				return
+			}
+			t.Errorf("Expected endpoint %s", tt.path)
+		})
+	}
+}
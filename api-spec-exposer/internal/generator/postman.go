@@ -0,0 +1,74 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/converter"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/document"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/loader"
+)
+
+const postmanCollectionSuffix = "/postman-collection"
+
+// generatePostmanCollections serves every REST spec in specMap converted to a Postman collection on '{spec path}/postman-collection'.
+// The collection is built from the content served for the spec, so the Swagger 2.0 conversion and overlays are included
+func (g *Generator) generatePostmanCollections(specMap map[string]*config.SpecMetadata) {
+	var paths []string
+	for path, spec := range specMap {
+		if spec.ApiType == config.ApiTypeRest {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		collectionPath := path + postmanCollectionSuffix
+		if _, exists := specMap[collectionPath]; exists {
+			continue
+		}
+
+		spec := specMap[path]
+		base, ok := g.renderers[path]
+		if !ok {
+			specCopy := *spec
+			base = func() ([]byte, error) {
+				return loader.Read(&specCopy)
+			}
+		}
+
+		collection := *spec
+		collection.Name = fmt.Sprintf("%s (Postman)", spec.Name)
+		collection.FileId = fmt.Sprintf("%s-postman", spec.FileId)
+		collection.Type = config.DocTypePostmanCollection21
+		collection.ApiType = config.ApiTypePostman
+		collection.Format = config.FormatJSON
+		collection.SourceFiles = nil
+
+		specMap[collectionPath] = &collection
+		g.renderers[collectionPath] = postmanRenderer(spec.Format, base)
+		g.derivedPaths[collectionPath] = true
+	}
+}
+
+func postmanRenderer(format config.Format, base renderFunc) renderFunc {
+	return func() ([]byte, error) {
+		content, err := base()
+		if err != nil {
+			return nil, err
+		}
+
+		source, err := document.Decode(content, format)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := converter.OpenAPIToPostman(source)
+		if err != nil {
+			return nil, err
+		}
+
+		return document.Encode(result, config.FormatJSON, nil)
+	}
+}
@@ -0,0 +1,113 @@
+package generator
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+)
+
+func TestGeneratorPostmanCollections(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "generator-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	files := map[string]string{
+		"pets.yaml":      "openapi: 3.0.0\ninfo:\n  title: Pets\n  version: 1.0.0\nservers:\n  - url: https://pets.example.com\npaths:\n  /pets:\n    get:\n      summary: List pets\n",
+		"legacy.json":    `{"swagger": "2.0", "info": {"title": "Legacy", "version": "1.0"}, "host": "legacy.example.com", "schemes": ["https"], "paths": {"/items": {"get": {}}}}`,
+		"title.yaml":     "overlay: 1.0.0\nactions:\n  - target: $.info\n    update:\n      title: Public pets\n",
+		"schema.graphql": "type Query { pets: [String] }",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(tempDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write test file: %v", err)
+		}
+	}
+
+	pets := filepath.Join(tempDir, "pets.yaml")
+	specs := []config.SpecMetadata{
+		{Name: "Pets", FilePath: pets, Type: config.DocTypeOpenAPI30, ApiType: config.ApiTypeRest, Format: config.FormatYAML, FileId: "pets-yaml"},
+		{Name: "Legacy", FilePath: filepath.Join(tempDir, "legacy.json"), Type: config.DocTypeOpenAPI20, ApiType: config.ApiTypeRest, Format: config.FormatJSON, FileId: "legacy-json"},
+		{Name: "Title", FilePath: filepath.Join(tempDir, "title.yaml"), Type: config.DocTypeOverlay10, ApiType: config.ApiTypeOverlay, Format: config.FormatYAML, FileId: "title-yaml", LinkedSpecs: []string{pets}},
+		{Name: "Schema", FilePath: filepath.Join(tempDir, "schema.graphql"), Type: config.DocTypeGraphQL, ApiType: config.ApiTypeGraphQL, Format: config.FormatGraphQL, FileId: "schema-graphql"},
+	}
+
+	tests := []struct {
+		name          string
+		postmanExport bool
+		path          string
+		expectedName  string
+		expectedURL   string
+	}{
+		{"overlay applied before export", true, "/v3/api-docs/pets-yaml/postman-collection", "Public pets", "https://pets.example.com"},
+		{"swagger spec exported", true, "/v3/api-docs/legacy-json/postman-collection", "Legacy", "https://legacy.example.com"},
+		{"export disabled", false, "/v3/api-docs/pets-yaml/postman-collection", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.DiscoveryConfig{ScanDirectory: tempDir, ApplyOverlays: true, PostmanExport: tt.postmanExport}
+			endpoints := New(specs, cfg).Generate()
+
+			endpointsByPath := make(map[string]config.EndpointConfig)
+			for _, endpoint := range endpoints {
+				endpointsByPath[endpoint.Path] = endpoint
+			}
+
+			endpoint, ok := endpointsByPath[tt.path]
+			if !tt.postmanExport {
+				if ok {
+					t.Errorf("Expected no endpoint %s", tt.path)
+				}
+				return
+			}
+			if !ok {
+				t.Fatalf("Expected endpoint %s", tt.path)
+			}
+			if _, ok := endpointsByPath["/graphql/postman-collection"]; ok {
+				t.Error("Expected no collection for GraphQL spec")
+			}
+
+			if endpoint.SpecMetadata.ApiType != config.ApiTypePostman || endpoint.SpecMetadata.Type != config.DocTypePostmanCollection21 {
+				t.Errorf("Expected Postman collection metadata, got %s/%s", endpoint.SpecMetadata.ApiType, endpoint.SpecMetadata.Type)
+			}
+
+			w := httptest.NewRecorder()
+			endpoint.Handler(w, httptest.NewRequest("GET", endpoint.Path, nil))
+			if w.Code != http.StatusOK {
+				t.Fatalf("Expected status 200, got %d: %s", w.Code, w.Body.String())
+			}
+			if contentType := w.Header().Get("Content-Type"); contentType != "application/json" {
+				t.Errorf("Expected Content-Type 'application/json', got '%s'", contentType)
+			}
+
+			var collection struct {
+				Info struct {
+					Name string `json:"name"`
+				} `json:"info"`
+				Item     []interface{} `json:"item"`
+				Variable []struct {
+					Value string `json:"value"`
+				} `json:"variable"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &collection); err != nil {
+				t.Fatalf("Failed to decode collection: %v", err)
+			}
+			if collection.Info.Name != tt.expectedName {
+				t.Errorf("Expected collection name '%s', got '%s'", tt.expectedName, collection.Info.Name)
+			}
+			if len(collection.Variable) != 1 || collection.Variable[0].Value != tt.expectedURL {
+				t.Errorf("Expected baseUrl '%s', got %v", tt.expectedURL, collection.Variable)
+			}
+			if len(collection.Item) != 1 {
+				t.Errorf("Expected 1 request, got %d", len(collection.Item))
+			}
+		})
+	}
+}
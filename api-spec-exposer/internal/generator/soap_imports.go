@@ -0,0 +1,63 @@
+package generator
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/loader"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/wsdl"
+)
+
+// generateWSDLImports registers renderers rewriting import locations of WSDL documents in specMap
+// and generates endpoints serving the imported documents under '{document path}/imports/'.
+// Imports keep the directory layout of the scan directory, so relative locations between imported documents stay valid
+func (g *Generator) generateWSDLImports(specMap map[string]*config.SpecMetadata) []config.EndpointConfig {
+	var endpoints []config.EndpointConfig
+	for docPath, spec := range specMap {
+		if spec.ApiType != config.ApiTypeSOAP || len(spec.Assets) == 0 {
+			continue
+		}
+
+		targets := make(map[string]string)
+		for _, asset := range spec.Assets {
+			asset = filepath.Clean(asset)
+			importPath := fmt.Sprintf("%s/imports/%s", docPath, g.assetName(asset))
+			targets[asset] = importPath
+			endpoints = append(endpoints, config.EndpointConfig{
+				SpecMetadata: config.SpecMetadata{
+					Name:     filepath.Base(asset),
+					FilePath: asset,
+					Type:     config.DocTypeUnknown,
+					ApiType:  config.ApiTypeUnknown,
+					Format:   config.FormatXML,
+				},
+				Path:    importPath,
+				Handler: g.fileContentHandler(&config.SpecMetadata{FilePath: asset, Format: config.FormatXML}),
+			})
+		}
+
+		g.renderers[docPath] = wsdlImportsRenderer(docPath, *spec, targets)
+	}
+	return endpoints
+}
+
+func wsdlImportsRenderer(docPath string, spec config.SpecMetadata, targets map[string]string) renderFunc {
+	return func() ([]byte, error) {
+		content, err := loader.Read(&spec)
+		if err != nil {
+			return nil, err
+		}
+
+		return wsdl.RewriteReferences(content, func(location string) (string, bool) {
+			if !wsdl.IsRelative(location) {
+				return "", false
+			}
+			target, ok := targets[filepath.Join(filepath.Dir(spec.FilePath), filepath.FromSlash(location))]
+			if !ok {
+				return "", false
+			}
+			return relativeURL(docPath, target), true
+		})
+	}
+}
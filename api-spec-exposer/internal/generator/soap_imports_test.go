@@ -0,0 +1,76 @@
+package generator
+
+import (
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+)
+
+func TestGeneratorWSDLImports(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "generator-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	files := map[string]string{
+		"soap/orders.wsdl":      `<definitions xmlns="http://schemas.xmlsoap.org/wsdl/" xmlns:xs="http://www.w3.org/2001/XMLSchema"><types><xs:schema><xs:import schemaLocation="types/orders.xsd"/></xs:schema></types></definitions>`,
+		"soap/types/orders.xsd": `<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema"/>`,
+	}
+	for name, content := range files {
+		path := filepath.Join(tempDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("Failed to create directory: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write test file: %v", err)
+		}
+	}
+
+	specs := []config.SpecMetadata{
+		{
+			Name:     "OrderService",
+			FilePath: filepath.Join(tempDir, "soap", "orders.wsdl"),
+			Type:     config.DocTypeWSDL11,
+			ApiType:  config.ApiTypeSOAP,
+			Format:   config.FormatXML,
+			FileId:   "orders-wsdl",
+			Assets:   []string{filepath.Join(tempDir, "soap", "types", "orders.xsd")},
+		},
+	}
+
+	endpoints := New(specs, config.DiscoveryConfig{ScanDirectory: tempDir}).Generate()
+
+	endpointsByPath := make(map[string]config.EndpointConfig)
+	for _, endpoint := range endpoints {
+		endpointsByPath[endpoint.Path] = endpoint
+	}
+
+	wsdl, ok := endpointsByPath["/v3/api-docs/orders-wsdl"]
+	if !ok {
+		t.Fatal("Expected WSDL endpoint")
+	}
+	w := httptest.NewRecorder()
+	wsdl.Handler(w, httptest.NewRequest("GET", wsdl.Path, nil))
+
+	if w.Header().Get("Content-Type") != "application/xml" {
+		t.Errorf("Expected content type application/xml, got %s", w.Header().Get("Content-Type"))
+	}
+	expected := `<definitions xmlns="http://schemas.xmlsoap.org/wsdl/" xmlns:xs="http://www.w3.org/2001/XMLSchema"><types><xs:schema><xs:import schemaLocation="orders-wsdl/imports/soap/types/orders.xsd"/></xs:schema></types></definitions>`
+	if w.Body.String() != expected {
+		t.Errorf("Expected rewritten document %q, got %q", expected, w.Body.String())
+	}
+
+	xsd, ok := endpointsByPath["/v3/api-docs/orders-wsdl/imports/soap/types/orders.xsd"]
+	if !ok {
+		t.Fatal("Expected imported XSD endpoint")
+	}
+	w = httptest.NewRecorder()
+	xsd.Handler(w, httptest.NewRequest("GET", xsd.Path, nil))
+	if w.Header().Get("Content-Type") != "application/xml" || w.Body.String() != files["soap/types/orders.xsd"] {
+		t.Errorf("Expected imported XSD, got %s %q", w.Header().Get("Content-Type"), w.Body.String())
+	}
+}
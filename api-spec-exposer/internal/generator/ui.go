@@ -0,0 +1,139 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/document"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/graphql"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/loader"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/ui"
+)
+
+const (
+	swaggerUIPath       = "/swagger-ui"
+	graphQLExplorerPath = "/graphql-explorer"
+)
+
+// explorerCSP restricts the GraphQL schema explorer to its inline stylesheet
+const explorerCSP = "default-src 'none'; style-src 'unsafe-inline'"
+
+// generateDocumentationUI generates endpoints of the documentation pages enabled in the configuration
+func (g *Generator) generateDocumentationUI(specMap map[string]*config.SpecMetadata, configMap map[string][]config.ConfigURL) []config.EndpointConfig {
+	var endpoints []config.EndpointConfig
+
+	if g.config.DocumentationUI.SwaggerUI {
+		if _, ok := configMap["/v3/api-docs/swagger-config"]; ok {
+			endpoints = append(endpoints, swaggerUIEndpoints("/v3/api-docs/swagger-config", "")...)
+		} else if spec, ok := specMap["/v3/api-docs"]; ok && spec.ApiType == config.ApiTypeRest {
+			endpoints = append(endpoints, swaggerUIEndpoints("", "/v3/api-docs")...)
+		}
+	}
+
+	if g.config.DocumentationUI.GraphQLExplorer {
+		var paths []string
+		for specPath, spec := range specMap {
+			if spec.ApiType == config.ApiTypeGraphQL && !g.derivedPaths[specPath] {
+				paths = append(paths, specPath)
+			}
+		}
+		if len(paths) > 0 {
+			sort.Strings(paths)
+			endpoints = append(endpoints, config.EndpointConfig{
+				Path:    graphQLExplorerPath,
+				Handler: graphQLExplorerHandler(paths, specMap),
+			})
+		}
+	}
+
+	return endpoints
+}
+
+// swaggerUIEndpoints generates endpoints of the embedded Swagger UI files and of its initializer
+// loading the spec list from configURL or the single spec from specURL
+func swaggerUIEndpoints(configURL string, specURL string) []config.EndpointConfig {
+	files := ui.SwaggerUIFiles()
+	// Embedded files have no modification time, the start time keeps conditional requests working
+	modTime := time.Now()
+
+	var endpoints []config.EndpointConfig
+	fs.WalkDir(files, ".", func(name string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		content, err := fs.ReadFile(files, name)
+		if err != nil {
+			return err
+		}
+		endpoints = append(endpoints, config.EndpointConfig{
+			Path:    path.Join(swaggerUIPath, name),
+			Handler: staticContentHandler(name, modTime, content),
+		})
+		return nil
+	})
+
+	initializer := []byte(ui.SwaggerInitializer(configURL, specURL))
+	endpoints = append(endpoints,
+		config.EndpointConfig{
+			Path:    swaggerUIPath + "/swagger-initializer.js",
+			Handler: staticContentHandler("swagger-initializer.js", modTime, initializer),
+		},
+		config.EndpointConfig{
+			Path: "/swagger-ui.html",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				http.Redirect(w, r, swaggerUIPath+"/index.html", http.StatusFound)
+			},
+		},
+	)
+	return endpoints
+}
+
+func staticContentHandler(name string, modTime time.Time, content []byte) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		http.ServeContent(w, r, name, modTime, bytes.NewReader(content))
+	}
+}
+
+func graphQLExplorerHandler(paths []string, specMap map[string]*config.SpecMetadata) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		schemas := make([]ui.GraphQLSchema, 0, len(paths))
+		for _, specPath := range paths {
+			spec := specMap[specPath]
+			doc, err := loadGraphQLDocument(spec)
+			schemas = append(schemas, ui.GraphQLSchema{Name: spec.Name, URL: specPath, Document: doc, Error: err})
+		}
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Header().Set("Content-Security-Policy", explorerCSP)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(ui.GraphQLExplorer(schemas)))
+	}
+}
+
+// loadGraphQLDocument parses the schema of a GraphQL SDL or introspection spec
+func loadGraphQLDocument(spec *config.SpecMetadata) (*graphql.Document, error) {
+	content, err := loader.Read(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	if spec.Type != config.DocTypeIntrospection {
+		return graphql.Parse(string(content))
+	}
+
+	data, err := document.Decode(content, config.FormatJSON)
+	if err != nil {
+		return nil, err
+	}
+	introspection := graphql.IntrospectionSchema(data)
+	if introspection == nil {
+		return nil, fmt.Errorf("file %s does not contain an introspection schema", spec.FilePath)
+	}
+	return graphql.FromIntrospection(introspection)
+}
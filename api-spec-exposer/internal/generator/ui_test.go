@@ -0,0 +1,104 @@
+package generator
+
+import (
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+)
+
+func TestGeneratorDocumentationUI(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "generator-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	files := map[string]string{
+		"users.yaml":     "openapi: 3.0.0",
+		"orders.yaml":    "openapi: 3.0.0",
+		"schema.graphql": "type Query { users: [User] }\ntype User { id: ID! }",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(tempDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write test file: %v", err)
+		}
+	}
+
+	restSpecs := []config.SpecMetadata{
+		{Name: "Users", FilePath: filepath.Join(tempDir, "users.yaml"), Type: config.DocTypeOpenAPI30, ApiType: config.ApiTypeRest, Format: config.FormatYAML, FileId: "users-yaml"},
+		{Name: "Orders", FilePath: filepath.Join(tempDir, "orders.yaml"), Type: config.DocTypeOpenAPI30, ApiType: config.ApiTypeRest, Format: config.FormatYAML, FileId: "orders-yaml"},
+	}
+	graphQLSpec := config.SpecMetadata{Name: "Schema", FilePath: filepath.Join(tempDir, "schema.graphql"), Type: config.DocTypeGraphQL, ApiType: config.ApiTypeGraphQL, Format: config.FormatGraphQL, FileId: "schema-graphql"}
+
+	tests := []struct {
+		name        string
+		specs       []config.SpecMetadata
+		uiConfig    config.DocumentationUIConfig
+		initializer string
+		explorer    bool
+	}{
+		{
+			name:        "swagger config",
+			specs:       append(restSpecs, graphQLSpec),
+			uiConfig:    config.DocumentationUIConfig{SwaggerUI: true, GraphQLExplorer: true},
+			initializer: `"configUrl":"/v3/api-docs/swagger-config"`,
+			explorer:    true,
+		},
+		{
+			name:        "single spec",
+			specs:       restSpecs[:1],
+			uiConfig:    config.DocumentationUIConfig{SwaggerUI: true, GraphQLExplorer: true},
+			initializer: `"url":"/v3/api-docs"`,
+		},
+		{
+			name:  "disabled",
+			specs: append(restSpecs, graphQLSpec),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			endpoints := New(tt.specs, config.DiscoveryConfig{ScanDirectory: tempDir, DocumentationUI: tt.uiConfig}).Generate()
+
+			endpointsByPath := make(map[string]config.EndpointConfig)
+			for _, endpoint := range endpoints {
+				endpointsByPath[endpoint.Path] = endpoint
+			}
+
+			initializer, ok := endpointsByPath["/swagger-ui/swagger-initializer.js"]
+			if ok != (tt.initializer != "") {
+				t.Fatalf("Expected Swagger UI endpoints %v, got %v", tt.initializer != "", ok)
+			}
+			if ok {
+				w := httptest.NewRecorder()
+				initializer.Handler(w, httptest.NewRequest("GET", initializer.Path, nil))
+				if !strings.Contains(w.Body.String(), tt.initializer) {
+					t.Errorf("Expected initializer with %s, got %q", tt.initializer, w.Body.String())
+				}
+
+				index := endpointsByPath["/swagger-ui/index.html"]
+				w = httptest.NewRecorder()
+				index.Handler(w, httptest.NewRequest("GET", index.Path, nil))
+				if !strings.HasPrefix(w.Header().Get("Content-Type"), "text/html") || !strings.Contains(w.Body.String(), "swagger-ui-bundle.js") {
+					t.Errorf("Expected Swagger UI page, got %s %q", w.Header().Get("Content-Type"), w.Body.String())
+				}
+			}
+
+			explorer, ok := endpointsByPath["/graphql-explorer"]
+			if ok != tt.explorer {
+				t.Fatalf("Expected GraphQL explorer endpoint %v, got %v", tt.explorer, ok)
+			}
+			if ok {
+				w := httptest.NewRecorder()
+				explorer.Handler(w, httptest.NewRequest("GET", explorer.Path, nil))
+				if !strings.Contains(w.Body.String(), `<code>users: [<a href="#schema-1-User">User</a>]</code>`) {
+					t.Errorf("Expected explorer page with schema types, got %q", w.Body.String())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,226 @@
+package graphql
+
+// DefinitionKind represents the kind of a type system definition
+type DefinitionKind string
+
+const (
+	KindSchema    DefinitionKind = "schema"
+	KindScalar    DefinitionKind = "scalar"
+	KindObject    DefinitionKind = "type"
+	KindInterface DefinitionKind = "interface"
+	KindUnion     DefinitionKind = "union"
+	KindEnum      DefinitionKind = "enum"
+	KindInput     DefinitionKind = "input"
+	KindDirective DefinitionKind = "directive"
+)
+
+// Document is a parsed GraphQL SDL document
+type Document struct {
+	Definitions []*Definition
+}
+
+// Definition is a type system definition or extension
+type Definition struct {
+	Kind           DefinitionKind
+	Extension      bool   // true for 'extend ...' definitions
+	Name           string // empty for schema definitions
+	Description    string
+	Directives     []Directive
+	Interfaces     []string                  // object and interface types
+	Fields         []FieldDefinition         // object and interface types
+	InputFields    []InputValueDefinition    // input types
+	EnumValues     []EnumValueDefinition     // enum types
+	Types          []string                  // union member types
+	OperationTypes []OperationTypeDefinition // schema definitions
+	Arguments      []InputValueDefinition    // directive definitions
+	Repeatable     bool                      // directive definitions
+	Locations      []string                  // directive definitions
+	Loc            Location
+	Source         string // file the definition was loaded from, empty for parsed strings
+}
+
+// FieldDefinition is a field of an object or interface type
+type FieldDefinition struct {
+	Name        string
+	Description string
+	Arguments   []InputValueDefinition
+	Type        *Type
+	Directives  []Directive
+	Loc         Location
+}
+
+// InputValueDefinition is an argument or an input object field
+type InputValueDefinition struct {
+	Name         string
+	Description  string
+	Type         *Type
+	DefaultValue string // source text of the default value, empty if not set
+	Directives   []Directive
+	Loc          Location
+}
+
+// EnumValueDefinition is a value of an enum type
+type EnumValueDefinition struct {
+	Name        string
+	Description string
+	Directives  []Directive
+	Loc         Location
+}
+
+// OperationTypeDefinition binds a root operation to an object type
+type OperationTypeDefinition struct {
+	Operation string // query, mutation or subscription
+	Type      string
+}
+
+// Directive is an applied directive
+type Directive struct {
+	Name      string
+	Arguments []Argument
+}
+
+// Argument is a directive argument
+type Argument struct {
+	Name  string
+	Value string // source text of the value
+}
+
+// Type is a type reference, either a named type or a list, possibly non-null
+type Type struct {
+	Name    string // set for named types
+	OfType  *Type  // set for list types
+	NonNull bool
+}
+
+func (t *Type) String() string {
+	var s string
+	if t.OfType != nil {
+		s = "[" + t.OfType.String() + "]"
+	} else {
+		s = t.Name
+	}
+	if t.NonNull {
+		s += "!"
+	}
+	return s
+}
+
+// NamedType returns the name of the innermost named type
+func (t *Type) NamedType() string {
+	for t.OfType != nil {
+		t = t.OfType
+	}
+	return t.Name
+}
+
+// Field describes a field of a GraphQL object type
+type Field struct {
+	Name        string
+	Description string
+	Deprecated  bool
+}
+
+// RootTypes contains names of the root operation types of a schema
+type RootTypes struct {
+	Query        string
+	Mutation     string
+	Subscription string
+}
+
+// SchemaDescription returns the description of the schema definition
+func (d *Document) SchemaDescription() string {
+	for _, def := range d.Definitions {
+		if def.Kind == KindSchema && !def.Extension {
+			return def.Description
+		}
+	}
+	return ""
+}
+
+// RootTypes returns root operation type names declared in schema definitions and extensions,
+// falling back to the default type names (Query, Mutation, Subscription) when the document defines them
+func (d *Document) RootTypes() RootTypes {
+	var roots RootTypes
+	declared := false
+	for _, def := range d.Definitions {
+		if def.Kind != KindSchema {
+			continue
+		}
+		for _, operationType := range def.OperationTypes {
+			declared = true
+			switch operationType.Operation {
+			case "query":
+				roots.Query = operationType.Type
+			case "mutation":
+				roots.Mutation = operationType.Type
+			case "subscription":
+				roots.Subscription = operationType.Type
+			}
+		}
+	}
+	if declared {
+		return roots
+	}
+
+	if d.hasType("Query") {
+		roots.Query = "Query"
+	}
+	if d.hasType("Mutation") {
+		roots.Mutation = "Mutation"
+	}
+	if d.hasType("Subscription") {
+		roots.Subscription = "Subscription"
+	}
+	return roots
+}
+
+// TypeCount returns the number of named type definitions, type extensions are not counted
+func (d *Document) TypeCount() int {
+	count := 0
+	for _, def := range d.Definitions {
+		if !def.Extension && def.Kind != KindSchema && def.Kind != KindDirective {
+			count++
+		}
+	}
+	return count
+}
+
+// ObjectFields returns fields of an object type including the fields of its extensions
+func (d *Document) ObjectFields(typeName string) []Field {
+	if typeName == "" {
+		return nil
+	}
+
+	var fields []Field
+	for _, def := range d.Definitions {
+		if def.Kind != KindObject || def.Name != typeName {
+			continue
+		}
+		for _, field := range def.Fields {
+			fields = append(fields, Field{
+				Name:        field.Name,
+				Description: field.Description,
+				Deprecated:  hasDirective(field.Directives, "deprecated"),
+			})
+		}
+	}
+	return fields
+}
+
+func (d *Document) hasType(name string) bool {
+	for _, def := range d.Definitions {
+		if def.Name == name && def.Kind != KindSchema && def.Kind != KindDirective {
+			return true
+		}
+	}
+	return false
+}
+
+func hasDirective(directives []Directive, name string) bool {
+	for _, directive := range directives {
+		if directive.Name == name {
+			return true
+		}
+	}
+	return false
+}
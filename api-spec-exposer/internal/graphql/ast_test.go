@@ -0,0 +1,115 @@
+package graphql
+
+import (
+	"testing"
+)
+
+func TestDocumentRootTypes(t *testing.T) {
+	tests := []struct {
+		name     string
+		sdl      string
+		expected RootTypes
+	}{
+		{
+			name:     "default root types",
+			sdl:      "type Query { users: [User] }\ntype Subscription { userAdded: User }",
+			expected: RootTypes{Query: "Query", Subscription: "Subscription"},
+		},
+		{
+			name:     "schema definition",
+			sdl:      "schema { query: RootQuery mutation: RootMutation }\ntype RootQuery { users: [User] }\ntype Query { unused: Int }",
+			expected: RootTypes{Query: "RootQuery", Mutation: "RootMutation"},
+		},
+		{
+			name:     "schema extension",
+			sdl:      "schema { query: RootQuery }\nextend schema { subscription: Events }",
+			expected: RootTypes{Query: "RootQuery", Subscription: "Events"},
+		},
+		{
+			name:     "schema keyword inside description",
+			sdl:      "\"\"\"schema { query: Fake }\"\"\"\ntype Query { users: [User] }",
+			expected: RootTypes{Query: "Query"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := Parse(tt.sdl)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if roots := doc.RootTypes(); roots != tt.expected {
+				t.Errorf("Expected %+v, got %+v", tt.expected, roots)
+			}
+		})
+	}
+}
+
+func TestDocumentTypeCount(t *testing.T) {
+	doc, err := Parse(`
+# type Commented { id: ID }
+type Query { users: [User] }
+type User { id: ID! }
+input UserInput { name: String }
+enum Role { ADMIN USER }
+directive @auth on FIELD_DEFINITION
+extend type Query { roles: [Role] }
+`)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if count := doc.TypeCount(); count != 4 {
+		t.Errorf("Expected 4 types, got %d", count)
+	}
+}
+
+func TestDocumentObjectFields(t *testing.T) {
+	doc, err := Parse(`
+type Query {
+	"Find users"
+	users(filter: UserFilter = {name: "a}b"}, limit: Int): [User]
+	user(id: ID!): User @deprecated(reason: "Use users")
+}
+
+extend type Query {
+	roles: [String]
+}
+`)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	fields := doc.ObjectFields("Query")
+
+	expected := []Field{
+		{Name: "users", Description: "Find users"},
+		{Name: "user", Deprecated: true},
+		{Name: "roles"},
+	}
+
+	if len(fields) != len(expected) {
+		t.Fatalf("Expected %d fields, got %d: %+v", len(expected), len(fields), fields)
+	}
+
+	for i, field := range fields {
+		if field != expected[i] {
+			t.Errorf("Expected field %+v, got %+v", expected[i], field)
+		}
+	}
+
+	if fields := doc.ObjectFields("Mutation"); len(fields) != 0 {
+		t.Errorf("Expected no fields for missing type, got %+v", fields)
+	}
+}
+
+func TestDocumentSchemaDescription(t *testing.T) {
+	doc, err := Parse("\"Users API\"\nschema { query: Query }\ntype Query { users: [String] }")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if description := doc.SchemaDescription(); description != "Users API" {
+		t.Errorf("Expected description 'Users API', got '%s'", description)
+	}
+}
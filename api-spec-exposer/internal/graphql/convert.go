@@ -0,0 +1,569 @@
+package graphql
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const defaultDeprecationReason = "No longer supported"
+
+var builtInScalars = []string{"String", "Int", "Float", "Boolean", "ID"}
+
+// standardDirectives are the directives every GraphQL schema supports, they are not printed to SDL
+var standardDirectives = map[string]bool{"include": true, "skip": true, "deprecated": true, "specifiedBy": true, "oneOf": true}
+
+// IsBuiltInScalar returns true for the scalar types defined by the GraphQL specification
+func IsBuiltInScalar(name string) bool {
+	for _, scalar := range builtInScalars {
+		if scalar == name {
+			return true
+		}
+	}
+	return false
+}
+
+// ToIntrospection builds an introspection result ({"data": {"__schema": ...}}) describing the schema of the document.
+// Type extensions are merged into the extended types
+func ToIntrospection(doc *Document) (map[string]interface{}, error) {
+	types, order := mergeDefinitions(doc)
+	for _, scalar := range builtInScalars {
+		if _, ok := types[scalar]; !ok {
+			types[scalar] = &Definition{Kind: KindScalar, Name: scalar}
+			order = append(order, scalar)
+		}
+	}
+
+	b := &introspectionBuilder{types: types}
+
+	var typeList []interface{}
+	for _, name := range order {
+		typeDef, err := b.typeDefinition(types[name])
+		if err != nil {
+			return nil, err
+		}
+		typeList = append(typeList, typeDef)
+	}
+
+	standard, err := Parse(standardDirectiveDefinitions)
+	if err != nil {
+		return nil, err
+	}
+	definedDirectives := make(map[string]bool)
+	for _, def := range doc.Definitions {
+		if def.Kind == KindDirective {
+			definedDirectives[def.Name] = true
+		}
+	}
+	var directiveDefs []*Definition
+	for _, def := range standard.Definitions {
+		if !definedDirectives[def.Name] {
+			directiveDefs = append(directiveDefs, def)
+		}
+	}
+	for _, def := range doc.Definitions {
+		if def.Kind == KindDirective {
+			directiveDefs = append(directiveDefs, def)
+		}
+	}
+
+	var directives []interface{}
+	for _, def := range directiveDefs {
+		args, err := b.inputValues(def.Arguments)
+		if err != nil {
+			return nil, err
+		}
+		locations := make([]interface{}, 0, len(def.Locations))
+		for _, location := range def.Locations {
+			locations = append(locations, location)
+		}
+		directives = append(directives, map[string]interface{}{
+			"name":         def.Name,
+			"description":  nullableString(def.Description),
+			"isRepeatable": def.Repeatable,
+			"locations":    locations,
+			"args":         args,
+		})
+	}
+
+	roots := doc.RootTypes()
+	schema := map[string]interface{}{
+		"description":      nullableString(doc.SchemaDescription()),
+		"queryType":        rootTypeRef(roots.Query),
+		"mutationType":     rootTypeRef(roots.Mutation),
+		"subscriptionType": rootTypeRef(roots.Subscription),
+		"types":            typeList,
+		"directives":       directives,
+	}
+
+	return map[string]interface{}{"data": map[string]interface{}{"__schema": schema}}, nil
+}
+
+const standardDirectiveDefinitions = `
+directive @include(if: Boolean!) on FIELD | FRAGMENT_SPREAD | INLINE_FRAGMENT
+directive @skip(if: Boolean!) on FIELD | FRAGMENT_SPREAD | INLINE_FRAGMENT
+directive @deprecated(reason: String = "No longer supported") on FIELD_DEFINITION | ARGUMENT_DEFINITION | INPUT_FIELD_DEFINITION | ENUM_VALUE
+directive @specifiedBy(url: String!) on SCALAR
+`
+
+// mergeDefinitions merges type extensions into the type definitions, returns types by name and the order of their appearance
+func mergeDefinitions(doc *Document) (map[string]*Definition, []string) {
+	types := make(map[string]*Definition)
+	var order []string
+	for _, def := range doc.Definitions {
+		if def.Kind == KindSchema || def.Kind == KindDirective {
+			continue
+		}
+		merged, ok := types[def.Name]
+		if !ok {
+			copyDef := *def
+			copyDef.Extension = false
+			copyDef.Directives = append([]Directive(nil), def.Directives...)
+			copyDef.Interfaces = append([]string(nil), def.Interfaces...)
+			copyDef.Fields = append([]FieldDefinition(nil), def.Fields...)
+			copyDef.InputFields = append([]InputValueDefinition(nil), def.InputFields...)
+			copyDef.EnumValues = append([]EnumValueDefinition(nil), def.EnumValues...)
+			copyDef.Types = append([]string(nil), def.Types...)
+			types[def.Name] = &copyDef
+			order = append(order, def.Name)
+			continue
+		}
+		if !def.Extension && merged.Description == "" {
+			merged.Description = def.Description
+		}
+		merged.Directives = append(merged.Directives, def.Directives...)
+		merged.Interfaces = append(merged.Interfaces, def.Interfaces...)
+		merged.Fields = append(merged.Fields, def.Fields...)
+		merged.InputFields = append(merged.InputFields, def.InputFields...)
+		merged.EnumValues = append(merged.EnumValues, def.EnumValues...)
+		merged.Types = append(merged.Types, def.Types...)
+	}
+	return types, order
+}
+
+type introspectionBuilder struct {
+	types map[string]*Definition
+}
+
+var introspectionKinds = map[DefinitionKind]string{
+	KindScalar:    "SCALAR",
+	KindObject:    "OBJECT",
+	KindInterface: "INTERFACE",
+	KindUnion:     "UNION",
+	KindEnum:      "ENUM",
+	KindInput:     "INPUT_OBJECT",
+}
+
+func (b *introspectionBuilder) typeDefinition(def *Definition) (map[string]interface{}, error) {
+	result := map[string]interface{}{
+		"kind":           introspectionKinds[def.Kind],
+		"name":           def.Name,
+		"description":    nullableString(def.Description),
+		"specifiedByURL": nil,
+		"fields":         nil,
+		"inputFields":    nil,
+		"interfaces":     nil,
+		"enumValues":     nil,
+		"possibleTypes":  nil,
+	}
+
+	switch def.Kind {
+	case KindScalar:
+		if url, ok := directiveArgument(def.Directives, "specifiedBy", "url"); ok {
+			result["specifiedByURL"] = url
+		}
+	case KindObject, KindInterface:
+		fields := make([]interface{}, 0, len(def.Fields))
+		for _, field := range def.Fields {
+			fieldType, err := b.typeRef(field.Type)
+			if err != nil {
+				return nil, err
+			}
+			args, err := b.inputValues(field.Arguments)
+			if err != nil {
+				return nil, err
+			}
+			deprecated, reason := deprecation(field.Directives)
+			fields = append(fields, map[string]interface{}{
+				"name":              field.Name,
+				"description":       nullableString(field.Description),
+				"args":              args,
+				"type":              fieldType,
+				"isDeprecated":      deprecated,
+				"deprecationReason": reason,
+			})
+		}
+		result["fields"] = fields
+
+		interfaces := make([]interface{}, 0, len(def.Interfaces))
+		for _, name := range def.Interfaces {
+			ref, err := b.namedTypeRef(name)
+			if err != nil {
+				return nil, err
+			}
+			interfaces = append(interfaces, ref)
+		}
+		result["interfaces"] = interfaces
+
+		if def.Kind == KindInterface {
+			possibleTypes := make([]interface{}, 0)
+			for _, name := range sortedDefinitionNames(b.types) {
+				candidate := b.types[name]
+				if candidate.Kind == KindObject && containsName(candidate.Interfaces, def.Name) {
+					possibleTypes = append(possibleTypes, map[string]interface{}{"kind": "OBJECT", "name": name, "ofType": nil})
+				}
+			}
+			result["possibleTypes"] = possibleTypes
+		}
+	case KindUnion:
+		possibleTypes := make([]interface{}, 0, len(def.Types))
+		for _, name := range def.Types {
+			ref, err := b.namedTypeRef(name)
+			if err != nil {
+				return nil, err
+			}
+			possibleTypes = append(possibleTypes, ref)
+		}
+		result["possibleTypes"] = possibleTypes
+	case KindEnum:
+		values := make([]interface{}, 0, len(def.EnumValues))
+		for _, value := range def.EnumValues {
+			deprecated, reason := deprecation(value.Directives)
+			values = append(values, map[string]interface{}{
+				"name":              value.Name,
+				"description":       nullableString(value.Description),
+				"isDeprecated":      deprecated,
+				"deprecationReason": reason,
+			})
+		}
+		result["enumValues"] = values
+	case KindInput:
+		inputFields, err := b.inputValues(def.InputFields)
+		if err != nil {
+			return nil, err
+		}
+		result["inputFields"] = inputFields
+	}
+
+	return result, nil
+}
+
+func (b *introspectionBuilder) inputValues(values []InputValueDefinition) ([]interface{}, error) {
+	result := make([]interface{}, 0, len(values))
+	for _, value := range values {
+		valueType, err := b.typeRef(value.Type)
+		if err != nil {
+			return nil, err
+		}
+		deprecated, reason := deprecation(value.Directives)
+		result = append(result, map[string]interface{}{
+			"name":              value.Name,
+			"description":       nullableString(value.Description),
+			"type":              valueType,
+			"defaultValue":      nullableString(value.DefaultValue),
+			"isDeprecated":      deprecated,
+			"deprecationReason": reason,
+		})
+	}
+	return result, nil
+}
+
+func (b *introspectionBuilder) typeRef(t *Type) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	if t.OfType != nil {
+		ofType, err := b.typeRef(t.OfType)
+		if err != nil {
+			return nil, err
+		}
+		result = map[string]interface{}{"kind": "LIST", "name": nil, "ofType": ofType}
+	} else {
+		ref, err := b.namedTypeRef(t.Name)
+		if err != nil {
+			return nil, err
+		}
+		result = ref
+	}
+
+	if t.NonNull {
+		return map[string]interface{}{"kind": "NON_NULL", "name": nil, "ofType": result}, nil
+	}
+	return result, nil
+}
+
+func (b *introspectionBuilder) namedTypeRef(name string) (map[string]interface{}, error) {
+	def, ok := b.types[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown type '%s'", name)
+	}
+	return map[string]interface{}{"kind": introspectionKinds[def.Kind], "name": name, "ofType": nil}, nil
+}
+
+func deprecation(directives []Directive) (bool, interface{}) {
+	for _, directive := range directives {
+		if directive.Name != "deprecated" {
+			continue
+		}
+		if reason, ok := directiveArgument([]Directive{directive}, "deprecated", "reason"); ok {
+			return true, reason
+		}
+		return true, defaultDeprecationReason
+	}
+	return false, nil
+}
+
+func directiveArgument(directives []Directive, directiveName string, argumentName string) (string, bool) {
+	for _, directive := range directives {
+		if directive.Name != directiveName {
+			continue
+		}
+		for _, argument := range directive.Arguments {
+			if argument.Name == argumentName {
+				value, err := UnquoteString(argument.Value)
+				return value, err == nil
+			}
+		}
+	}
+	return "", false
+}
+
+func rootTypeRef(name string) interface{} {
+	if name == "" {
+		return nil
+	}
+	return map[string]interface{}{"kind": "OBJECT", "name": name}
+}
+
+func nullableString(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
+}
+
+// FromIntrospection builds an SDL document from an introspection '__schema' object.
+// Introspection types, built-in scalars and standard directives are omitted
+func FromIntrospection(schema map[string]interface{}) (*Document, error) {
+	if _, err := CheckIntrospection(schema); err != nil {
+		return nil, err
+	}
+
+	doc := &Document{}
+
+	roots := IntrospectionRootTypes(schema)
+	description, _ := schema["description"].(string)
+	if description != "" || (roots.Query != "" && roots.Query != "Query") ||
+		(roots.Mutation != "" && roots.Mutation != "Mutation") ||
+		(roots.Subscription != "" && roots.Subscription != "Subscription") {
+		schemaDef := &Definition{Kind: KindSchema, Description: description}
+		for _, root := range []OperationTypeDefinition{{"query", roots.Query}, {"mutation", roots.Mutation}, {"subscription", roots.Subscription}} {
+			if root.Type != "" {
+				schemaDef.OperationTypes = append(schemaDef.OperationTypes, root)
+			}
+		}
+		doc.Definitions = append(doc.Definitions, schemaDef)
+	}
+
+	directives, _ := schema["directives"].([]interface{})
+	for _, item := range directives {
+		directive, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := directive["name"].(string)
+		if name == "" || standardDirectives[name] {
+			continue
+		}
+		def := &Definition{Kind: KindDirective, Name: name}
+		def.Description, _ = directive["description"].(string)
+		def.Repeatable, _ = directive["isRepeatable"].(bool)
+		locations, _ := directive["locations"].([]interface{})
+		for _, location := range locations {
+			if text, ok := location.(string); ok {
+				def.Locations = append(def.Locations, text)
+			}
+		}
+		if len(def.Locations) == 0 {
+			return nil, fmt.Errorf("directive '%s' has no locations", name)
+		}
+		var err error
+		if def.Arguments, err = fromIntrospectionInputValues(directive["args"]); err != nil {
+			return nil, err
+		}
+		doc.Definitions = append(doc.Definitions, def)
+	}
+
+	types, _ := schema["types"].([]interface{})
+	for _, item := range types {
+		typeDef, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := typeDef["name"].(string)
+		if name == "" || strings.HasPrefix(name, "__") || IsBuiltInScalar(name) {
+			continue
+		}
+		def, err := fromIntrospectionType(typeDef)
+		if err != nil {
+			return nil, err
+		}
+		doc.Definitions = append(doc.Definitions, def)
+	}
+
+	if len(doc.Definitions) == 0 {
+		return nil, fmt.Errorf("introspection result does not contain any types")
+	}
+	return doc, nil
+}
+
+func fromIntrospectionType(typeDef map[string]interface{}) (*Definition, error) {
+	name, _ := typeDef["name"].(string)
+	def := &Definition{Name: name}
+	def.Description, _ = typeDef["description"].(string)
+
+	kind, _ := typeDef["kind"].(string)
+	switch kind {
+	case "SCALAR":
+		def.Kind = KindScalar
+		if url, ok := typeDef["specifiedByURL"].(string); ok && url != "" {
+			def.Directives = append(def.Directives, Directive{Name: "specifiedBy", Arguments: []Argument{{Name: "url", Value: QuoteString(url)}}})
+		}
+	case "OBJECT", "INTERFACE":
+		def.Kind = KindObject
+		if kind == "INTERFACE" {
+			def.Kind = KindInterface
+		}
+		def.Interfaces = introspectionTypeNames(typeDef["interfaces"])
+		fields, _ := typeDef["fields"].([]interface{})
+		for _, fieldItem := range fields {
+			field, ok := fieldItem.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			fieldDef := FieldDefinition{Directives: fromIntrospectionDeprecation(field)}
+			fieldDef.Name, _ = field["name"].(string)
+			fieldDef.Description, _ = field["description"].(string)
+			var err error
+			if fieldDef.Type, err = fromIntrospectionTypeRef(field["type"]); err != nil {
+				return nil, fmt.Errorf("field '%s.%s': %w", name, fieldDef.Name, err)
+			}
+			if fieldDef.Arguments, err = fromIntrospectionInputValues(field["args"]); err != nil {
+				return nil, fmt.Errorf("field '%s.%s': %w", name, fieldDef.Name, err)
+			}
+			def.Fields = append(def.Fields, fieldDef)
+		}
+	case "UNION":
+		def.Kind = KindUnion
+		def.Types = introspectionTypeNames(typeDef["possibleTypes"])
+	case "ENUM":
+		def.Kind = KindEnum
+		values, _ := typeDef["enumValues"].([]interface{})
+		for _, valueItem := range values {
+			value, ok := valueItem.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			valueDef := EnumValueDefinition{Directives: fromIntrospectionDeprecation(value)}
+			valueDef.Name, _ = value["name"].(string)
+			valueDef.Description, _ = value["description"].(string)
+			def.EnumValues = append(def.EnumValues, valueDef)
+		}
+	case "INPUT_OBJECT":
+		def.Kind = KindInput
+		var err error
+		if def.InputFields, err = fromIntrospectionInputValues(typeDef["inputFields"]); err != nil {
+			return nil, fmt.Errorf("input type '%s': %w", name, err)
+		}
+	default:
+		return nil, fmt.Errorf("type '%s' has invalid kind '%s'", name, kind)
+	}
+	return def, nil
+}
+
+func fromIntrospectionInputValues(value interface{}) ([]InputValueDefinition, error) {
+	var result []InputValueDefinition
+	items, _ := value.([]interface{})
+	for _, item := range items {
+		input, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		inputDef := InputValueDefinition{Directives: fromIntrospectionDeprecation(input)}
+		inputDef.Name, _ = input["name"].(string)
+		inputDef.Description, _ = input["description"].(string)
+		inputDef.DefaultValue, _ = input["defaultValue"].(string)
+		var err error
+		if inputDef.Type, err = fromIntrospectionTypeRef(input["type"]); err != nil {
+			return nil, fmt.Errorf("argument '%s': %w", inputDef.Name, err)
+		}
+		result = append(result, inputDef)
+	}
+	return result, nil
+}
+
+func fromIntrospectionTypeRef(value interface{}) (*Type, error) {
+	typeRef, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("type reference is missing")
+	}
+	kind, _ := typeRef["kind"].(string)
+	switch kind {
+	case "NON_NULL":
+		ofType, err := fromIntrospectionTypeRef(typeRef["ofType"])
+		if err != nil {
+			return nil, err
+		}
+		ofType.NonNull = true
+		return ofType, nil
+	case "LIST":
+		ofType, err := fromIntrospectionTypeRef(typeRef["ofType"])
+		if err != nil {
+			return nil, err
+		}
+		return &Type{OfType: ofType}, nil
+	}
+	name, _ := typeRef["name"].(string)
+	if name == "" {
+		return nil, fmt.Errorf("type reference has no name")
+	}
+	return &Type{Name: name}, nil
+}
+
+func fromIntrospectionDeprecation(item map[string]interface{}) []Directive {
+	if deprecated, _ := item["isDeprecated"].(bool); !deprecated {
+		return nil
+	}
+	reason, _ := item["deprecationReason"].(string)
+	if reason == "" || reason == defaultDeprecationReason {
+		return []Directive{{Name: "deprecated"}}
+	}
+	return []Directive{{Name: "deprecated", Arguments: []Argument{{Name: "reason", Value: QuoteString(reason)}}}}
+}
+
+func introspectionTypeNames(value interface{}) []string {
+	var names []string
+	items, _ := value.([]interface{})
+	for _, item := range items {
+		if name := introspectionTypeName(item); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func sortedDefinitionNames(types map[string]*Definition) []string {
+	names := make([]string, 0, len(types))
+	for name := range types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func containsName(names []string, name string) bool {
+	for _, item := range names {
+		if item == name {
+			return true
+		}
+	}
+	return false
+}
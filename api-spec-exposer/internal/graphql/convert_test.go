@@ -0,0 +1,177 @@
+package graphql
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+const convertTestSDL = `"Users service"
+schema {
+  query: RootQuery
+  mutation: Mutation
+}
+
+type RootQuery {
+  "Find a user"
+  user(id: ID!): User
+  users(first: Int = 10): [User!]! @deprecated(reason: "Use search")
+  node(id: ID!): Node
+}
+
+type Mutation {
+  setRole(role: Role!): User
+}
+
+interface Node {
+  id: ID!
+}
+
+type User implements Node {
+  id: ID!
+  role: Role
+  born: Date
+}
+
+enum Role {
+  ADMIN
+  USER @deprecated
+}
+
+input UserFilter {
+  name: String
+  roles: [Role!] = [ADMIN]
+}
+
+union SearchResult = User
+
+scalar Date @specifiedBy(url: "https://tools.ietf.org/html/rfc3339")
+
+directive @auth(role: Role!) repeatable on FIELD_DEFINITION
+`
+
+func TestToIntrospection(t *testing.T) {
+	doc, err := Parse(convertTestSDL + "\nextend type User { email: String }")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	result, err := ToIntrospection(doc)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// round trip through JSON to work with the same structures as a decoded file
+	content, err := json.Marshal(result)
+	if err != nil {
+		t.Fatalf("Failed to encode introspection: %v", err)
+	}
+	var data map[string]interface{}
+	if err := json.Unmarshal(content, &data); err != nil {
+		t.Fatalf("Failed to decode introspection: %v", err)
+	}
+
+	schema := IntrospectionSchema(data)
+	if schema == nil {
+		t.Fatal("Expected introspection schema")
+	}
+
+	if problems, err := CheckIntrospection(schema); err != nil || len(problems) != 0 {
+		t.Errorf("Expected complete introspection, got %v %v", problems, err)
+	}
+
+	if schema["description"] != "Users service" {
+		t.Errorf("Expected schema description, got %v", schema["description"])
+	}
+
+	roots := IntrospectionRootTypes(schema)
+	if roots.Query != "RootQuery" || roots.Mutation != "Mutation" || roots.Subscription != "" {
+		t.Errorf("Unexpected root types %+v", roots)
+	}
+
+	fields := IntrospectionFields(schema, "RootQuery")
+	if len(fields) != 3 || fields[0].Description != "Find a user" || !fields[1].Deprecated {
+		t.Errorf("Unexpected query fields %+v", fields)
+	}
+
+	if fields := IntrospectionFields(schema, "User"); len(fields) != 4 {
+		t.Errorf("Expected type extension fields to be merged, got %+v", fields)
+	}
+
+	directives := schema["directives"].([]interface{})
+	if len(directives) != 5 {
+		t.Errorf("Expected 4 standard directives and 1 custom, got %d", len(directives))
+	}
+}
+
+func TestToIntrospectionUnknownType(t *testing.T) {
+	doc, err := Parse("type Query { user: User }")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if _, err := ToIntrospection(doc); err == nil {
+		t.Error("Expected error for unknown type")
+	}
+}
+
+func TestIntrospectionRoundTrip(t *testing.T) {
+	doc, err := Parse(convertTestSDL)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	result, err := ToIntrospection(doc)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	content, err := json.Marshal(result)
+	if err != nil {
+		t.Fatalf("Failed to encode introspection: %v", err)
+	}
+	var data map[string]interface{}
+	if err := json.Unmarshal(content, &data); err != nil {
+		t.Fatalf("Failed to decode introspection: %v", err)
+	}
+
+	converted, err := FromIntrospection(IntrospectionSchema(data))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// directives are listed before types in introspection based SDL
+	expected, err := Parse(convertTestSDL)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	directive := expected.Definitions[len(expected.Definitions)-1]
+	expected.Definitions = append([]*Definition{expected.Definitions[0], directive}, expected.Definitions[1:len(expected.Definitions)-1]...)
+
+	if printed, want := Print(converted), Print(expected); printed != want {
+		t.Errorf("Expected SDL:\n%s\ngot:\n%s", want, printed)
+	}
+}
+
+func TestFromIntrospectionInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"missing query type", `{"types": []}`},
+		{"invalid kind", `{"queryType": {"name": "Query"}, "types": [{"kind": "OBJECT", "name": "Query", "fields": []}, {"kind": "TABLE", "name": "Users"}]}`},
+		{"missing field type", `{"queryType": {"name": "Query"}, "types": [{"kind": "OBJECT", "name": "Query", "fields": [{"name": "users"}]}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var schema map[string]interface{}
+			if err := json.Unmarshal([]byte(tt.content), &schema); err != nil {
+				t.Fatalf("Failed to parse test document: %v", err)
+			}
+
+			if _, err := FromIntrospection(schema); err == nil {
+				t.Error("Expected error, got nil")
+			}
+		})
+	}
+}
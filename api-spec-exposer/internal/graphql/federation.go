@@ -0,0 +1,393 @@
+package graphql
+
+import (
+	"fmt"
+	"strings"
+)
+
+// federationSpecURL is the prefix of the Apollo federation spec URL imported by @link
+const federationSpecURL = "specs.apollo.dev/federation/"
+
+// apolloSpecsHost is the host of the federation, link and other specs linked by subgraphs
+const apolloSpecsHost = "specs.apollo.dev/"
+
+// entityDirectives are federation directives that mark a schema as a subgraph
+var entityDirectives = map[string]bool{
+	"key":             true,
+	"external":        true,
+	"requires":        true,
+	"provides":        true,
+	"extends":         true,
+	"shareable":       true,
+	"override":        true,
+	"interfaceObject": true,
+}
+
+// federationDirectives are directives defined by the federation and link specs, they are removed from composed schemas
+var federationDirectives = map[string]bool{
+	"key":              true,
+	"external":         true,
+	"requires":         true,
+	"provides":         true,
+	"extends":          true,
+	"shareable":        true,
+	"override":         true,
+	"interfaceObject":  true,
+	"inaccessible":     true,
+	"tag":              true,
+	"composeDirective": true,
+	"authenticated":    true,
+	"requiresScopes":   true,
+	"policy":           true,
+	"context":          true,
+	"fromContext":      true,
+	"cost":             true,
+	"listSize":         true,
+	"link":             true,
+}
+
+// federationTypes are types added to subgraph schemas by federation libraries, they are removed from composed schemas
+var federationTypes = map[string]bool{
+	"_Any":      true,
+	"_FieldSet": true,
+	"FieldSet":  true,
+	"_Entity":   true,
+	"_Service":  true,
+}
+
+// federationRootFields are query fields added to subgraph schemas by federation libraries
+var federationRootFields = map[string]bool{
+	"_entities": true,
+	"_service":  true,
+}
+
+// IsFederationSubgraph reports whether the document is an Apollo federation subgraph schema:
+// it links the federation spec or applies entity directives such as @key, @external, @requires or @provides
+func IsFederationSubgraph(doc *Document) bool {
+	for _, def := range doc.Definitions {
+		if def.Kind == KindSchema {
+			for _, directive := range def.Directives {
+				if directive.Name == "link" && linksFederation(directive) {
+					return true
+				}
+			}
+			continue
+		}
+		if hasEntityDirective(def.Directives) {
+			return true
+		}
+		for _, field := range def.Fields {
+			if hasEntityDirective(field.Directives) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// SubgraphName returns the name a subgraph declares for itself by linking its own URL on the schema, e.g.
+// 'extend schema @link(url: "https://example.com/products/v1.0")' declares 'products'. Following the link spec,
+// the name is the 'as' argument or the last URL path segment that is not a version. Links to the Apollo specs
+// and links importing definitions are not identity links; an empty string is returned when there is none
+func SubgraphName(doc *Document) string {
+	for _, def := range doc.Definitions {
+		if def.Kind != KindSchema {
+			continue
+		}
+		for _, directive := range def.Directives {
+			if directive.Name != "link" {
+				continue
+			}
+			var url, name string
+			imported := false
+			for _, argument := range directive.Arguments {
+				value, _ := UnquoteString(argument.Value)
+				switch argument.Name {
+				case "url":
+					url = value
+				case "as":
+					name = value
+				case "import":
+					imported = true
+				}
+			}
+			if url == "" || imported || strings.Contains(url, apolloSpecsHost) {
+				continue
+			}
+			if name == "" {
+				name = linkName(url)
+			}
+			if name != "" {
+				return name
+			}
+		}
+	}
+	return ""
+}
+
+// linkName returns the name of a linked URL: its last path segment, skipping a version segment such as 'v1.0'
+func linkName(url string) string {
+	if i := strings.Index(url, "://"); i >= 0 {
+		url = url[i+3:]
+	}
+	url = strings.SplitN(strings.SplitN(url, "?", 2)[0], "#", 2)[0]
+	segments := strings.Split(strings.Trim(url, "/"), "/")
+	if len(segments) < 2 {
+		return ""
+	}
+	segments = segments[1:]
+	name := segments[len(segments)-1]
+	if isVersionSegment(name) && len(segments) > 1 {
+		name = segments[len(segments)-2]
+	}
+	if isVersionSegment(name) {
+		return ""
+	}
+	return name
+}
+
+func isVersionSegment(segment string) bool {
+	if !strings.HasPrefix(segment, "v") || len(segment) < 2 {
+		return false
+	}
+	for _, r := range segment[1:] {
+		if (r < '0' || r > '9') && r != '.' {
+			return false
+		}
+	}
+	return true
+}
+
+// LoadSupergraph parses federation subgraph SDL files and composes them into a single validated schema document
+func LoadSupergraph(paths []string) (*Document, error) {
+	docs, err := loadFiles(paths)
+	if err != nil {
+		return nil, err
+	}
+
+	composed, errs := Compose(docs...)
+	if len(errs) > 0 {
+		return nil, errs[0]
+	}
+	return composed, nil
+}
+
+// Compose combines federation subgraph documents into the schema exposed to clients.
+// Types defined in several subgraphs are merged, external fields are taken from the subgraph that owns them,
+// federation directives and types are removed along with @inaccessible types and fields
+func Compose(docs ...*Document) (*Document, []error) {
+	var errs []error
+
+	composed := &Document{}
+	var schema *Definition
+	rootTypes := make(map[string]string)
+
+	types := make(map[string]*Definition)
+	var order []string
+	owners := make(map[string]*Definition)
+	directives := make(map[string]bool)
+	var directiveDefs []*Definition
+	externals := make(map[string][]FieldDefinition)
+
+	for _, doc := range docs {
+		for _, def := range doc.Definitions {
+			switch {
+			case def.Kind == KindSchema:
+				for _, operationType := range def.OperationTypes {
+					if previous, ok := rootTypes[operationType.Operation]; ok {
+						if previous != operationType.Type {
+							errs = append(errs, fmt.Errorf("root %s type is '%s' and '%s' in different subgraphs (%s)", operationType.Operation, previous, operationType.Type, definitionPosition(def)))
+						}
+						continue
+					}
+					rootTypes[operationType.Operation] = operationType.Type
+					if schema == nil {
+						schema = &Definition{Kind: KindSchema, Loc: def.Loc, Source: def.Source}
+					}
+					schema.OperationTypes = append(schema.OperationTypes, operationType)
+				}
+				if schema != nil && !def.Extension && schema.Description == "" {
+					schema.Description = def.Description
+				}
+			case def.Kind == KindDirective:
+				if isFederationDirective(def.Name) || directives[def.Name] {
+					continue
+				}
+				directives[def.Name] = true
+				directiveDefs = append(directiveDefs, def)
+			case isFederationType(def.Name) || hasDirective(def.Directives, "inaccessible"):
+				// not part of the composed schema
+			default:
+				target, ok := types[def.Name]
+				if !ok {
+					target = &Definition{Kind: def.Kind, Name: def.Name, Loc: def.Loc, Source: def.Source}
+					types[def.Name] = target
+					owners[def.Name] = def
+					order = append(order, def.Name)
+				} else if target.Kind != def.Kind {
+					errs = append(errs, fmt.Errorf("%s is defined as %s (%s) and %s (%s)", describeDefinition(def), target.Kind, definitionPosition(owners[def.Name]), def.Kind, definitionPosition(def)))
+					continue
+				}
+				errs = append(errs, composeDefinition(target, def, externals)...)
+			}
+		}
+	}
+
+	for _, name := range order {
+		target := types[name]
+		for _, field := range externals[name] {
+			if findField(target.Fields, field.Name) < 0 {
+				target.Fields = append(target.Fields, field)
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	if schema != nil {
+		composed.Definitions = append(composed.Definitions, schema)
+	}
+	for _, name := range order {
+		composed.Definitions = append(composed.Definitions, types[name])
+	}
+	composed.Definitions = append(composed.Definitions, directiveDefs...)
+
+	if errs := Validate(composed); len(errs) > 0 {
+		return nil, errs
+	}
+	return composed, nil
+}
+
+// composeDefinition adds members of a subgraph definition to the composed one,
+// external fields are collected separately and used only if no subgraph resolves them
+func composeDefinition(target *Definition, def *Definition, externals map[string][]FieldDefinition) []error {
+	var errs []error
+
+	if target.Description == "" {
+		target.Description = def.Description
+	}
+	for _, directive := range def.Directives {
+		if !isFederationDirective(directive.Name) && !hasDirective(target.Directives, directive.Name) {
+			target.Directives = append(target.Directives, directive)
+		}
+	}
+	for _, name := range def.Interfaces {
+		if !containsName(target.Interfaces, name) {
+			target.Interfaces = append(target.Interfaces, name)
+		}
+	}
+	for _, name := range def.Types {
+		if !containsName(target.Types, name) {
+			target.Types = append(target.Types, name)
+		}
+	}
+
+	for _, field := range def.Fields {
+		if hasDirective(field.Directives, "inaccessible") || federationRootFields[field.Name] {
+			continue
+		}
+		external := hasDirective(field.Directives, "external")
+		field.Directives = withoutFederationDirectives(field.Directives)
+		if external {
+			externals[def.Name] = append(externals[def.Name], field)
+			continue
+		}
+		index := findField(target.Fields, field.Name)
+		if index >= 0 {
+			if target.Fields[index].Type.String() != field.Type.String() {
+				errs = append(errs, fmt.Errorf("field '%s.%s' has type '%s' and '%s' in different subgraphs (%s)", def.Name, field.Name, target.Fields[index].Type, field.Type, definitionPosition(def)))
+			}
+			continue
+		}
+		target.Fields = append(target.Fields, field)
+	}
+
+	for _, field := range def.InputFields {
+		if hasDirective(field.Directives, "inaccessible") {
+			continue
+		}
+		field.Directives = withoutFederationDirectives(field.Directives)
+		index := findInputField(target.InputFields, field.Name)
+		if index >= 0 {
+			if target.InputFields[index].Type.String() != field.Type.String() {
+				errs = append(errs, fmt.Errorf("field '%s.%s' has type '%s' and '%s' in different subgraphs (%s)", def.Name, field.Name, target.InputFields[index].Type, field.Type, definitionPosition(def)))
+			}
+			continue
+		}
+		target.InputFields = append(target.InputFields, field)
+	}
+
+	for _, value := range def.EnumValues {
+		if hasDirective(value.Directives, "inaccessible") || findEnumValue(target.EnumValues, value.Name) >= 0 {
+			continue
+		}
+		value.Directives = withoutFederationDirectives(value.Directives)
+		target.EnumValues = append(target.EnumValues, value)
+	}
+
+	return errs
+}
+
+func linksFederation(directive Directive) bool {
+	for _, argument := range directive.Arguments {
+		if argument.Name == "url" && strings.Contains(argument.Value, federationSpecURL) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasEntityDirective(directives []Directive) bool {
+	for _, directive := range directives {
+		if entityDirectives[strings.TrimPrefix(directive.Name, "federation__")] {
+			return true
+		}
+	}
+	return false
+}
+
+func isFederationDirective(name string) bool {
+	return federationDirectives[name] || strings.HasPrefix(name, "federation__") || strings.HasPrefix(name, "link__")
+}
+
+func isFederationType(name string) bool {
+	return federationTypes[name] || strings.HasPrefix(name, "federation__") || strings.HasPrefix(name, "link__")
+}
+
+func withoutFederationDirectives(directives []Directive) []Directive {
+	var result []Directive
+	for _, directive := range directives {
+		if !isFederationDirective(directive.Name) {
+			result = append(result, directive)
+		}
+	}
+	return result
+}
+
+func findField(fields []FieldDefinition, name string) int {
+	for i, field := range fields {
+		if field.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func findInputField(fields []InputValueDefinition, name string) int {
+	for i, field := range fields {
+		if field.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func findEnumValue(values []EnumValueDefinition, name string) int {
+	for i, value := range values {
+		if value.Name == name {
+			return i
+		}
+	}
+	return -1
+}
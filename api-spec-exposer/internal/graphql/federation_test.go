@@ -0,0 +1,234 @@
+package graphql
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIsFederationSubgraph(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   string
+		expected bool
+	}{
+		{
+			name:     "Plain schema",
+			source:   "type Query { users: [User] }\ntype User { id: ID! }",
+			expected: false,
+		},
+		{
+			name:     "Entity key",
+			source:   "type Query { users: [User] }\ntype User @key(fields: \"id\") { id: ID! }",
+			expected: true,
+		},
+		{
+			name:     "External field",
+			source:   "extend type User { id: ID! @external\n orders: [String] }",
+			expected: true,
+		},
+		{
+			name:     "Requires field",
+			source:   "type User { id: ID!\n name: String @requires(fields: \"id\") }",
+			expected: true,
+		},
+		{
+			name:     "Namespaced directive",
+			source:   "type User @federation__key(fields: \"id\") { id: ID! }",
+			expected: true,
+		},
+		{
+			name:     "Link to federation spec",
+			source:   "extend schema @link(url: \"https://specs.apollo.dev/federation/v2.3\", import: [\"@shareable\"])\ntype Query { ping: String }",
+			expected: true,
+		},
+		{
+			name:     "Link to other spec",
+			source:   "extend schema @link(url: \"https://specs.example.com/custom/v1.0\")\ntype Query { ping: String }",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := IsFederationSubgraph(mustParse(t, tt.source))
+			if result != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestCompose(t *testing.T) {
+	users := mustParse(t, `
+extend schema @link(url: "https://specs.apollo.dev/federation/v2.3", import: ["@key", "@shareable"])
+
+type Query {
+  users: [User]
+  _service: _Service!
+}
+
+"A registered user"
+type User @key(fields: "id") {
+  id: ID!
+  name: String
+  secret: String @inaccessible
+}
+
+type _Service { sdl: String }
+
+scalar _Any
+`)
+	orders := mustParse(t, `
+type Query {
+  orders: [Order]
+}
+
+type User @key(fields: "id") {
+  id: ID!
+  orders: [Order]
+}
+
+type Order @key(fields: "id") {
+  id: ID!
+  total: Float
+  buyer: Customer
+}
+
+extend type Customer @key(fields: "id") {
+  id: ID! @external
+  email: String @external
+}
+
+enum Status { NEW }
+`)
+	billing := mustParse(t, `
+type Customer @key(fields: "id") {
+  id: ID!
+  plan: String
+}
+
+enum Status { PAID }
+`)
+
+	composed, errs := Compose(users, orders, billing)
+	if len(errs) != 0 {
+		t.Fatalf("Expected no errors, got %v", errs)
+	}
+
+	expected := `type Query {
+  users: [User]
+  orders: [Order]
+}
+
+"A registered user"
+type User {
+  id: ID!
+  name: String
+  orders: [Order]
+}
+
+type Order {
+  id: ID!
+  total: Float
+  buyer: Customer
+}
+
+type Customer {
+  id: ID!
+  plan: String
+  email: String
+}
+
+enum Status {
+  NEW
+  PAID
+}
+`
+	if result := Print(composed); result != expected {
+		t.Errorf("Expected composed schema:\n%s\ngot:\n%s", expected, result)
+	}
+}
+
+func TestComposeErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		sources   []string
+		errorPart string
+	}{
+		{
+			name: "Conflicting field types",
+			sources: []string{
+				"type Query { user: User }\ntype User @key(fields: \"id\") { id: ID! }",
+				"type User @key(fields: \"id\") { id: String! }",
+			},
+			errorPart: "field 'User.id' has type 'ID!' and 'String!' in different subgraphs",
+		},
+		{
+			name: "Conflicting kinds",
+			sources: []string{
+				"type Query { status: Status }\nenum Status { NEW }",
+				"type Status @key(fields: \"id\") { id: ID! }",
+			},
+			errorPart: "type 'Status' is defined as enum",
+		},
+		{
+			name: "Conflicting root types",
+			sources: []string{
+				"schema { query: Query }\ntype Query { a: String }",
+				"schema { query: RootQuery }\ntype RootQuery @shareable { b: String }",
+			},
+			errorPart: "root query type is 'Query' and 'RootQuery' in different subgraphs",
+		},
+		{
+			name: "Undefined type",
+			sources: []string{
+				"type Query { user: User }",
+				"type Order @key(fields: \"id\") { id: ID! }",
+			},
+			errorPart: "field 'Query.user' references undefined type 'User'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var docs []*Document
+			for _, source := range tt.sources {
+				docs = append(docs, mustParse(t, source))
+			}
+			_, errs := Compose(docs...)
+			if len(errs) == 0 {
+				t.Fatalf("Expected errors, got none")
+			}
+			if !strings.Contains(errs[0].Error(), tt.errorPart) {
+				t.Errorf("Expected error containing %q, got %v", tt.errorPart, errs[0])
+			}
+		})
+	}
+}
+
+func TestSubgraphName(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   string
+		expected string
+	}{
+		{"No identity link", "extend schema @link(url: \"https://specs.apollo.dev/federation/v2.3\", import: [\"@key\"])\ntype Query { ping: String }", ""},
+		{"Versioned URL", "extend schema @link(url: \"https://example.com/graphs/products/v1.0\")\ntype Query { ping: String }", "products"},
+		{"Unversioned URL", "schema @link(url: \"https://example.com/reviews\") { query: Query }\ntype Query { ping: String }", "reviews"},
+		{"Renamed link", "extend schema @link(url: \"https://example.com/products/v1.0\", as: \"catalog\")\ntype Query { ping: String }", "catalog"},
+		{"Importing link", "extend schema @link(url: \"https://example.com/custom/v1.0\", import: [\"@custom\"])\ntype Query { ping: String }", ""},
+		{"Host only", "extend schema @link(url: \"https://example.com\")\ntype Query { ping: String }", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := Parse(tt.source)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if result := SubgraphName(doc); result != tt.expected {
+				t.Errorf("Expected '%s', got '%s'", tt.expected, result)
+			}
+		})
+	}
+}
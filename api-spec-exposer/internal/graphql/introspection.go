@@ -0,0 +1,199 @@
+package graphql
+
+import (
+	"fmt"
+	"sort"
+)
+
+var introspectionTypeKinds = map[string]bool{
+	"SCALAR": true, "OBJECT": true, "INTERFACE": true, "UNION": true,
+	"ENUM": true, "INPUT_OBJECT": true, "LIST": true, "NON_NULL": true,
+}
+
+// IsIntrospectionResult returns true if the document has one of the introspection result shapes:
+// {"data": {"__schema": ...}}, a bare {"__schema": ...} object or a failed {"data": null, "errors": [...]} response
+func IsIntrospectionResult(data map[string]interface{}) bool {
+	if _, ok := data["__schema"]; ok {
+		return true
+	}
+	dataField, hasData := data["data"]
+	if dataObject, ok := dataField.(map[string]interface{}); ok {
+		if _, ok := dataObject["__schema"]; ok {
+			return true
+		}
+	}
+	_, hasErrors := data["errors"].([]interface{})
+	return hasData && hasErrors
+}
+
+// IntrospectionSchema returns the '__schema' object of an introspection result, nil if there is none
+func IntrospectionSchema(data map[string]interface{}) map[string]interface{} {
+	if schema, ok := data["__schema"].(map[string]interface{}); ok {
+		return schema
+	}
+	if dataField, ok := data["data"].(map[string]interface{}); ok {
+		if schema, ok := dataField["__schema"].(map[string]interface{}); ok {
+			return schema
+		}
+	}
+	return nil
+}
+
+// IntrospectionErrors returns messages of the 'errors' array of an introspection result
+func IntrospectionErrors(data map[string]interface{}) []string {
+	var messages []string
+	errors, _ := data["errors"].([]interface{})
+	for _, item := range errors {
+		message := fmt.Sprintf("%v", item)
+		if errorObject, ok := item.(map[string]interface{}); ok {
+			if text, ok := errorObject["message"].(string); ok {
+				message = text
+			}
+		}
+		messages = append(messages, message)
+	}
+	return messages
+}
+
+// CheckIntrospection validates the structure of an introspection '__schema' object.
+// It returns an error if the schema cannot be used and a list of problems if the schema is partial.
+// Type references are only checked when the schema lists any types
+func CheckIntrospection(schema map[string]interface{}) ([]string, error) {
+	if IntrospectionRootTypes(schema).Query == "" {
+		return nil, fmt.Errorf("'__schema.queryType.name' is missing")
+	}
+	types, ok := schema["types"].([]interface{})
+	if _, present := schema["types"]; present && !ok {
+		return nil, fmt.Errorf("'__schema.types' must be an array")
+	}
+	if len(types) == 0 {
+		return nil, nil
+	}
+
+	var problems []string
+	defined := make(map[string]bool)
+	referenced := make(map[string]bool)
+	for i, item := range types {
+		typeDef, ok := item.(map[string]interface{})
+		if !ok {
+			problems = append(problems, fmt.Sprintf("'__schema.types[%d]' is not an object", i))
+			continue
+		}
+		name, _ := typeDef["name"].(string)
+		kind, _ := typeDef["kind"].(string)
+		if name == "" {
+			problems = append(problems, fmt.Sprintf("'__schema.types[%d]' has no name", i))
+			continue
+		}
+		if !introspectionTypeKinds[kind] {
+			problems = append(problems, fmt.Sprintf("type '%s' has invalid kind '%s'", name, kind))
+		}
+		defined[name] = true
+
+		if kind == "OBJECT" || kind == "INTERFACE" {
+			if _, ok := typeDef["fields"].([]interface{}); !ok {
+				problems = append(problems, fmt.Sprintf("type '%s' has no fields", name))
+			}
+		}
+		for _, key := range []string{"fields", "inputFields"} {
+			fields, _ := typeDef[key].([]interface{})
+			for _, fieldItem := range fields {
+				if field, ok := fieldItem.(map[string]interface{}); ok {
+					referenced[introspectionTypeName(unwrapTypeRef(field["type"]))] = true
+					args, _ := field["args"].([]interface{})
+					for _, argItem := range args {
+						if arg, ok := argItem.(map[string]interface{}); ok {
+							referenced[introspectionTypeName(unwrapTypeRef(arg["type"]))] = true
+						}
+					}
+				}
+			}
+		}
+		for _, key := range []string{"interfaces", "possibleTypes"} {
+			refs, _ := typeDef[key].([]interface{})
+			for _, ref := range refs {
+				referenced[introspectionTypeName(ref)] = true
+			}
+		}
+	}
+
+	roots := IntrospectionRootTypes(schema)
+	for _, root := range []string{roots.Query, roots.Mutation, roots.Subscription} {
+		referenced[root] = true
+	}
+
+	var missing []string
+	for name := range referenced {
+		if name != "" && !defined[name] {
+			missing = append(missing, name)
+		}
+	}
+	sort.Strings(missing)
+	for _, name := range missing {
+		problems = append(problems, fmt.Sprintf("type '%s' is referenced but not defined", name))
+	}
+
+	return problems, nil
+}
+
+// IntrospectionRootTypes returns root operation type names of an introspection '__schema' object
+func IntrospectionRootTypes(schema map[string]interface{}) RootTypes {
+	return RootTypes{
+		Query:        introspectionTypeName(schema["queryType"]),
+		Mutation:     introspectionTypeName(schema["mutationType"]),
+		Subscription: introspectionTypeName(schema["subscriptionType"]),
+	}
+}
+
+// IntrospectionFields returns fields of a type described in an introspection '__schema' object
+func IntrospectionFields(schema map[string]interface{}, typeName string) []Field {
+	if typeName == "" {
+		return nil
+	}
+
+	types, _ := schema["types"].([]interface{})
+	for _, item := range types {
+		typeDef, ok := item.(map[string]interface{})
+		if !ok || typeDef["name"] != typeName {
+			continue
+		}
+
+		var fields []Field
+		list, _ := typeDef["fields"].([]interface{})
+		for _, fieldItem := range list {
+			field, ok := fieldItem.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			name, _ := field["name"].(string)
+			description, _ := field["description"].(string)
+			deprecated, _ := field["isDeprecated"].(bool)
+			fields = append(fields, Field{Name: name, Description: description, Deprecated: deprecated})
+		}
+		return fields
+	}
+	return nil
+}
+
+func introspectionTypeName(value interface{}) string {
+	if typeRef, ok := value.(map[string]interface{}); ok {
+		name, _ := typeRef["name"].(string)
+		return name
+	}
+	return ""
+}
+
+// unwrapTypeRef returns the named type of a LIST/NON_NULL wrapped type reference
+func unwrapTypeRef(value interface{}) interface{} {
+	for {
+		typeRef, ok := value.(map[string]interface{})
+		if !ok {
+			return value
+		}
+		ofType, ok := typeRef["ofType"].(map[string]interface{})
+		if !ok {
+			return typeRef
+		}
+		value = ofType
+	}
+}
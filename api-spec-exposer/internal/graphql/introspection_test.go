@@ -0,0 +1,156 @@
+package graphql
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestIntrospectionFields(t *testing.T) {
+	content := `{
+		"data": {
+			"__schema": {
+				"queryType": {"name": "Query"},
+				"mutationType": null,
+				"types": [
+					{
+						"kind": "OBJECT",
+						"name": "Query",
+						"fields": [
+							{"name": "users", "description": "Find users", "isDeprecated": false},
+							{"name": "user", "isDeprecated": true}
+						]
+					}
+				]
+			}
+		}
+	}`
+
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(content), &data); err != nil {
+		t.Fatalf("Failed to parse test document: %v", err)
+	}
+
+	schema := IntrospectionSchema(data)
+	if schema == nil {
+		t.Fatal("Expected introspection schema")
+	}
+
+	roots := IntrospectionRootTypes(schema)
+	if roots.Query != "Query" || roots.Mutation != "" || roots.Subscription != "" {
+		t.Errorf("Expected only query root type, got %+v", roots)
+	}
+
+	fields := IntrospectionFields(schema, "Query")
+	expected := []Field{
+		{Name: "users", Description: "Find users"},
+		{Name: "user", Deprecated: true},
+	}
+
+	if len(fields) != len(expected) {
+		t.Fatalf("Expected %d fields, got %d: %+v", len(expected), len(fields), fields)
+	}
+
+	for i, field := range fields {
+		if field != expected[i] {
+			t.Errorf("Expected field %+v, got %+v", expected[i], field)
+		}
+	}
+}
+
+func parseIntrospection(t *testing.T, content string) map[string]interface{} {
+	t.Helper()
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(content), &data); err != nil {
+		t.Fatalf("Failed to parse test document: %v", err)
+	}
+	return data
+}
+
+func TestIsIntrospectionResult(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected bool
+	}{
+		{"data wrapper", `{"data": {"__schema": {}}}`, true},
+		{"bare schema", `{"__schema": {}}`, true},
+		{"data wrapper with errors", `{"data": {"__schema": {}}, "errors": [{"message": "partial"}]}`, true},
+		{"failed response", `{"data": null, "errors": [{"message": "forbidden"}]}`, true},
+		{"other data", `{"data": {"users": []}}`, false},
+		{"openapi", `{"openapi": "3.0.0"}`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := IsIntrospectionResult(parseIntrospection(t, tt.content)); result != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestIntrospectionErrors(t *testing.T) {
+	data := parseIntrospection(t, `{"errors": [{"message": "field 'secret' is forbidden"}, "plain error"]}`)
+
+	messages := IntrospectionErrors(data)
+	if len(messages) != 2 || messages[0] != "field 'secret' is forbidden" || messages[1] != "plain error" {
+		t.Errorf("Expected 2 error messages, got %v", messages)
+	}
+}
+
+func TestCheckIntrospection(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		problems []string
+		wantErr  bool
+	}{
+		{
+			name:    "complete schema",
+			content: `{"queryType": {"name": "Query"}, "types": [{"kind": "OBJECT", "name": "Query", "fields": [{"name": "id", "type": {"kind": "NON_NULL", "ofType": {"kind": "SCALAR", "name": "ID"}}}], "interfaces": []}, {"kind": "SCALAR", "name": "ID"}]}`,
+		},
+		{
+			name:    "stub without types",
+			content: `{"queryType": {"name": "Query"}}`,
+		},
+		{
+			name:    "missing query type",
+			content: `{"types": []}`,
+			wantErr: true,
+		},
+		{
+			name:    "types not an array",
+			content: `{"queryType": {"name": "Query"}, "types": {}}`,
+			wantErr: true,
+		},
+		{
+			name:    "partial schema",
+			content: `{"queryType": {"name": "Query"}, "mutationType": {"name": "Mutation"}, "types": [{"kind": "OBJECT", "name": "Query", "fields": [{"name": "user", "args": [{"name": "id", "type": {"kind": "SCALAR", "name": "ID"}}], "type": {"kind": "OBJECT", "name": "User"}}]}, {"kind": "OBJECT", "name": "Broken"}, {"kind": "SCALAR"}, {"kind": "SCALAR", "name": "ID"}]}`,
+			problems: []string{
+				"type 'Broken' has no fields",
+				"'__schema.types[2]' has no name",
+				"type 'Mutation' is referenced but not defined",
+				"type 'User' is referenced but not defined",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			problems, err := CheckIntrospection(parseIntrospection(t, tt.content))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+
+			if len(problems) != len(tt.problems) {
+				t.Fatalf("Expected %d problems, got %d: %v", len(tt.problems), len(problems), problems)
+			}
+
+			for i, problem := range problems {
+				if problem != tt.problems[i] {
+					t.Errorf("Expected problem '%s', got '%s'", tt.problems[i], problem)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,321 @@
+package graphql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// TokenKind represents the kind of a lexical token of GraphQL source
+type TokenKind int
+
+const (
+	TokenEOF TokenKind = iota
+	TokenPunctuator
+	TokenName
+	TokenInt
+	TokenFloat
+	TokenString
+	TokenBlockString
+)
+
+// Token is a lexical token of GraphQL source
+type Token struct {
+	Kind  TokenKind
+	Value string // punctuator or name text, number literal, or the processed string value
+	Start int    // byte offset of the token start
+	End   int    // byte offset following the token end
+	Loc   Location
+}
+
+// Location is a 1-based line and column position in GraphQL source
+type Location struct {
+	Line   int
+	Column int
+}
+
+// ParseError describes a syntax error in GraphQL source
+type ParseError struct {
+	Message string
+	Loc     Location
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("syntax error at line %d, column %d: %s", e.Loc.Line, e.Loc.Column, e.Message)
+}
+
+const byteOrderMark = "\uFEFF"
+
+// lexer splits GraphQL source into tokens skipping ignored tokens (whitespace, commas, comments)
+type lexer struct {
+	source    string
+	pos       int
+	line      int
+	lineStart int
+	tokenLoc  Location // location of the token being read, block strings may span several lines
+}
+
+func newLexer(source string) *lexer {
+	return &lexer{source: source, line: 1}
+}
+
+func (l *lexer) location(pos int) Location {
+	return Location{Line: l.line, Column: utf8.RuneCountInString(l.source[l.lineStart:pos]) + 1}
+}
+
+func (l *lexer) errorAt(pos int, format string, args ...interface{}) *ParseError {
+	return &ParseError{Message: fmt.Sprintf(format, args...), Loc: l.location(pos)}
+}
+
+func (l *lexer) newLine(pos int) {
+	l.line++
+	l.lineStart = pos
+}
+
+// next returns the next significant token
+func (l *lexer) next() (Token, error) {
+	l.skipIgnored()
+
+	start := l.pos
+	l.tokenLoc = l.location(start)
+	if start >= len(l.source) {
+		return Token{Kind: TokenEOF, Start: start, End: start, Loc: l.tokenLoc}, nil
+	}
+
+	c := l.source[start]
+	switch {
+	case strings.IndexByte("!$&():=@[]{}|", c) >= 0:
+		l.pos++
+		return l.token(TokenPunctuator, start, string(c)), nil
+	case c == '.':
+		if strings.HasPrefix(l.source[start:], "...") {
+			l.pos += 3
+			return l.token(TokenPunctuator, start, "..."), nil
+		}
+		return Token{}, l.errorAt(start, "unexpected character '.'")
+	case isNameStart(c):
+		for l.pos++; l.pos < len(l.source) && isNameContinue(l.source[l.pos]); l.pos++ {
+		}
+		return l.token(TokenName, start, l.source[start:l.pos]), nil
+	case c == '-' || isDigit(c):
+		return l.readNumber()
+	case c == '"':
+		if strings.HasPrefix(l.source[start:], `"""`) {
+			return l.readBlockString()
+		}
+		return l.readString()
+	}
+
+	r, _ := utf8.DecodeRuneInString(l.source[start:])
+	return Token{}, l.errorAt(start, "unexpected character %q", r)
+}
+
+func (l *lexer) token(kind TokenKind, start int, value string) Token {
+	return Token{Kind: kind, Value: value, Start: start, End: l.pos, Loc: l.tokenLoc}
+}
+
+func (l *lexer) skipIgnored() {
+	for l.pos < len(l.source) {
+		switch c := l.source[l.pos]; {
+		case c == ' ' || c == '\t' || c == ',':
+			l.pos++
+		case c == '\n':
+			l.pos++
+			l.newLine(l.pos)
+		case c == '\r':
+			l.pos++
+			if l.pos < len(l.source) && l.source[l.pos] == '\n' {
+				l.pos++
+			}
+			l.newLine(l.pos)
+		case c == '#':
+			for l.pos < len(l.source) && l.source[l.pos] != '\n' && l.source[l.pos] != '\r' {
+				l.pos++
+			}
+		case strings.HasPrefix(l.source[l.pos:], byteOrderMark):
+			l.pos += len(byteOrderMark)
+		default:
+			return
+		}
+	}
+}
+
+func (l *lexer) readNumber() (Token, error) {
+	start := l.pos
+	kind := TokenInt
+
+	if l.source[l.pos] == '-' {
+		l.pos++
+	}
+	if l.pos < len(l.source) && l.source[l.pos] == '0' {
+		l.pos++
+		if l.pos < len(l.source) && isDigit(l.source[l.pos]) {
+			return Token{}, l.errorAt(l.pos, "invalid number, unexpected digit after 0")
+		}
+	} else if err := l.readDigits(); err != nil {
+		return Token{}, err
+	}
+
+	if l.pos < len(l.source) && l.source[l.pos] == '.' {
+		kind = TokenFloat
+		l.pos++
+		if err := l.readDigits(); err != nil {
+			return Token{}, err
+		}
+	}
+	if l.pos < len(l.source) && (l.source[l.pos] == 'e' || l.source[l.pos] == 'E') {
+		kind = TokenFloat
+		l.pos++
+		if l.pos < len(l.source) && (l.source[l.pos] == '+' || l.source[l.pos] == '-') {
+			l.pos++
+		}
+		if err := l.readDigits(); err != nil {
+			return Token{}, err
+		}
+	}
+
+	if l.pos < len(l.source) && (l.source[l.pos] == '.' || isNameStart(l.source[l.pos])) {
+		return Token{}, l.errorAt(l.pos, "invalid number, unexpected character %q", l.source[l.pos])
+	}
+
+	return l.token(kind, start, l.source[start:l.pos]), nil
+}
+
+func (l *lexer) readDigits() error {
+	if l.pos >= len(l.source) || !isDigit(l.source[l.pos]) {
+		return l.errorAt(l.pos, "invalid number, expected digit")
+	}
+	for l.pos < len(l.source) && isDigit(l.source[l.pos]) {
+		l.pos++
+	}
+	return nil
+}
+
+func (l *lexer) readString() (Token, error) {
+	start := l.pos
+	var sb strings.Builder
+
+	for l.pos++; l.pos < len(l.source); {
+		c := l.source[l.pos]
+		switch c {
+		case '"':
+			l.pos++
+			return l.token(TokenString, start, sb.String()), nil
+		case '\n', '\r':
+			return Token{}, l.errorAt(l.pos, "unterminated string")
+		case '\\':
+			if l.pos+1 >= len(l.source) {
+				return Token{}, l.errorAt(l.pos, "unterminated string")
+			}
+			escape := l.source[l.pos+1]
+			switch escape {
+			case '"', '\\', '/':
+				sb.WriteByte(escape)
+			case 'b':
+				sb.WriteByte('\b')
+			case 'f':
+				sb.WriteByte('\f')
+			case 'n':
+				sb.WriteByte('\n')
+			case 'r':
+				sb.WriteByte('\r')
+			case 't':
+				sb.WriteByte('\t')
+			case 'u':
+				if l.pos+6 > len(l.source) {
+					return Token{}, l.errorAt(l.pos, "invalid unicode escape sequence")
+				}
+				code, err := strconv.ParseUint(l.source[l.pos+2:l.pos+6], 16, 32)
+				if err != nil {
+					return Token{}, l.errorAt(l.pos, "invalid unicode escape sequence %q", l.source[l.pos:l.pos+6])
+				}
+				sb.WriteRune(rune(code))
+				l.pos += 4
+			default:
+				return Token{}, l.errorAt(l.pos, "invalid escape sequence '\\%c'", escape)
+			}
+			l.pos += 2
+		default:
+			sb.WriteByte(c)
+			l.pos++
+		}
+	}
+
+	return Token{}, l.errorAt(l.pos, "unterminated string")
+}
+
+func (l *lexer) readBlockString() (Token, error) {
+	start := l.pos
+	var raw strings.Builder
+
+	for l.pos += 3; l.pos < len(l.source); {
+		switch {
+		case strings.HasPrefix(l.source[l.pos:], `"""`):
+			l.pos += 3
+			return l.token(TokenBlockString, start, blockStringValue(raw.String())), nil
+		case strings.HasPrefix(l.source[l.pos:], `\"""`):
+			raw.WriteString(`"""`)
+			l.pos += 4
+		case l.source[l.pos] == '\n':
+			raw.WriteByte('\n')
+			l.pos++
+			l.newLine(l.pos)
+		case l.source[l.pos] == '\r':
+			raw.WriteByte('\n')
+			l.pos++
+			if l.pos < len(l.source) && l.source[l.pos] == '\n' {
+				l.pos++
+			}
+			l.newLine(l.pos)
+		default:
+			raw.WriteByte(l.source[l.pos])
+			l.pos++
+		}
+	}
+
+	return Token{}, l.errorAt(l.pos, "unterminated block string")
+}
+
+// blockStringValue removes the common indentation and leading/trailing blank lines of a block string
+func blockStringValue(raw string) string {
+	lines := strings.Split(raw, "\n")
+
+	commonIndent := -1
+	for _, line := range lines[1:] {
+		indent := len(line) - len(strings.TrimLeft(line, " \t"))
+		if indent < len(line) && (commonIndent < 0 || indent < commonIndent) {
+			commonIndent = indent
+		}
+	}
+	if commonIndent > 0 {
+		for i := 1; i < len(lines); i++ {
+			if len(lines[i]) >= commonIndent {
+				lines[i] = lines[i][commonIndent:]
+			} else {
+				lines[i] = ""
+			}
+		}
+	}
+
+	for len(lines) > 0 && strings.TrimLeft(lines[0], " \t") == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && strings.TrimLeft(lines[len(lines)-1], " \t") == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func isNameStart(c byte) bool {
+	return c == '_' || (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z')
+}
+
+func isNameContinue(c byte) bool {
+	return isNameStart(c) || isDigit(c)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,119 @@
+package graphql
+
+import (
+	"testing"
+)
+
+func TestLexerTokens(t *testing.T) {
+	source := "\uFEFFtype Query, { # comment\n  count(limit: -10, ratio: 1.5e3): [String!] @deprecated(reason: \"a \\\"b\\\" \\u0041\")\n}"
+
+	expected := []struct {
+		kind  TokenKind
+		value string
+	}{
+		{TokenName, "type"}, {TokenName, "Query"}, {TokenPunctuator, "{"},
+		{TokenName, "count"}, {TokenPunctuator, "("}, {TokenName, "limit"}, {TokenPunctuator, ":"}, {TokenInt, "-10"},
+		{TokenName, "ratio"}, {TokenPunctuator, ":"}, {TokenFloat, "1.5e3"}, {TokenPunctuator, ")"},
+		{TokenPunctuator, ":"}, {TokenPunctuator, "["}, {TokenName, "String"}, {TokenPunctuator, "!"}, {TokenPunctuator, "]"},
+		{TokenPunctuator, "@"}, {TokenName, "deprecated"}, {TokenPunctuator, "("}, {TokenName, "reason"}, {TokenPunctuator, ":"},
+		{TokenString, `a "b" A`}, {TokenPunctuator, ")"},
+		{TokenPunctuator, "}"}, {TokenEOF, ""},
+	}
+
+	l := newLexer(source)
+	for i, exp := range expected {
+		token, err := l.next()
+		if err != nil {
+			t.Fatalf("Token %d: expected no error, got %v", i, err)
+		}
+		if token.Kind != exp.kind || token.Value != exp.value {
+			t.Errorf("Token %d: expected %v %q, got %v %q", i, exp.kind, exp.value, token.Kind, token.Value)
+		}
+	}
+}
+
+func TestLexerTokenLocation(t *testing.T) {
+	l := newLexer("type Query {\n\t\"\"\"\n\tMulti-line\n\t\"\"\"\n\tfield: Int\n}")
+
+	var field Token
+	for {
+		token, err := l.next()
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if token.Kind == TokenBlockString {
+			if token.Loc != (Location{Line: 2, Column: 2}) {
+				t.Errorf("Expected block string at 2:2, got %+v", token.Loc)
+			}
+			if token.Value != "Multi-line" {
+				t.Errorf("Expected block string value 'Multi-line', got %q", token.Value)
+			}
+		}
+		if token.Value == "field" {
+			field = token
+			break
+		}
+	}
+
+	if field.Loc != (Location{Line: 5, Column: 2}) {
+		t.Errorf("Expected field at 5:2, got %+v", field.Loc)
+	}
+}
+
+func TestBlockStringValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      string
+		expected string
+	}{
+		{"single line", "Hello", "Hello"},
+		{"common indentation", "\n    First\n      Second\n    Third\n  ", "First\n  Second\nThird"},
+		{"first line kept", "First\n  Second", "First\nSecond"},
+		{"blank lines trimmed", "\n\n  Text\n\n", "Text"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if value := blockStringValue(tt.raw); value != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, value)
+			}
+		})
+	}
+}
+
+func TestLexerErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		loc    Location
+	}{
+		{"unterminated string", "\n  \"abc", Location{Line: 2, Column: 7}},
+		{"invalid escape", `"\x"`, Location{Line: 1, Column: 2}},
+		{"unterminated block string", `"""abc`, Location{Line: 1, Column: 7}},
+		{"leading zero", "01", Location{Line: 1, Column: 2}},
+		{"invalid character", "type ?", Location{Line: 1, Column: 6}},
+		{"single dot", ".", Location{Line: 1, Column: 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := newLexer(tt.source)
+			var err error
+			for err == nil {
+				var token Token
+				token, err = l.next()
+				if err == nil && token.Kind == TokenEOF {
+					t.Fatal("Expected error, got EOF")
+				}
+			}
+
+			parseErr, ok := err.(*ParseError)
+			if !ok {
+				t.Fatalf("Expected *ParseError, got %T", err)
+			}
+			if parseErr.Loc != tt.loc {
+				t.Errorf("Expected error at %+v, got %+v (%v)", tt.loc, parseErr.Loc, parseErr)
+			}
+		})
+	}
+}
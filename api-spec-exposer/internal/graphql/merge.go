@@ -0,0 +1,233 @@
+package graphql
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/charset"
+)
+
+// LoadSchema parses GraphQL SDL files and merges them into a single validated schema document
+func LoadSchema(paths []string) (*Document, error) {
+	docs, err := loadFiles(paths)
+	if err != nil {
+		return nil, err
+	}
+
+	merged, errs := Merge(docs...)
+	if len(errs) == 0 {
+		errs = Validate(merged)
+	}
+	if len(errs) > 0 {
+		return nil, errs[0]
+	}
+	return merged, nil
+}
+
+// loadFiles reads and parses SDL files converted to UTF-8, the definitions record the file they come from
+func loadFiles(paths []string) ([]*Document, error) {
+	var docs []*Document
+	for _, path := range paths {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		content, _ = charset.Normalize(content)
+		doc, err := Parse(string(content))
+		if err != nil {
+			return nil, fmt.Errorf("file %s: %w", path, err)
+		}
+		for _, def := range doc.Definitions {
+			def.Source = path
+		}
+		docs = append(docs, doc)
+	}
+	return docs, nil
+}
+
+// Merge combines documents into one, type and schema extensions are folded into their definitions
+func Merge(docs ...*Document) (*Document, []error) {
+	var errs []error
+
+	var all []*Definition
+	for _, doc := range docs {
+		all = append(all, doc.Definitions...)
+	}
+
+	defined := make(map[string]*Definition)
+	for _, def := range all {
+		if def.Extension || def.Kind == KindSchema {
+			continue
+		}
+		key := definitionKey(def)
+		if previous, ok := defined[key]; ok {
+			errs = append(errs, fmt.Errorf("%s is defined more than once (%s and %s)", describeDefinition(def), definitionPosition(previous), definitionPosition(def)))
+			continue
+		}
+		defined[key] = def
+	}
+	for _, def := range all {
+		if !def.Extension || def.Kind == KindSchema {
+			continue
+		}
+		base, ok := defined[definitionKey(def)]
+		if !ok {
+			errs = append(errs, fmt.Errorf("extension of undefined %s (%s)", describeDefinition(def), definitionPosition(def)))
+		} else if base.Kind != def.Kind {
+			errs = append(errs, fmt.Errorf("%s cannot be extended as %s (%s)", describeDefinition(base), def.Kind, definitionPosition(def)))
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	merged := &Document{}
+	var schema *Definition
+	for _, def := range all {
+		if def.Kind != KindSchema {
+			continue
+		}
+		if schema == nil {
+			schema = &Definition{Kind: KindSchema, Loc: def.Loc, Source: def.Source}
+			merged.Definitions = append(merged.Definitions, schema)
+		}
+		if !def.Extension && def.Description != "" {
+			schema.Description = def.Description
+		}
+		schema.Directives = append(schema.Directives, def.Directives...)
+		schema.OperationTypes = append(schema.OperationTypes, def.OperationTypes...)
+	}
+
+	types, _ := mergeDefinitions(&Document{Definitions: all})
+	added := make(map[string]bool)
+	for _, def := range all {
+		switch {
+		case def.Kind == KindSchema:
+		case def.Kind == KindDirective:
+			merged.Definitions = append(merged.Definitions, def)
+		case !added[def.Name]:
+			added[def.Name] = true
+			merged.Definitions = append(merged.Definitions, types[def.Name])
+		}
+	}
+
+	return merged, nil
+}
+
+// Validate checks that a merged schema document is complete: root operation types and all referenced types are defined
+// and fields, arguments and values are unique within their types
+func Validate(doc *Document) []error {
+	var errs []error
+
+	types := make(map[string]*Definition)
+	for _, def := range doc.Definitions {
+		if def.Kind != KindSchema && def.Kind != KindDirective {
+			types[def.Name] = def
+		}
+	}
+
+	checkType := func(name string, context string) {
+		if !IsBuiltInScalar(name) && types[name] == nil {
+			errs = append(errs, fmt.Errorf("%s references undefined type '%s'", context, name))
+		}
+	}
+	checkArguments := func(arguments []InputValueDefinition, context string) {
+		seen := make(map[string]bool)
+		for _, argument := range arguments {
+			if seen[argument.Name] {
+				errs = append(errs, fmt.Errorf("%s has duplicate argument '%s'", context, argument.Name))
+			}
+			seen[argument.Name] = true
+			checkType(argument.Type.NamedType(), fmt.Sprintf("argument '%s' of %s", argument.Name, context))
+		}
+	}
+
+	operations := make(map[string]bool)
+	for _, def := range doc.Definitions {
+		if def.Kind != KindSchema {
+			continue
+		}
+		for _, operationType := range def.OperationTypes {
+			if operations[operationType.Operation] {
+				errs = append(errs, fmt.Errorf("root %s type is defined more than once", operationType.Operation))
+			}
+			operations[operationType.Operation] = true
+			if target := types[operationType.Type]; target == nil || target.Kind != KindObject {
+				errs = append(errs, fmt.Errorf("root %s type '%s' is not a defined object type", operationType.Operation, operationType.Type))
+			}
+		}
+	}
+	if doc.RootTypes().Query == "" {
+		errs = append(errs, fmt.Errorf("schema does not define a query root type"))
+	}
+
+	for _, def := range doc.Definitions {
+		context := describeDefinition(def)
+		switch def.Kind {
+		case KindObject, KindInterface:
+			for _, name := range def.Interfaces {
+				if target := types[name]; target == nil || target.Kind != KindInterface {
+					errs = append(errs, fmt.Errorf("%s implements '%s' which is not a defined interface", context, name))
+				}
+			}
+			seen := make(map[string]bool)
+			for _, field := range def.Fields {
+				if seen[field.Name] {
+					errs = append(errs, fmt.Errorf("%s has duplicate field '%s'", context, field.Name))
+				}
+				seen[field.Name] = true
+				fieldContext := fmt.Sprintf("field '%s.%s'", def.Name, field.Name)
+				checkType(field.Type.NamedType(), fieldContext)
+				checkArguments(field.Arguments, fieldContext)
+			}
+		case KindInput:
+			seen := make(map[string]bool)
+			for _, field := range def.InputFields {
+				if seen[field.Name] {
+					errs = append(errs, fmt.Errorf("%s has duplicate field '%s'", context, field.Name))
+				}
+				seen[field.Name] = true
+				checkType(field.Type.NamedType(), fmt.Sprintf("field '%s.%s'", def.Name, field.Name))
+			}
+		case KindEnum:
+			seen := make(map[string]bool)
+			for _, value := range def.EnumValues {
+				if seen[value.Name] {
+					errs = append(errs, fmt.Errorf("%s has duplicate value '%s'", context, value.Name))
+				}
+				seen[value.Name] = true
+			}
+		case KindUnion:
+			for _, name := range def.Types {
+				if target := types[name]; target == nil || target.Kind != KindObject {
+					errs = append(errs, fmt.Errorf("%s member '%s' is not a defined object type", context, name))
+				}
+			}
+		case KindDirective:
+			checkArguments(def.Arguments, context)
+		}
+	}
+
+	return errs
+}
+
+func definitionKey(def *Definition) string {
+	if def.Kind == KindDirective {
+		return "@" + def.Name
+	}
+	return def.Name
+}
+
+func describeDefinition(def *Definition) string {
+	if def.Kind == KindDirective {
+		return fmt.Sprintf("directive '@%s'", def.Name)
+	}
+	return fmt.Sprintf("type '%s'", def.Name)
+}
+
+func definitionPosition(def *Definition) string {
+	if def.Source != "" {
+		return fmt.Sprintf("%s:%d:%d", def.Source, def.Loc.Line, def.Loc.Column)
+	}
+	return fmt.Sprintf("line %d, column %d", def.Loc.Line, def.Loc.Column)
+}
@@ -0,0 +1,233 @@
+package graphql
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func mustParse(t *testing.T, source string) *Document {
+	t.Helper()
+	doc, err := Parse(source)
+	if err != nil {
+		t.Fatalf("Failed to parse test schema: %v", err)
+	}
+	return doc
+}
+
+func TestMerge(t *testing.T) {
+	users := mustParse(t, `
+type Query { users: [User] }
+type User { id: ID! }
+enum Role { ADMIN }
+`)
+	orders := mustParse(t, `
+extend type Query { orders: [Order] }
+extend type User { orders: [Order] }
+extend enum Role { CUSTOMER }
+type Order { id: ID! }
+directive @auth on FIELD_DEFINITION
+`)
+	schema := mustParse(t, `schema { query: Query }`)
+
+	merged, errs := Merge(users, orders, schema)
+	if len(errs) != 0 {
+		t.Fatalf("Expected no errors, got %v", errs)
+	}
+
+	expected := `schema {
+  query: Query
+}
+
+type Query {
+  users: [User]
+  orders: [Order]
+}
+
+type User {
+  id: ID!
+  orders: [Order]
+}
+
+enum Role {
+  ADMIN
+  CUSTOMER
+}
+
+type Order {
+  id: ID!
+}
+
+directive @auth on FIELD_DEFINITION
+`
+	if printed := Print(merged); printed != expected {
+		t.Errorf("Expected merged schema:\n%s\ngot:\n%s", expected, printed)
+	}
+
+	if errs := Validate(merged); len(errs) != 0 {
+		t.Errorf("Expected merged schema to be valid, got %v", errs)
+	}
+
+	if fields := users.Definitions[0].Fields; len(fields) != 1 {
+		t.Errorf("Expected source documents to stay unchanged, got %d fields", len(fields))
+	}
+}
+
+func TestMergeErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		sources  []string
+		expected string
+	}{
+		{
+			name:     "duplicate type",
+			sources:  []string{"type Query { a: Int }", "type Query { b: Int }"},
+			expected: "type 'Query' is defined more than once (line 1, column 1 and line 1, column 1)",
+		},
+		{
+			name:     "extension of undefined type",
+			sources:  []string{"type Query { a: Int }", "extend type User { b: Int }"},
+			expected: "extension of undefined type 'User' (line 1, column 1)",
+		},
+		{
+			name:     "extension kind mismatch",
+			sources:  []string{"type Query { a: Int }", "extend input Query { b: Int }"},
+			expected: "type 'Query' cannot be extended as input (line 1, column 1)",
+		},
+		{
+			name:     "duplicate directive",
+			sources:  []string{"directive @auth on FIELD", "directive @auth on OBJECT"},
+			expected: "directive '@auth' is defined more than once (line 1, column 1 and line 1, column 1)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var docs []*Document
+			for _, source := range tt.sources {
+				docs = append(docs, mustParse(t, source))
+			}
+
+			_, errs := Merge(docs...)
+			if len(errs) != 1 {
+				t.Fatalf("Expected 1 error, got %v", errs)
+			}
+			if errs[0].Error() != tt.expected {
+				t.Errorf("Expected error '%s', got '%s'", tt.expected, errs[0].Error())
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   string
+		expected []string
+	}{
+		{
+			name:   "valid schema",
+			source: "type Query { user(id: ID!): User }\ntype User implements Node { id: ID! }\ninterface Node { id: ID! }\nunion Result = User",
+		},
+		{
+			name:     "missing query type",
+			source:   "type User { id: ID! }",
+			expected: []string{"schema does not define a query root type"},
+		},
+		{
+			name:     "undefined root type",
+			source:   "schema { query: Query mutation: Mutation }\ntype Query { a: Int }",
+			expected: []string{"root mutation type 'Mutation' is not a defined object type"},
+		},
+		{
+			name:   "undefined references",
+			source: "type Query { user(filter: Filter): User }\nunion Result = Query | Missing\ntype Item implements Query { a: Int }",
+			expected: []string{
+				"field 'Query.user' references undefined type 'User'",
+				"argument 'filter' of field 'Query.user' references undefined type 'Filter'",
+				"type 'Result' member 'Missing' is not a defined object type",
+				"type 'Item' implements 'Query' which is not a defined interface",
+			},
+		},
+		{
+			name:   "duplicates",
+			source: "type Query { a: Int a: String b(x: Int, x: Int): Int }\nenum Role { A A }",
+			expected: []string{
+				"type 'Query' has duplicate field 'a'",
+				"field 'Query.b' has duplicate argument 'x'",
+				"type 'Role' has duplicate value 'A'",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := Validate(mustParse(t, tt.source))
+
+			if len(errs) != len(tt.expected) {
+				t.Fatalf("Expected %d errors, got %d: %v", len(tt.expected), len(errs), errs)
+			}
+			for i, err := range errs {
+				if err.Error() != tt.expected[i] {
+					t.Errorf("Expected error '%s', got '%s'", tt.expected[i], err.Error())
+				}
+			}
+		})
+	}
+}
+
+func TestLoadSchema(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "graphql-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	files := map[string]string{
+		"query.graphqls":  "type Query { users: [User] }",
+		"users.graphqls":  "type User { id: ID! }",
+		"orders.graphqls": "extend type Query { orders: [Order] }",
+		"broken.graphqls": "type Broken {",
+		"dup.graphqls":    "\n\ntype User { name: String }",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(tempDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write test file: %v", err)
+		}
+	}
+
+	path := func(name string) string { return filepath.Join(tempDir, name) }
+
+	t.Run("valid group", func(t *testing.T) {
+		schema, err := LoadSchema([]string{path("query.graphqls"), path("users.graphqls")})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(schema.Definitions) != 2 {
+			t.Errorf("Expected 2 definitions, got %d", len(schema.Definitions))
+		}
+	})
+
+	t.Run("undefined type", func(t *testing.T) {
+		_, err := LoadSchema([]string{path("query.graphqls"), path("users.graphqls"), path("orders.graphqls")})
+		if err == nil || err.Error() != "field 'Query.orders' references undefined type 'Order'" {
+			t.Errorf("Expected undefined type error, got %v", err)
+		}
+	})
+
+	t.Run("syntax error", func(t *testing.T) {
+		_, err := LoadSchema([]string{path("query.graphqls"), path("broken.graphqls")})
+		if err == nil || !strings.HasPrefix(err.Error(), "file "+path("broken.graphqls")+": syntax error at line 1, column 14") {
+			t.Errorf("Expected syntax error with file name, got %v", err)
+		}
+	})
+
+	t.Run("duplicate type", func(t *testing.T) {
+		_, err := LoadSchema([]string{path("users.graphqls"), path("dup.graphqls")})
+		expected := "type 'User' is defined more than once (" + path("users.graphqls") + ":1:1 and " + path("dup.graphqls") + ":3:1)"
+		if err == nil || err.Error() != expected {
+			t.Errorf("Expected error '%s', got %v", expected, err)
+		}
+	})
+}
@@ -0,0 +1,657 @@
+package graphql
+
+import (
+	"fmt"
+)
+
+var directiveLocations = map[string]bool{
+	"QUERY": true, "MUTATION": true, "SUBSCRIPTION": true, "FIELD": true, "FRAGMENT_DEFINITION": true,
+	"FRAGMENT_SPREAD": true, "INLINE_FRAGMENT": true, "VARIABLE_DEFINITION": true,
+	"SCHEMA": true, "SCALAR": true, "OBJECT": true, "FIELD_DEFINITION": true, "ARGUMENT_DEFINITION": true,
+	"INTERFACE": true, "UNION": true, "ENUM": true, "ENUM_VALUE": true, "INPUT_OBJECT": true,
+	"INPUT_FIELD_DEFINITION": true,
+}
+
+// Parse parses a GraphQL SDL document, syntax errors are returned as *ParseError
+func Parse(source string) (*Document, error) {
+	p := &parser{lexer: newLexer(source)}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	doc := &Document{}
+	for p.token.Kind != TokenEOF {
+		def, err := p.parseDefinition()
+		if err != nil {
+			return nil, err
+		}
+		doc.Definitions = append(doc.Definitions, def)
+	}
+
+	if len(doc.Definitions) == 0 {
+		return nil, p.errorf("document does not contain any definitions")
+	}
+	return doc, nil
+}
+
+type parser struct {
+	lexer *lexer
+	token Token
+}
+
+func (p *parser) advance() error {
+	token, err := p.lexer.next()
+	if err != nil {
+		return err
+	}
+	p.token = token
+	return nil
+}
+
+func (p *parser) errorf(format string, args ...interface{}) *ParseError {
+	return &ParseError{Message: fmt.Sprintf(format, args...), Loc: p.token.Loc}
+}
+
+func (p *parser) unexpected(expected string) *ParseError {
+	return p.errorf("expected %s, found %s", expected, describeToken(p.token))
+}
+
+func describeToken(token Token) string {
+	switch token.Kind {
+	case TokenEOF:
+		return "<EOF>"
+	case TokenName:
+		return fmt.Sprintf("Name \"%s\"", token.Value)
+	case TokenInt, TokenFloat:
+		return fmt.Sprintf("number %s", token.Value)
+	case TokenString, TokenBlockString:
+		return "string"
+	}
+	return fmt.Sprintf("'%s'", token.Value)
+}
+
+func (p *parser) peek(value string) bool {
+	return p.token.Kind == TokenPunctuator && p.token.Value == value
+}
+
+func (p *parser) peekKeyword(keyword string) bool {
+	return p.token.Kind == TokenName && p.token.Value == keyword
+}
+
+// skip advances past the punctuator if it is the current token
+func (p *parser) skip(value string) (bool, error) {
+	if !p.peek(value) {
+		return false, nil
+	}
+	return true, p.advance()
+}
+
+func (p *parser) expect(value string) error {
+	if !p.peek(value) {
+		return p.unexpected(fmt.Sprintf("'%s'", value))
+	}
+	return p.advance()
+}
+
+func (p *parser) expectKeyword(keyword string) error {
+	if !p.peekKeyword(keyword) {
+		return p.unexpected(fmt.Sprintf("\"%s\"", keyword))
+	}
+	return p.advance()
+}
+
+func (p *parser) parseName() (string, error) {
+	if p.token.Kind != TokenName {
+		return "", p.unexpected("Name")
+	}
+	name := p.token.Value
+	return name, p.advance()
+}
+
+func (p *parser) parseDescription() (string, error) {
+	if p.token.Kind != TokenString && p.token.Kind != TokenBlockString {
+		return "", nil
+	}
+	description := p.token.Value
+	return description, p.advance()
+}
+
+func (p *parser) parseDefinition() (*Definition, error) {
+	loc := p.token.Loc
+	description, err := p.parseDescription()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.token.Kind != TokenName {
+		if p.peek("{") {
+			return nil, p.errorf("unexpected operation definition, only type system definitions are allowed")
+		}
+		return nil, p.unexpected("definition")
+	}
+
+	extension := false
+	if p.peekKeyword("extend") {
+		if description != "" {
+			return nil, p.errorf("unexpected description before type system extension")
+		}
+		extension = true
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+	}
+
+	def := &Definition{Extension: extension, Description: description, Loc: loc}
+	keyword := p.token.Value
+	switch keyword {
+	case "schema":
+		err = p.parseSchemaDefinition(def)
+	case "scalar":
+		err = p.parseScalarDefinition(def)
+	case "type", "interface":
+		err = p.parseObjectDefinition(def)
+	case "union":
+		err = p.parseUnionDefinition(def)
+	case "enum":
+		err = p.parseEnumDefinition(def)
+	case "input":
+		err = p.parseInputDefinition(def)
+	case "directive":
+		if extension {
+			return nil, p.unexpected("type system extension")
+		}
+		err = p.parseDirectiveDefinition(def)
+	case "query", "mutation", "subscription", "fragment":
+		return nil, p.errorf("unexpected %s definition, only type system definitions are allowed", keyword)
+	default:
+		return nil, p.unexpected("definition")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return def, nil
+}
+
+func (p *parser) parseSchemaDefinition(def *Definition) error {
+	def.Kind = KindSchema
+	if err := p.advance(); err != nil {
+		return err
+	}
+
+	var err error
+	if def.Directives, err = p.parseDirectives(); err != nil {
+		return err
+	}
+
+	if !p.peek("{") {
+		if def.Extension && len(def.Directives) > 0 {
+			return nil
+		}
+		return p.unexpected("'{'")
+	}
+
+	return p.parseBlock(func() error {
+		if p.token.Kind == TokenName && !p.peekKeyword("query") && !p.peekKeyword("mutation") && !p.peekKeyword("subscription") {
+			return p.errorf("unknown root operation type \"%s\"", p.token.Value)
+		}
+		operation, err := p.parseName()
+		if err != nil {
+			return err
+		}
+		if err := p.expect(":"); err != nil {
+			return err
+		}
+		typeName, err := p.parseName()
+		if err != nil {
+			return err
+		}
+		def.OperationTypes = append(def.OperationTypes, OperationTypeDefinition{Operation: operation, Type: typeName})
+		return nil
+	})
+}
+
+func (p *parser) parseScalarDefinition(def *Definition) error {
+	def.Kind = KindScalar
+	if err := p.parseTypeName(def); err != nil {
+		return err
+	}
+
+	var err error
+	if def.Directives, err = p.parseDirectives(); err != nil {
+		return err
+	}
+	if def.Extension && len(def.Directives) == 0 {
+		return p.unexpected("directive")
+	}
+	return nil
+}
+
+func (p *parser) parseObjectDefinition(def *Definition) error {
+	def.Kind = DefinitionKind(p.token.Value)
+	if err := p.parseTypeName(def); err != nil {
+		return err
+	}
+
+	if p.peekKeyword("implements") {
+		if err := p.advance(); err != nil {
+			return err
+		}
+		if _, err := p.skip("&"); err != nil {
+			return err
+		}
+		for {
+			name, err := p.parseName()
+			if err != nil {
+				return err
+			}
+			def.Interfaces = append(def.Interfaces, name)
+			more, err := p.skip("&")
+			if err != nil {
+				return err
+			}
+			if !more {
+				break
+			}
+		}
+	}
+
+	var err error
+	if def.Directives, err = p.parseDirectives(); err != nil {
+		return err
+	}
+
+	if p.peek("{") {
+		err := p.parseBlock(func() error {
+			field, err := p.parseFieldDefinition()
+			if err != nil {
+				return err
+			}
+			def.Fields = append(def.Fields, field)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	} else if def.Extension && len(def.Interfaces) == 0 && len(def.Directives) == 0 {
+		return p.unexpected("'{'")
+	}
+	return nil
+}
+
+func (p *parser) parseUnionDefinition(def *Definition) error {
+	def.Kind = KindUnion
+	if err := p.parseTypeName(def); err != nil {
+		return err
+	}
+
+	var err error
+	if def.Directives, err = p.parseDirectives(); err != nil {
+		return err
+	}
+
+	if p.peek("=") {
+		if err := p.advance(); err != nil {
+			return err
+		}
+		if _, err := p.skip("|"); err != nil {
+			return err
+		}
+		for {
+			name, err := p.parseName()
+			if err != nil {
+				return err
+			}
+			def.Types = append(def.Types, name)
+			more, err := p.skip("|")
+			if err != nil {
+				return err
+			}
+			if !more {
+				break
+			}
+		}
+	} else if def.Extension && len(def.Directives) == 0 {
+		return p.unexpected("'='")
+	}
+	return nil
+}
+
+func (p *parser) parseEnumDefinition(def *Definition) error {
+	def.Kind = KindEnum
+	if err := p.parseTypeName(def); err != nil {
+		return err
+	}
+
+	var err error
+	if def.Directives, err = p.parseDirectives(); err != nil {
+		return err
+	}
+
+	if p.peek("{") {
+		return p.parseBlock(func() error {
+			value := EnumValueDefinition{Loc: p.token.Loc}
+			var err error
+			if value.Description, err = p.parseDescription(); err != nil {
+				return err
+			}
+			if p.peekKeyword("true") || p.peekKeyword("false") || p.peekKeyword("null") {
+				return p.errorf("enum value cannot be \"%s\"", p.token.Value)
+			}
+			if value.Name, err = p.parseName(); err != nil {
+				return err
+			}
+			if value.Directives, err = p.parseDirectives(); err != nil {
+				return err
+			}
+			def.EnumValues = append(def.EnumValues, value)
+			return nil
+		})
+	}
+	if def.Extension && len(def.Directives) == 0 {
+		return p.unexpected("'{'")
+	}
+	return nil
+}
+
+func (p *parser) parseInputDefinition(def *Definition) error {
+	def.Kind = KindInput
+	if err := p.parseTypeName(def); err != nil {
+		return err
+	}
+
+	var err error
+	if def.Directives, err = p.parseDirectives(); err != nil {
+		return err
+	}
+
+	if p.peek("{") {
+		return p.parseBlock(func() error {
+			field, err := p.parseInputValueDefinition()
+			if err != nil {
+				return err
+			}
+			def.InputFields = append(def.InputFields, field)
+			return nil
+		})
+	}
+	if def.Extension && len(def.Directives) == 0 {
+		return p.unexpected("'{'")
+	}
+	return nil
+}
+
+func (p *parser) parseDirectiveDefinition(def *Definition) error {
+	def.Kind = KindDirective
+	if err := p.advance(); err != nil {
+		return err
+	}
+	if err := p.expect("@"); err != nil {
+		return err
+	}
+
+	var err error
+	if def.Name, err = p.parseName(); err != nil {
+		return err
+	}
+	if def.Arguments, err = p.parseArgumentDefinitions(); err != nil {
+		return err
+	}
+
+	if p.peekKeyword("repeatable") {
+		def.Repeatable = true
+		if err := p.advance(); err != nil {
+			return err
+		}
+	}
+
+	if err := p.expectKeyword("on"); err != nil {
+		return err
+	}
+	if _, err := p.skip("|"); err != nil {
+		return err
+	}
+	for {
+		if p.token.Kind == TokenName && !directiveLocations[p.token.Value] {
+			return p.errorf("unknown directive location \"%s\"", p.token.Value)
+		}
+		location, err := p.parseName()
+		if err != nil {
+			return err
+		}
+		def.Locations = append(def.Locations, location)
+		more, err := p.skip("|")
+		if err != nil {
+			return err
+		}
+		if !more {
+			break
+		}
+	}
+	return nil
+}
+
+// parseTypeName skips the definition keyword and reads the type name
+func (p *parser) parseTypeName(def *Definition) error {
+	if err := p.advance(); err != nil {
+		return err
+	}
+	var err error
+	def.Name, err = p.parseName()
+	return err
+}
+
+// parseBlock parses a non-empty list of items enclosed in braces
+func (p *parser) parseBlock(parseItem func() error) error {
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+	if p.peek("}") {
+		return p.unexpected("definition of a block item")
+	}
+	for !p.peek("}") {
+		if p.token.Kind == TokenEOF {
+			return p.unexpected("'}'")
+		}
+		if err := parseItem(); err != nil {
+			return err
+		}
+	}
+	return p.advance()
+}
+
+func (p *parser) parseFieldDefinition() (FieldDefinition, error) {
+	field := FieldDefinition{Loc: p.token.Loc}
+	var err error
+	if field.Description, err = p.parseDescription(); err != nil {
+		return field, err
+	}
+	if field.Name, err = p.parseName(); err != nil {
+		return field, err
+	}
+	if field.Arguments, err = p.parseArgumentDefinitions(); err != nil {
+		return field, err
+	}
+	if err = p.expect(":"); err != nil {
+		return field, err
+	}
+	if field.Type, err = p.parseType(); err != nil {
+		return field, err
+	}
+	field.Directives, err = p.parseDirectives()
+	return field, err
+}
+
+func (p *parser) parseArgumentDefinitions() ([]InputValueDefinition, error) {
+	if !p.peek("(") {
+		return nil, nil
+	}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	if p.peek(")") {
+		return nil, p.unexpected("argument definition")
+	}
+
+	var arguments []InputValueDefinition
+	for !p.peek(")") {
+		argument, err := p.parseInputValueDefinition()
+		if err != nil {
+			return nil, err
+		}
+		arguments = append(arguments, argument)
+	}
+	return arguments, p.advance()
+}
+
+func (p *parser) parseInputValueDefinition() (InputValueDefinition, error) {
+	value := InputValueDefinition{Loc: p.token.Loc}
+	var err error
+	if value.Description, err = p.parseDescription(); err != nil {
+		return value, err
+	}
+	if value.Name, err = p.parseName(); err != nil {
+		return value, err
+	}
+	if err = p.expect(":"); err != nil {
+		return value, err
+	}
+	if value.Type, err = p.parseType(); err != nil {
+		return value, err
+	}
+	if p.peek("=") {
+		if err = p.advance(); err != nil {
+			return value, err
+		}
+		if value.DefaultValue, err = p.parseValue(); err != nil {
+			return value, err
+		}
+	}
+	value.Directives, err = p.parseDirectives()
+	return value, err
+}
+
+func (p *parser) parseType() (*Type, error) {
+	var t *Type
+	if p.peek("[") {
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		ofType, err := p.parseType()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect("]"); err != nil {
+			return nil, err
+		}
+		t = &Type{OfType: ofType}
+	} else {
+		name, err := p.parseName()
+		if err != nil {
+			return nil, p.unexpected("type")
+		}
+		t = &Type{Name: name}
+	}
+
+	nonNull, err := p.skip("!")
+	if err != nil {
+		return nil, err
+	}
+	t.NonNull = nonNull
+	return t, nil
+}
+
+func (p *parser) parseDirectives() ([]Directive, error) {
+	var directives []Directive
+	for p.peek("@") {
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		name, err := p.parseName()
+		if err != nil {
+			return nil, err
+		}
+		directive := Directive{Name: name}
+
+		if p.peek("(") {
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+			if p.peek(")") {
+				return nil, p.unexpected("argument")
+			}
+			for !p.peek(")") {
+				argument := Argument{}
+				if argument.Name, err = p.parseName(); err != nil {
+					return nil, err
+				}
+				if err := p.expect(":"); err != nil {
+					return nil, err
+				}
+				if argument.Value, err = p.parseValue(); err != nil {
+					return nil, err
+				}
+				directive.Arguments = append(directive.Arguments, argument)
+			}
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+		}
+		directives = append(directives, directive)
+	}
+	return directives, nil
+}
+
+// parseValue parses a constant value and returns its source text
+func (p *parser) parseValue() (string, error) {
+	start := p.token.Start
+	end, err := p.skipValue()
+	if err != nil {
+		return "", err
+	}
+	return p.lexer.source[start:end], nil
+}
+
+// skipValue advances past a constant value and returns the offset of its end
+func (p *parser) skipValue() (int, error) {
+	token := p.token
+	switch {
+	case token.Kind == TokenInt || token.Kind == TokenFloat || token.Kind == TokenString ||
+		token.Kind == TokenBlockString || token.Kind == TokenName:
+		return token.End, p.advance()
+	case p.peek("["):
+		return p.skipCompositeValue("]", func() error {
+			_, err := p.skipValue()
+			return err
+		})
+	case p.peek("{"):
+		return p.skipCompositeValue("}", func() error {
+			if _, err := p.parseName(); err != nil {
+				return err
+			}
+			if err := p.expect(":"); err != nil {
+				return err
+			}
+			_, err := p.skipValue()
+			return err
+		})
+	case p.peek("$"):
+		return 0, p.errorf("unexpected variable in constant value")
+	}
+	return 0, p.unexpected("value")
+}
+
+func (p *parser) skipCompositeValue(closing string, skipItem func() error) (int, error) {
+	if err := p.advance(); err != nil {
+		return 0, err
+	}
+	for !p.peek(closing) {
+		if p.token.Kind == TokenEOF {
+			return 0, p.unexpected(fmt.Sprintf("'%s'", closing))
+		}
+		if err := skipItem(); err != nil {
+			return 0, err
+		}
+	}
+	end := p.token.End
+	return end, p.advance()
+}
@@ -0,0 +1,145 @@
+package graphql
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseTypeSystemDefinitions(t *testing.T) {
+	source := `
+"""
+Users service schema
+"""
+schema @link(url: "https://specs.apollo.dev/federation/v2.0") {
+	query: RootQuery
+	mutation: RootMutation
+}
+
+"Root query"
+type RootQuery implements & Node & Entity @key(fields: "id") {
+	"Find users"
+	users(filter: UserFilter = {name: "a}b", roles: [ADMIN]}, first: Int = 10): [User!]!
+	node(id: ID!): Node @deprecated(reason: "Use users")
+}
+
+interface Node { id: ID! }
+union SearchResult = | User | Group
+enum Role { ADMIN USER @deprecated }
+input UserFilter { name: String, roles: [Role!] = [] }
+scalar DateTime @specifiedBy(url: "https://tools.ietf.org/html/rfc3339")
+directive @key(fields: String!) repeatable on OBJECT | INTERFACE
+
+extend schema @auth
+extend type RootQuery { groups: [Group] }
+extend union SearchResult = Team
+extend enum Role @tag
+extend input UserFilter { active: Boolean }
+extend scalar DateTime @tag
+extend interface Node @tag
+`
+
+	doc, err := Parse(source)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(doc.Definitions) != 15 {
+		t.Fatalf("Expected 15 definitions, got %d", len(doc.Definitions))
+	}
+
+	schema := doc.Definitions[0]
+	if schema.Kind != KindSchema || schema.Description != "Users service schema" || len(schema.OperationTypes) != 2 {
+		t.Errorf("Unexpected schema definition %+v", schema)
+	}
+
+	query := doc.Definitions[1]
+	if query.Kind != KindObject || query.Name != "RootQuery" || query.Description != "Root query" {
+		t.Errorf("Unexpected object definition %+v", query)
+	}
+	if len(query.Interfaces) != 2 || query.Interfaces[1] != "Entity" {
+		t.Errorf("Expected interfaces [Node Entity], got %v", query.Interfaces)
+	}
+	if query.Loc != (Location{Line: 10, Column: 1}) {
+		t.Errorf("Expected definition at 10:1, got %+v", query.Loc)
+	}
+
+	users := query.Fields[0]
+	if users.Description != "Find users" || users.Type.String() != "[User!]!" || users.Type.NamedType() != "User" {
+		t.Errorf("Unexpected field %+v", users)
+	}
+	if len(users.Arguments) != 2 || users.Arguments[0].DefaultValue != `{name: "a}b", roles: [ADMIN]}` || users.Arguments[1].DefaultValue != "10" {
+		t.Errorf("Unexpected field arguments %+v", users.Arguments)
+	}
+
+	node := query.Fields[1]
+	if len(node.Directives) != 1 || node.Directives[0].Arguments[0].Value != `"Use users"` {
+		t.Errorf("Unexpected field directives %+v", node.Directives)
+	}
+
+	union := doc.Definitions[3]
+	if union.Kind != KindUnion || len(union.Types) != 2 {
+		t.Errorf("Unexpected union definition %+v", union)
+	}
+
+	directive := doc.Definitions[7]
+	if directive.Kind != KindDirective || directive.Name != "key" || !directive.Repeatable || len(directive.Locations) != 2 {
+		t.Errorf("Unexpected directive definition %+v", directive)
+	}
+
+	for _, def := range doc.Definitions[8:] {
+		if !def.Extension {
+			t.Errorf("Expected extension, got %+v", def)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		loc    Location
+	}{
+		{"empty document", "# only a comment\n", Location{Line: 2, Column: 1}},
+		{"plain text", "This is just some plain text content.", Location{Line: 1, Column: 1}},
+		{"missing field type", "type Query {\n  users\n}", Location{Line: 3, Column: 1}},
+		{"empty fields", "type Query {}", Location{Line: 1, Column: 13}},
+		{"unclosed type", "type Query {\n  users: [User]\n", Location{Line: 3, Column: 1}},
+		{"operation", "query { users }", Location{Line: 1, Column: 1}},
+		{"anonymous operation", "{ users }", Location{Line: 1, Column: 1}},
+		{"variable in default value", "type Query { users(first: Int = $first): [User] }", Location{Line: 1, Column: 33}},
+		{"unknown root operation", "schema { read: Query }", Location{Line: 1, Column: 10}},
+		{"unknown directive location", "directive @auth on METHOD", Location{Line: 1, Column: 20}},
+		{"empty extension", "extend type Query", Location{Line: 1, Column: 18}},
+		{"boolean enum value", "enum Flag { true }", Location{Line: 1, Column: 13}},
+		{"description before extension", "\"Text\" extend type Query @tag", Location{Line: 1, Column: 8}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.source)
+			if err == nil {
+				t.Fatal("Expected error, got nil")
+			}
+
+			var parseErr *ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Expected *ParseError, got %T", err)
+			}
+			if parseErr.Loc != tt.loc {
+				t.Errorf("Expected error at %+v, got %+v (%v)", tt.loc, parseErr.Loc, parseErr)
+			}
+		})
+	}
+}
+
+func TestParseErrorMessage(t *testing.T) {
+	_, err := Parse("type Query {\n  users: \n}")
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	expected := "syntax error at line 3, column 1: expected type, found '}'"
+	if err.Error() != expected {
+		t.Errorf("Expected '%s', got '%s'", expected, err.Error())
+	}
+}
@@ -0,0 +1,181 @@
+package graphql
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Print renders a document as GraphQL SDL
+func Print(doc *Document) string {
+	var definitions []string
+	for _, def := range doc.Definitions {
+		definitions = append(definitions, printDefinition(def))
+	}
+	return strings.Join(definitions, "\n\n") + "\n"
+}
+
+func printDefinition(def *Definition) string {
+	var sb strings.Builder
+	sb.WriteString(printDescription(def.Description, ""))
+	if def.Extension {
+		sb.WriteString("extend ")
+	}
+	sb.WriteString(string(def.Kind))
+
+	switch def.Kind {
+	case KindSchema:
+		sb.WriteString(printDirectives(def.Directives))
+		if len(def.OperationTypes) > 0 {
+			sb.WriteString(" {\n")
+			for _, operationType := range def.OperationTypes {
+				sb.WriteString(fmt.Sprintf("  %s: %s\n", operationType.Operation, operationType.Type))
+			}
+			sb.WriteString("}")
+		}
+	case KindDirective:
+		sb.WriteString(" @" + def.Name)
+		sb.WriteString(printArgumentDefinitions(def.Arguments, ""))
+		if def.Repeatable {
+			sb.WriteString(" repeatable")
+		}
+		sb.WriteString(" on " + strings.Join(def.Locations, " | "))
+	default:
+		sb.WriteString(" " + def.Name)
+		if len(def.Interfaces) > 0 {
+			sb.WriteString(" implements " + strings.Join(def.Interfaces, " & "))
+		}
+		sb.WriteString(printDirectives(def.Directives))
+		if len(def.Types) > 0 {
+			sb.WriteString(" = " + strings.Join(def.Types, " | "))
+		}
+
+		var items []string
+		for _, field := range def.Fields {
+			items = append(items, printDescription(field.Description, "  ")+"  "+field.Name+
+				printArgumentDefinitions(field.Arguments, "  ")+": "+field.Type.String()+printDirectives(field.Directives))
+		}
+		for _, field := range def.InputFields {
+			items = append(items, printDescription(field.Description, "  ")+"  "+printInputValue(field))
+		}
+		for _, value := range def.EnumValues {
+			items = append(items, printDescription(value.Description, "  ")+"  "+value.Name+printDirectives(value.Directives))
+		}
+		if len(items) > 0 {
+			sb.WriteString(" {\n" + strings.Join(items, "\n") + "\n}")
+		}
+	}
+	return sb.String()
+}
+
+func printArgumentDefinitions(arguments []InputValueDefinition, indent string) string {
+	if len(arguments) == 0 {
+		return ""
+	}
+
+	multiline := false
+	for _, argument := range arguments {
+		if argument.Description != "" {
+			multiline = true
+		}
+	}
+
+	var items []string
+	for _, argument := range arguments {
+		if multiline {
+			items = append(items, printDescription(argument.Description, indent+"  ")+indent+"  "+printInputValue(argument))
+		} else {
+			items = append(items, printInputValue(argument))
+		}
+	}
+
+	if multiline {
+		return "(\n" + strings.Join(items, "\n") + "\n" + indent + ")"
+	}
+	return "(" + strings.Join(items, ", ") + ")"
+}
+
+func printInputValue(value InputValueDefinition) string {
+	result := value.Name + ": " + value.Type.String()
+	if value.DefaultValue != "" {
+		result += " = " + value.DefaultValue
+	}
+	return result + printDirectives(value.Directives)
+}
+
+func printDirectives(directives []Directive) string {
+	var sb strings.Builder
+	for _, directive := range directives {
+		sb.WriteString(" @" + directive.Name)
+		if len(directive.Arguments) > 0 {
+			var arguments []string
+			for _, argument := range directive.Arguments {
+				arguments = append(arguments, argument.Name+": "+argument.Value)
+			}
+			sb.WriteString("(" + strings.Join(arguments, ", ") + ")")
+		}
+	}
+	return sb.String()
+}
+
+// printDescription renders a description followed by a line break, block strings are used for multi-line descriptions
+func printDescription(description string, indent string) string {
+	if description == "" {
+		return ""
+	}
+	if !strings.ContainsAny(description, "\n\"\\") {
+		return indent + QuoteString(description) + "\n"
+	}
+
+	lines := strings.Split(strings.ReplaceAll(description, `"""`, `\"""`), "\n")
+	var sb strings.Builder
+	sb.WriteString(indent + `"""` + "\n")
+	for _, line := range lines {
+		if line != "" {
+			sb.WriteString(indent + line)
+		}
+		sb.WriteString("\n")
+	}
+	sb.WriteString(indent + `"""` + "\n")
+	return sb.String()
+}
+
+// QuoteString renders a value as a GraphQL string literal
+func QuoteString(value string) string {
+	var sb strings.Builder
+	sb.WriteByte('"')
+	for _, r := range value {
+		switch r {
+		case '"':
+			sb.WriteString(`\"`)
+		case '\\':
+			sb.WriteString(`\\`)
+		case '\n':
+			sb.WriteString(`\n`)
+		case '\r':
+			sb.WriteString(`\r`)
+		case '\t':
+			sb.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				sb.WriteString(fmt.Sprintf(`\u%04X`, r))
+			} else {
+				sb.WriteRune(r)
+			}
+		}
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
+
+// UnquoteString returns the value of a GraphQL string or block string literal
+func UnquoteString(literal string) (string, error) {
+	l := newLexer(literal)
+	token, err := l.next()
+	if err != nil {
+		return "", err
+	}
+	if token.Kind != TokenString && token.Kind != TokenBlockString {
+		return "", fmt.Errorf("%s is not a string literal", literal)
+	}
+	return token.Value, nil
+}
@@ -0,0 +1,83 @@
+package graphql
+
+import (
+	"testing"
+)
+
+func TestPrint(t *testing.T) {
+	source := `"""
+Users service
+schema
+"""
+schema {
+  query: RootQuery
+}
+
+"Root query"
+type RootQuery implements Node @key(fields: "id") {
+  id: ID!
+  users(first: Int = 10, filter: UserFilter): [User!]! @deprecated(reason: "Use search")
+  search(
+    "Search text"
+    text: String!
+  ): [SearchResult]
+}
+
+interface Node {
+  id: ID!
+}
+
+union SearchResult = User | Group
+
+enum Role {
+  ADMIN
+  USER @deprecated
+}
+
+input UserFilter {
+  name: String
+  roles: [Role!] = [ADMIN]
+}
+
+scalar DateTime @specifiedBy(url: "https://tools.ietf.org/html/rfc3339")
+
+directive @key(fields: String!) repeatable on OBJECT | INTERFACE
+
+extend type User @key(fields: "id")
+`
+
+	doc, err := Parse(source)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if printed := Print(doc); printed != source {
+		t.Errorf("Expected printed SDL to match source, got:\n%s", printed)
+	}
+}
+
+func TestQuoteString(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected string
+	}{
+		{"plain", `"plain"`},
+		{`say "hi"`, `"say \"hi\""`},
+		{"line\nbreak\t\\", `"line\nbreak\t\\"`},
+		{"\x01", `"\u0001"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			quoted := QuoteString(tt.value)
+			if quoted != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, quoted)
+			}
+
+			value, err := UnquoteString(quoted)
+			if err != nil || value != tt.value {
+				t.Errorf("Expected round trip to %q, got %q (%v)", tt.value, value, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package linter
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/document"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/loader"
+)
+
+// Rule interface for API style checks
+type Rule interface {
+	// Name returns the unique rule name used in configuration and diagnostics
+	Name() string
+
+	// DefaultSeverity returns the severity used when the rule is not configured explicitly
+	DefaultSeverity() config.Severity
+
+	// CanCheck returns true if this rule applies to the spec
+	CanCheck(spec *config.SpecMetadata) bool
+
+	// Check inspects the document and returns rule violations
+	Check(doc *Document) []Finding
+}
+
+// Document is a spec prepared for linting
+type Document struct {
+	Spec    *config.SpecMetadata
+	Content []byte
+	Data    map[string]interface{} // parsed content of JSON and YAML documents, nil for other formats
+}
+
+// Finding describes a single rule violation
+type Finding struct {
+	Location string // JSON pointer to the violation location within JSON and YAML documents, 'line:column' within GraphQL SDL
+	Message  string
+}
+
+// Linter runs lint rules over discovered specs
+type Linter struct {
+	rules      []Rule
+	severities map[string]config.Severity
+}
+
+// New creates a new linter with the default rule set
+func New(cfg config.LintConfig) *Linter {
+	return NewWithRules(cfg, DefaultRules())
+}
+
+// NewWithRules creates a new linter with the given rule set
+func NewWithRules(cfg config.LintConfig, rules []Rule) *Linter {
+	return &Linter{
+		rules:      rules,
+		severities: cfg.Rules,
+	}
+}
+
+// Lint runs the rules over REST and GraphQL specs and returns diagnostics, warnings, and errors
+func (l *Linter) Lint(specs []config.SpecMetadata) ([]config.Diagnostic, []string, []error) {
+	var diagnostics []config.Diagnostic
+	var errors []error
+
+	warnings := l.checkConfiguredRules()
+
+	for i := range specs {
+		spec := &specs[i]
+		if spec.ApiType != config.ApiTypeRest && spec.ApiType != config.ApiTypeGraphQL {
+			continue
+		}
+
+		doc, err := l.loadDocument(spec)
+		if err != nil {
+			errors = append(errors, fmt.Errorf("cannot lint file %s: %w", spec.FilePath, err))
+			continue
+		}
+
+		for _, rule := range l.rules {
+			severity := l.severity(rule)
+			if severity == config.SeverityOff || !rule.CanCheck(spec) {
+				continue
+			}
+			for _, finding := range rule.Check(doc) {
+				diagnostics = append(diagnostics, config.Diagnostic{
+					FilePath: spec.FilePath,
+					Location: finding.Location,
+					Severity: severity,
+					Rule:     rule.Name(),
+					Message:  finding.Message,
+				})
+			}
+		}
+	}
+
+	return diagnostics, warnings, errors
+}
+
+func (l *Linter) severity(rule Rule) config.Severity {
+	if severity, ok := l.severities[rule.Name()]; ok && isValidSeverity(severity) {
+		return severity
+	}
+	return rule.DefaultSeverity()
+}
+
+func (l *Linter) checkConfiguredRules() []string {
+	known := make(map[string]bool, len(l.rules))
+	for _, rule := range l.rules {
+		known[rule.Name()] = true
+	}
+
+	var warnings []string
+	for _, name := range sortedRuleNames(l.severities) {
+		severity := l.severities[name]
+		if !known[name] {
+			warnings = append(warnings, fmt.Sprintf("lint rule '%s' is unknown, its configuration is ignored", name))
+			continue
+		}
+		if !isValidSeverity(severity) {
+			warnings = append(warnings, fmt.Sprintf("lint rule '%s' has invalid severity '%s', using default", name, severity))
+		}
+	}
+	return warnings
+}
+
+func (l *Linter) loadDocument(spec *config.SpecMetadata) (*Document, error) {
+	content, err := loader.Read(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := &Document{
+		Spec:    spec,
+		Content: content,
+	}
+	if spec.Format == config.FormatJSON || spec.Format == config.FormatYAML {
+		doc.Data, err = document.Decode(content, spec.Format)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return doc, nil
+}
+
+func isValidSeverity(severity config.Severity) bool {
+	switch severity {
+	case config.SeverityError, config.SeverityWarning, config.SeverityInfo, config.SeverityOff:
+		return true
+	default:
+		return false
+	}
+}
+
+func sortedRuleNames(severities map[string]config.Severity) []string {
+	names := make([]string, 0, len(severities))
+	for name := range severities {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
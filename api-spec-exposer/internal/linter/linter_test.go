@@ -0,0 +1,180 @@
+package linter
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+)
+
+func writeSpec(t *testing.T, dir string, name string, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write test file %s: %v", name, err)
+	}
+	return path
+}
+
+func TestLinterLintDefaultRules(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "linter-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	restPath := writeSpec(t, tempDir, "api.yaml", `
+openapi: 3.0.0
+info:
+  title: API
+  version: "1"
+paths:
+  /userProfiles/{id}:
+    get:
+      responses:
+        "200":
+          description: OK
+`)
+	gqlPath := writeSpec(t, tempDir, "schema.graphql", `type Query { user: user_type }
+type user_type { id: ID }`)
+
+	specs := []config.SpecMetadata{
+		{FilePath: restPath, Type: config.DocTypeOpenAPI30, ApiType: config.ApiTypeRest, Format: config.FormatYAML, XApiKind: "BWC"},
+		{FilePath: gqlPath, Type: config.DocTypeGraphQL, ApiType: config.ApiTypeGraphQL, Format: config.FormatGraphQL},
+		{FilePath: "/nonexistent/doc.md", Type: config.DocTypeMarkdown, ApiType: config.ApiTypeMarkdown, Format: config.FormatMarkdown},
+	}
+
+	diagnostics, warnings, errors := New(config.LintConfig{Enabled: true}).Lint(specs)
+
+	if len(errors) != 0 {
+		t.Errorf("Expected 0 errors, got %v", errors)
+	}
+
+	if len(warnings) != 0 {
+		t.Errorf("Expected 0 warnings, got %v", warnings)
+	}
+
+	expectedRules := map[string]string{
+		"paths-kebab-case":          "/paths/~1userProfiles~1{id}",
+		"operation-operation-id":    "/paths/~1userProfiles~1{id}/get",
+		"operation-error-responses": "/paths/~1userProfiles~1{id}/get/responses",
+		"x-api-kind-declared":       "",
+		"graphql-type-pascal-case":  "2:1",
+	}
+
+	if len(diagnostics) != len(expectedRules) {
+		t.Fatalf("Expected %d diagnostics, got %d: %v", len(expectedRules), len(diagnostics), diagnostics)
+	}
+
+	for _, diagnostic := range diagnostics {
+		location, ok := expectedRules[diagnostic.Rule]
+		if !ok {
+			t.Errorf("Unexpected diagnostic %v", diagnostic)
+			continue
+		}
+		if diagnostic.Location != location {
+			t.Errorf("Expected location '%s' for rule '%s', got '%s'", location, diagnostic.Rule, diagnostic.Location)
+		}
+		if diagnostic.Severity != config.SeverityWarning {
+			t.Errorf("Expected default severity warning for rule '%s', got '%s'", diagnostic.Rule, diagnostic.Severity)
+		}
+	}
+}
+
+func TestLinterLintSeverityOverrides(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "linter-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	restPath := writeSpec(t, tempDir, "api.json", `{
+		"openapi": "3.0.0",
+		"info": {"title": "API", "version": "1"},
+		"paths": {"/users": {"get": {"responses": {"200": {"description": "OK"}, "404": {"description": "Not found"}}}}}
+	}`)
+
+	specs := []config.SpecMetadata{
+		{FilePath: restPath, Type: config.DocTypeOpenAPI30, ApiType: config.ApiTypeRest, Format: config.FormatJSON},
+	}
+
+	cfg := config.LintConfig{
+		Enabled: true,
+		Rules: map[string]config.Severity{
+			"operation-operation-id": config.SeverityError,
+			"x-api-kind-declared":    config.SeverityOff,
+			"paths-kebab-case":       "fatal",
+			"no-such-rule":           config.SeverityError,
+		},
+	}
+
+	diagnostics, warnings, errors := New(cfg).Lint(specs)
+
+	if len(errors) != 0 {
+		t.Errorf("Expected 0 errors, got %v", errors)
+	}
+
+	if len(warnings) != 2 {
+		t.Errorf("Expected 2 warnings for unknown rule and invalid severity, got %v", warnings)
+	}
+
+	if len(diagnostics) != 1 {
+		t.Fatalf("Expected 1 diagnostic, got %d: %v", len(diagnostics), diagnostics)
+	}
+
+	if diagnostics[0].Rule != "operation-operation-id" || diagnostics[0].Severity != config.SeverityError {
+		t.Errorf("Expected operation-operation-id error, got %v", diagnostics[0])
+	}
+}
+
+func TestLinterLintUnreadableFile(t *testing.T) {
+	specs := []config.SpecMetadata{
+		{FilePath: "/nonexistent/api.json", Type: config.DocTypeOpenAPI30, ApiType: config.ApiTypeRest, Format: config.FormatJSON},
+	}
+
+	diagnostics, _, errors := New(config.LintConfig{Enabled: true}).Lint(specs)
+
+	if len(diagnostics) != 0 {
+		t.Errorf("Expected 0 diagnostics, got %d", len(diagnostics))
+	}
+
+	if len(errors) != 1 {
+		t.Errorf("Expected 1 error, got %d", len(errors))
+	}
+}
+
+type testRule struct{}
+
+func (r *testRule) Name() string { return "test-rule" }
+
+func (r *testRule) DefaultSeverity() config.Severity { return config.SeverityInfo }
+
+func (r *testRule) CanCheck(spec *config.SpecMetadata) bool { return true }
+
+func (r *testRule) Check(doc *Document) []Finding {
+	return []Finding{{Location: "/info", Message: "checked"}}
+}
+
+func TestLinterNewWithRules(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "linter-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	restPath := writeSpec(t, tempDir, "api.json", `{"openapi": "3.0.0", "info": {"title": "API", "version": "1"}, "paths": {}}`)
+	specs := []config.SpecMetadata{
+		{FilePath: restPath, Type: config.DocTypeOpenAPI30, ApiType: config.ApiTypeRest, Format: config.FormatJSON},
+	}
+
+	diagnostics, _, _ := NewWithRules(config.LintConfig{Enabled: true}, []Rule{&testRule{}}).Lint(specs)
+
+	if len(diagnostics) != 1 {
+		t.Fatalf("Expected 1 diagnostic, got %d", len(diagnostics))
+	}
+
+	if diagnostics[0].Rule != "test-rule" || diagnostics[0].Severity != config.SeverityInfo {
+		t.Errorf("Expected test-rule info diagnostic, got %v", diagnostics[0])
+	}
+}
@@ -0,0 +1,182 @@
+package linter
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/document"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/graphql"
+)
+
+var (
+	kebabCasePattern  = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+	pascalCasePattern = regexp.MustCompile(`^[A-Z][a-zA-Z0-9]*$`)
+	errorCodePattern  = regexp.MustCompile(`^[45](\d\d|XX)$`)
+)
+
+// DefaultRules returns the default rule set
+func DefaultRules() []Rule {
+	return []Rule{
+		&PathsKebabCaseRule{},
+		&OperationIdRule{},
+		&ErrorResponsesRule{},
+		&XApiKindDeclaredRule{},
+		&GraphQLTypeNamesRule{},
+	}
+}
+
+// PathsKebabCaseRule requires static path segments to be kebab-case
+type PathsKebabCaseRule struct{}
+
+func (r *PathsKebabCaseRule) Name() string { return "paths-kebab-case" }
+
+func (r *PathsKebabCaseRule) DefaultSeverity() config.Severity { return config.SeverityWarning }
+
+func (r *PathsKebabCaseRule) CanCheck(spec *config.SpecMetadata) bool {
+	return spec.ApiType == config.ApiTypeRest
+}
+
+func (r *PathsKebabCaseRule) Check(doc *Document) []Finding {
+	var findings []Finding
+	paths, _ := doc.Data["paths"].(map[string]interface{})
+	for _, path := range document.SortedKeys(paths) {
+		if strings.HasPrefix(path, "x-") {
+			continue
+		}
+		for _, segment := range strings.Split(path, "/") {
+			if segment == "" || (strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}")) {
+				continue
+			}
+			if !kebabCasePattern.MatchString(segment) {
+				findings = append(findings, Finding{
+					Location: document.Pointer("paths", path),
+					Message:  fmt.Sprintf("path segment '%s' is not kebab-case", segment),
+				})
+				break
+			}
+		}
+	}
+	return findings
+}
+
+// OperationIdRule requires every operation to have an operationId
+type OperationIdRule struct{}
+
+func (r *OperationIdRule) Name() string { return "operation-operation-id" }
+
+func (r *OperationIdRule) DefaultSeverity() config.Severity { return config.SeverityWarning }
+
+func (r *OperationIdRule) CanCheck(spec *config.SpecMetadata) bool {
+	return spec.ApiType == config.ApiTypeRest
+}
+
+func (r *OperationIdRule) Check(doc *Document) []Finding {
+	var findings []Finding
+	forEachOperation(doc, func(path string, method string, operation map[string]interface{}) {
+		if operationId, _ := operation["operationId"].(string); strings.TrimSpace(operationId) == "" {
+			findings = append(findings, Finding{
+				Location: document.Pointer("paths", path, method),
+				Message:  fmt.Sprintf("operation '%s %s' has no operationId", strings.ToUpper(method), path),
+			})
+		}
+	})
+	return findings
+}
+
+// ErrorResponsesRule requires every operation to declare at least one error response
+type ErrorResponsesRule struct{}
+
+func (r *ErrorResponsesRule) Name() string { return "operation-error-responses" }
+
+func (r *ErrorResponsesRule) DefaultSeverity() config.Severity { return config.SeverityWarning }
+
+func (r *ErrorResponsesRule) CanCheck(spec *config.SpecMetadata) bool {
+	return spec.ApiType == config.ApiTypeRest
+}
+
+func (r *ErrorResponsesRule) Check(doc *Document) []Finding {
+	var findings []Finding
+	forEachOperation(doc, func(path string, method string, operation map[string]interface{}) {
+		responses, _ := operation["responses"].(map[string]interface{})
+		for code := range responses {
+			if code == "default" || errorCodePattern.MatchString(code) {
+				return
+			}
+		}
+		findings = append(findings, Finding{
+			Location: document.Pointer("paths", path, method, "responses"),
+			Message:  fmt.Sprintf("operation '%s %s' declares no 4xx, 5xx or default response", strings.ToUpper(method), path),
+		})
+	})
+	return findings
+}
+
+// XApiKindDeclaredRule requires the 'x-api-kind' extension to be declared in the document
+type XApiKindDeclaredRule struct{}
+
+func (r *XApiKindDeclaredRule) Name() string { return "x-api-kind-declared" }
+
+func (r *XApiKindDeclaredRule) DefaultSeverity() config.Severity { return config.SeverityWarning }
+
+func (r *XApiKindDeclaredRule) CanCheck(spec *config.SpecMetadata) bool {
+	return spec.ApiType == config.ApiTypeRest
+}
+
+func (r *XApiKindDeclaredRule) Check(doc *Document) []Finding {
+	if _, ok := doc.Data["x-api-kind"]; ok {
+		return nil
+	}
+	return []Finding{{
+		Location: "",
+		Message:  fmt.Sprintf("'x-api-kind' is not declared, '%s' is derived from the file name", doc.Spec.XApiKind),
+	}}
+}
+
+// GraphQLTypeNamesRule requires GraphQL type names to be PascalCase
+type GraphQLTypeNamesRule struct{}
+
+func (r *GraphQLTypeNamesRule) Name() string { return "graphql-type-pascal-case" }
+
+func (r *GraphQLTypeNamesRule) DefaultSeverity() config.Severity { return config.SeverityWarning }
+
+func (r *GraphQLTypeNamesRule) CanCheck(spec *config.SpecMetadata) bool {
+	return spec.Type == config.DocTypeGraphQL
+}
+
+func (r *GraphQLTypeNamesRule) Check(doc *Document) []Finding {
+	schema, err := graphql.Parse(string(doc.Content))
+	if err != nil {
+		return nil
+	}
+
+	var findings []Finding
+	for _, def := range schema.Definitions {
+		if def.Kind == graphql.KindSchema || def.Kind == graphql.KindDirective {
+			continue
+		}
+		if !pascalCasePattern.MatchString(def.Name) {
+			findings = append(findings, Finding{
+				Location: fmt.Sprintf("%d:%d", def.Loc.Line, def.Loc.Column),
+				Message:  fmt.Sprintf("type name '%s' is not PascalCase", def.Name),
+			})
+		}
+	}
+	return findings
+}
+
+func forEachOperation(doc *Document, fn func(path string, method string, operation map[string]interface{})) {
+	paths, _ := doc.Data["paths"].(map[string]interface{})
+	for _, path := range document.SortedKeys(paths) {
+		pathItem, ok := paths[path].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, method := range document.OperationMethodsOf(doc.Spec.Type) {
+			if operation, ok := pathItem[method].(map[string]interface{}); ok {
+				fn(path, method, operation)
+			}
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package linter
+
+import (
+	"testing"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+)
+
+func restDocument(data map[string]interface{}) *Document {
+	return &Document{
+		Spec: &config.SpecMetadata{ApiType: config.ApiTypeRest, XApiKind: "BWC"},
+		Data: data,
+	}
+}
+
+func operationsDocument(paths map[string]interface{}) *Document {
+	return restDocument(map[string]interface{}{"paths": paths})
+}
+
+func TestOperationIdRuleSwagger20Trace(t *testing.T) {
+	rule := &OperationIdRule{}
+	paths := map[string]interface{}{
+		"/users": map[string]interface{}{
+			"get":   map[string]interface{}{"operationId": "listUsers"},
+			"trace": map[string]interface{}{},
+		},
+	}
+
+	tests := []struct {
+		docType  config.DocumentType
+		expected int
+	}{
+		{config.DocTypeOpenAPI20, 0},
+		{config.DocTypeOpenAPI30, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.docType), func(t *testing.T) {
+			doc := operationsDocument(paths)
+			doc.Spec.Type = tt.docType
+			if findings := rule.Check(doc); len(findings) != tt.expected {
+				t.Errorf("Expected %d findings, got %d: %v", tt.expected, len(findings), findings)
+			}
+		})
+	}
+}
+
+func TestPathsKebabCaseRule(t *testing.T) {
+	rule := &PathsKebabCaseRule{}
+
+	tests := []struct {
+		path     string
+		expected int
+	}{
+		{"/users", 0},
+		{"/user-profiles/{profileId}/avatar-images", 0},
+		{"/api/v1/items", 0},
+		{"/userProfiles", 1},
+		{"/user_profiles", 1},
+		{"/Users/{id}", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			doc := operationsDocument(map[string]interface{}{tt.path: map[string]interface{}{}})
+			findings := rule.Check(doc)
+			if len(findings) != tt.expected {
+				t.Errorf("Expected %d findings, got %d: %v", tt.expected, len(findings), findings)
+			}
+		})
+	}
+}
+
+func TestOperationIdRule(t *testing.T) {
+	rule := &OperationIdRule{}
+	doc := operationsDocument(map[string]interface{}{
+		"/users": map[string]interface{}{
+			"get":        map[string]interface{}{"operationId": "listUsers"},
+			"post":       map[string]interface{}{"operationId": " "},
+			"delete":     map[string]interface{}{},
+			"parameters": []interface{}{},
+		},
+	})
+
+	findings := rule.Check(doc)
+
+	if len(findings) != 2 {
+		t.Fatalf("Expected 2 findings, got %d: %v", len(findings), findings)
+	}
+
+	if findings[0].Location != "/paths/~1users/post" || findings[1].Location != "/paths/~1users/delete" {
+		t.Errorf("Unexpected finding locations: %v", findings)
+	}
+}
+
+func TestErrorResponsesRule(t *testing.T) {
+	rule := &ErrorResponsesRule{}
+
+	tests := []struct {
+		name      string
+		responses map[string]interface{}
+		expected  int
+	}{
+		{"success only", map[string]interface{}{"200": map[string]interface{}{}}, 1},
+		{"client error", map[string]interface{}{"200": map[string]interface{}{}, "404": map[string]interface{}{}}, 0},
+		{"server error range", map[string]interface{}{"5XX": map[string]interface{}{}}, 0},
+		{"default", map[string]interface{}{"default": map[string]interface{}{}}, 0},
+		{"no responses", nil, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			operation := map[string]interface{}{}
+			if tt.responses != nil {
+				operation["responses"] = tt.responses
+			}
+			doc := operationsDocument(map[string]interface{}{"/users": map[string]interface{}{"get": operation}})
+			findings := rule.Check(doc)
+			if len(findings) != tt.expected {
+				t.Errorf("Expected %d findings, got %d: %v", tt.expected, len(findings), findings)
+			}
+		})
+	}
+}
+
+func TestXApiKindDeclaredRule(t *testing.T) {
+	rule := &XApiKindDeclaredRule{}
+
+	if findings := rule.Check(restDocument(map[string]interface{}{"x-api-kind": "no-BWC"})); len(findings) != 0 {
+		t.Errorf("Expected no findings, got %v", findings)
+	}
+
+	if findings := rule.Check(restDocument(map[string]interface{}{})); len(findings) != 1 {
+		t.Errorf("Expected 1 finding, got %v", findings)
+	}
+}
+
+func TestGraphQLTypeNamesRule(t *testing.T) {
+	rule := &GraphQLTypeNamesRule{}
+
+	if !rule.CanCheck(&config.SpecMetadata{Type: config.DocTypeGraphQL}) {
+		t.Error("Expected rule to check GraphQL schemas")
+	}
+
+	if rule.CanCheck(&config.SpecMetadata{Type: config.DocTypeIntrospection}) {
+		t.Error("Expected rule to skip introspection results")
+	}
+
+	doc := &Document{Content: []byte(`type Query { user: User }
+type User { id: ID }
+input user_input { id: ID }
+extend type pet { id: ID }
+enum Color { RED }`)}
+
+	findings := rule.Check(doc)
+
+	if len(findings) != 2 {
+		t.Fatalf("Expected 2 findings, got %d: %v", len(findings), findings)
+	}
+	if findings[0].Location != "3:1" || findings[0].Message != "type name 'user_input' is not PascalCase" {
+		t.Errorf("Expected finding at '3:1' without position in the message, got %v", findings[0])
+	}
+}
@@ -0,0 +1,69 @@
+package loader
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/archive"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/charset"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/document"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/graphql"
+)
+
+// Read returns the content of a discovered spec, specs merged or composed from several source files are rendered from their sources,
+// specs of other encodings are converted to UTF-8 and specs of multi-document YAML files are cut out of the file
+func Read(spec *config.SpecMetadata) ([]byte, error) {
+	if spec.Supergraph {
+		schema, err := graphql.LoadSupergraph(spec.SourceFiles)
+		if err != nil {
+			return nil, err
+		}
+		return []byte(graphql.Print(schema)), nil
+	}
+
+	if len(spec.SourceFiles) > 0 {
+		schema, err := graphql.LoadSchema(spec.SourceFiles)
+		if err != nil {
+			return nil, err
+		}
+		return []byte(graphql.Print(schema)), nil
+	}
+
+	content, err := readFile(spec)
+	if err != nil {
+		return nil, err
+	}
+	if content, err = charset.Decode(content, spec.Encoding); err != nil || spec.DocumentIndex == 0 {
+		return content, err
+	}
+	documents := document.SplitYAML(content)
+	if spec.DocumentIndex > len(documents) {
+		return nil, fmt.Errorf("file %s has no document %d", spec.FilePath, spec.DocumentIndex)
+	}
+	return documents[spec.DocumentIndex-1], nil
+}
+
+// IsPlainFile reports whether the content of a spec is the unchanged content of its file
+func IsPlainFile(spec *config.SpecMetadata) bool {
+	return len(spec.SourceFiles) == 0 && spec.DocumentIndex == 0 && spec.Archive == "" && spec.Encoding == ""
+}
+
+// readFile returns the content of the file of a spec, which is read from the archive containing the spec if there is one
+// within the limits the archive was scanned with
+func readFile(spec *config.SpecMetadata) ([]byte, error) {
+	if spec.Archive == "" {
+		return os.ReadFile(spec.FilePath)
+	}
+	name, err := filepath.Rel(spec.Archive, spec.FilePath)
+	if err != nil {
+		return nil, err
+	}
+	limits := archive.Limits{
+		MaxEntries:   spec.ArchiveLimits.MaxEntries,
+		MaxFileSize:  spec.ArchiveLimits.MaxFileSize,
+		MaxTotalSize: spec.ArchiveLimits.MaxTotalSize,
+	}
+	return archive.ReadFile(spec.Archive, filepath.ToSlash(name), limits)
+}
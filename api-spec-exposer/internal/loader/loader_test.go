@@ -0,0 +1,167 @@
+package loader
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/charset"
+)
+
+func TestRead(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "loader-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	files := map[string]string{
+		"query.graphqls": "type Query { users: [User] }",
+		"users.graphqls": "type User { id: ID! }",
+		"orders.graphql": "type Query { orders: [String] }\ntype User @key(fields: \"id\") { id: ID! @external }",
+		"bundle.yaml":    "kind: ConfigMap\n---\nopenapi: 3.0.0\n",
+		"bom.yaml":       "\xef\xbb\xbfkind: ConfigMap\n---\nopenapi: 3.0.0\n",
+		"latin1.md":      "# Caf\xe9\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(tempDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write test file: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		spec     config.SpecMetadata
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "single file",
+			spec:     config.SpecMetadata{FilePath: filepath.Join(tempDir, "users.graphqls")},
+			expected: "type User { id: ID! }",
+		},
+		{
+			name: "merged source files",
+			spec: config.SpecMetadata{
+				FilePath:    tempDir,
+				SourceFiles: []string{filepath.Join(tempDir, "query.graphqls"), filepath.Join(tempDir, "users.graphqls")},
+			},
+			expected: "type Query {\n  users: [User]\n}\n\ntype User {\n  id: ID!\n}\n",
+		},
+		{
+			name: "composed supergraph",
+			spec: config.SpecMetadata{
+				FilePath:    tempDir,
+				SourceFiles: []string{filepath.Join(tempDir, "query.graphqls"), filepath.Join(tempDir, "users.graphqls"), filepath.Join(tempDir, "orders.graphql")},
+				Supergraph:  true,
+			},
+			expected: "type Query {\n  users: [User]\n  orders: [String]\n}\n\ntype User {\n  id: ID!\n}\n",
+		},
+		{
+			name:     "document of a multi-document file",
+			spec:     config.SpecMetadata{FilePath: filepath.Join(tempDir, "bundle.yaml"), DocumentIndex: 2},
+			expected: "---\nopenapi: 3.0.0\n",
+		},
+		{
+			name:     "document of a file with a byte order mark",
+			spec:     config.SpecMetadata{FilePath: filepath.Join(tempDir, "bom.yaml"), DocumentIndex: 2, Encoding: charset.UTF8BOM},
+			expected: "---\nopenapi: 3.0.0\n",
+		},
+		{
+			name:     "file converted to UTF-8",
+			spec:     config.SpecMetadata{FilePath: filepath.Join(tempDir, "latin1.md"), Encoding: charset.Latin1},
+			expected: "# Café\n",
+		},
+		{
+			name:    "unsupported encoding",
+			spec:    config.SpecMetadata{FilePath: filepath.Join(tempDir, "latin1.md"), Encoding: "EBCDIC"},
+			wantErr: true,
+		},
+		{
+			name:    "missing document",
+			spec:    config.SpecMetadata{FilePath: filepath.Join(tempDir, "bundle.yaml"), DocumentIndex: 3},
+			wantErr: true,
+		},
+		{
+			name:    "missing file",
+			spec:    config.SpecMetadata{FilePath: filepath.Join(tempDir, "missing.graphqls")},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, err := Read(&tt.spec)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			if string(content) != tt.expected {
+				t.Errorf("Expected content %q, got %q", tt.expected, string(content))
+			}
+		})
+	}
+}
+
+func TestReadArchive(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "loader-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	archivePath := filepath.Join(tempDir, "specs.zip")
+	file, err := os.Create(archivePath)
+	if err != nil {
+		t.Fatalf("Failed to create archive: %v", err)
+	}
+	writer := zip.NewWriter(file)
+	w, err := writer.Create("deploy/bundle.yaml")
+	if err != nil {
+		t.Fatalf("Failed to add archive entry: %v", err)
+	}
+	w.Write([]byte("kind: ConfigMap\n---\nopenapi: 3.0.0\n"))
+	writer.Close()
+	file.Close()
+
+	tests := []struct {
+		name     string
+		spec     config.SpecMetadata
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "file of an archive",
+			spec:     config.SpecMetadata{FilePath: filepath.Join(archivePath, "deploy", "bundle.yaml"), Archive: archivePath},
+			expected: "kind: ConfigMap\n---\nopenapi: 3.0.0\n",
+		},
+		{
+			name:     "document of a file of an archive",
+			spec:     config.SpecMetadata{FilePath: filepath.Join(archivePath, "deploy", "bundle.yaml"), Archive: archivePath, DocumentIndex: 2},
+			expected: "---\nopenapi: 3.0.0\n",
+		},
+		{
+			name:    "file larger than the scan limit",
+			spec:    config.SpecMetadata{FilePath: filepath.Join(archivePath, "deploy", "bundle.yaml"), Archive: archivePath, ArchiveLimits: config.ArchiveScanningConfig{MaxFileSize: 16}},
+			wantErr: true,
+		},
+		{
+			name:    "missing file of an archive",
+			spec:    config.SpecMetadata{FilePath: filepath.Join(archivePath, "missing.yaml"), Archive: archivePath},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, err := Read(&tt.spec)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			if string(content) != tt.expected {
+				t.Errorf("Expected content %q, got %q", tt.expected, string(content))
+			}
+		})
+	}
+}
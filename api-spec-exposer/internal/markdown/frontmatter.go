@@ -0,0 +1,29 @@
+package markdown
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// SplitFrontMatter separates the front matter delimited by '---' lines from the Markdown body.
+// The front matter is nil when the document has none, an unclosed front matter is reported as an error
+// and the whole content is returned as the body
+func SplitFrontMatter(content []byte) ([]byte, []byte, error) {
+	text := bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))
+	lines := strings.SplitAfter(string(text), "\n")
+	if len(lines) == 0 || strings.TrimRight(lines[0], " \t\r\n") != "---" {
+		return nil, text, nil
+	}
+
+	offset := len(lines[0])
+	for _, line := range lines[1:] {
+		trimmed := strings.TrimRight(line, " \t\r\n")
+		if trimmed == "---" || trimmed == "..." {
+			return text[len(lines[0]):offset], text[offset+len(line):], nil
+		}
+		offset += len(line)
+	}
+
+	return nil, text, fmt.Errorf("closing '---' is missing")
+}
@@ -0,0 +1,52 @@
+package markdown
+
+import "testing"
+
+func TestSplitFrontMatter(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		frontMatter string
+		body        string
+		wantErr     bool
+	}{
+		{
+			name:        "front matter",
+			content:     "---\ntitle: Guide\n---\n# Guide\n",
+			frontMatter: "title: Guide\n",
+			body:        "# Guide\n",
+		},
+		{
+			name:        "byte order mark and dots",
+			content:     "\xef\xbb\xbf---\r\ntitle: Guide\r\n...\r\nText",
+			frontMatter: "title: Guide\r\n",
+			body:        "Text",
+		},
+		{
+			name:    "no front matter",
+			content: "# Guide\n---\n",
+			body:    "# Guide\n---\n",
+		},
+		{
+			name:    "unclosed front matter",
+			content: "---\ntitle: Guide\n",
+			body:    "---\ntitle: Guide\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			frontMatter, body, err := SplitFrontMatter([]byte(tt.content))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			if string(frontMatter) != tt.frontMatter {
+				t.Errorf("Expected front matter %q, got %q", tt.frontMatter, string(frontMatter))
+			}
+			if string(body) != tt.body {
+				t.Errorf("Expected body %q, got %q", tt.body, string(body))
+			}
+		})
+	}
+}
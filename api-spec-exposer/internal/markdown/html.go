@@ -0,0 +1,98 @@
+package markdown
+
+import (
+	"bytes"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer/html"
+)
+
+// converter renders CommonMark with the GitHub Flavored Markdown extensions. Raw HTML is passed through
+// and removed by the sanitizer along with unsafe links
+var converter = goldmark.New(
+	goldmark.WithExtensions(
+		extension.NewTable(extension.WithTableCellAlignMethod(extension.TableCellAlignAttribute)),
+		extension.Strikethrough,
+		extension.Linkify,
+		extension.TaskList,
+	),
+	goldmark.WithParserOptions(parser.WithAutoHeadingID()),
+	goldmark.WithRendererOptions(html.WithUnsafe()),
+)
+
+// ToHTML renders a Markdown document to sanitized HTML. CommonMark blocks and inlines are supported along with
+// GitHub Flavored Markdown tables, task lists, strikethrough and autolinks. The front matter is not rendered,
+// raw HTML is limited to a safe set of tags and attributes and links with unsafe URL schemes are removed
+func ToHTML(content []byte) string {
+	_, body, _ := SplitFrontMatter(content)
+
+	var buf bytes.Buffer
+	if err := converter.Convert(limitListNesting(body), &buf); err != nil {
+		return ""
+	}
+	return sanitizer.Sanitize(buf.String())
+}
+
+// maxListNesting is the deepest list nesting rendered, the parser slows down quadratically on deeper lists
+const maxListNesting = 100
+
+// limitListNesting escapes list markers nested deeper than maxListNesting at the start of a line,
+// e.g. in '- - - item', so that they are rendered as text of the deepest list item
+func limitListNesting(content []byte) []byte {
+	var result []byte
+	copied := 0
+	for lineStart := 0; lineStart < len(content); {
+		lineEnd := len(content)
+		if i := bytes.IndexByte(content[lineStart:], '\n'); i >= 0 {
+			lineEnd = lineStart + i + 1
+		}
+		line := content[lineStart:lineEnd]
+
+		depth, pos := 0, 0
+		for {
+			for pos < len(line) && (line[pos] == ' ' || line[pos] == '\t' || line[pos] == '>') {
+				pos++
+			}
+			end := listMarkerEnd(line, pos)
+			if end < 0 {
+				break
+			}
+			if depth++; depth > maxListNesting {
+				result = append(result, content[copied:lineStart+pos]...)
+				result = append(result, '\\')
+				copied = lineStart + pos
+				break
+			}
+			pos = end
+		}
+		lineStart = lineEnd
+	}
+	if result == nil {
+		return content
+	}
+	return append(result, content[copied:]...)
+}
+
+// listMarkerEnd returns the position after the bullet or ordered list marker starting at pos and the following space,
+// -1 when there is no list marker
+func listMarkerEnd(line []byte, pos int) int {
+	end := pos
+	switch {
+	case end < len(line) && (line[end] == '-' || line[end] == '*' || line[end] == '+'):
+		end++
+	default:
+		for end < len(line) && end-pos < 9 && line[end] >= '0' && line[end] <= '9' {
+			end++
+		}
+		if end == pos || end >= len(line) || (line[end] != '.' && line[end] != ')') {
+			return -1
+		}
+		end++
+	}
+	if end >= len(line) || (line[end] != ' ' && line[end] != '\t') {
+		return -1
+	}
+	return end + 1
+}
@@ -0,0 +1,130 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestToHTML(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{
+			name:     "headings",
+			content:  "# Hello *world*\n\nSetext\n---\n\n## Hello world ##\n",
+			expected: "<h1 id=\"hello-world\">Hello <em>world</em></h1>\n<h2 id=\"setext\">Setext</h2>\n<h2 id=\"hello-world-1\">Hello world</h2>\n",
+		},
+		{
+			name:     "front matter",
+			content:  "---\ntitle: Guide\n---\nText\n",
+			expected: "<p>Text</p>\n",
+		},
+		{
+			name:     "emphasis and code",
+			content:  "Some **bold**, _em_, ~~old~~ and `a < b` in snake_case_word\n",
+			expected: "<p>Some <strong>bold</strong>, <em>em</em>, <del>old</del> and <code>a &lt; b</code> in snake_case_word</p>\n",
+		},
+		{
+			name:     "links and images",
+			content:  "[link](http://a.b \"t\") ![img](x.png) [ref] <https://c.d> www.e.f\n\n[ref]: ./ref.md\n",
+			expected: "<p><a href=\"http://a.b\" title=\"t\">link</a> <img src=\"x.png\" alt=\"img\"> <a href=\"./ref.md\">ref</a> <a href=\"https://c.d\">https://c.d</a> <a href=\"http://www.e.f\">www.e.f</a></p>\n",
+		},
+		{
+			name:     "unsafe links",
+			content:  "[bad](javascript:alert(1)) ![bad](data:text/html,x)\n",
+			expected: "<p>bad <img alt=\"bad\"></p>\n",
+		},
+		{
+			name:     "fenced code",
+			content:  "```go\nfmt.Println(\"<\")\n```\n",
+			expected: "<pre><code class=\"language-go\">fmt.Println(&#34;&lt;&#34;)\n</code></pre>\n",
+		},
+		{
+			name:     "table",
+			content:  "| a | b |\n|:-|-:|\n| 1 | `\\|` |\n",
+			expected: "<table>\n<thead>\n<tr>\n<th align=\"left\">a</th>\n<th align=\"right\">b</th>\n</tr>\n</thead>\n<tbody>\n<tr>\n<td align=\"left\">1</td>\n<td align=\"right\"><code>|</code></td>\n</tr>\n</tbody>\n</table>\n",
+		},
+		{
+			name:     "task list",
+			content:  "- [x] done\n- item\n",
+			expected: "<ul>\n<li><input checked=\"\" disabled=\"\" type=\"checkbox\"> done</li>\n<li>item</li>\n</ul>\n",
+		},
+		{
+			name:     "blockquote",
+			content:  "> quote\n",
+			expected: "<blockquote>\n<p>quote</p>\n</blockquote>\n",
+		},
+		{
+			name:     "raw html",
+			content:  "<script>alert(1)</script>\n\nText <b onclick=\"x()\">bold</b> <img src=\"javascript:x\">\n",
+			expected: "\n<p>Text <b>bold</b> </p>\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ToHTML([]byte(tt.content))
+			if result != tt.expected {
+				t.Errorf("Expected HTML %q, got %q", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestLimitListNesting(t *testing.T) {
+	content := strings.Repeat("- ", maxListNesting+2) + "item\n> 1. text\n"
+	expected := strings.Repeat("- ", maxListNesting) + "\\- - item\n> 1. text\n"
+	if result := string(limitListNesting([]byte(content))); result != expected {
+		t.Errorf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestPage(t *testing.T) {
+	page := Page("<Guide>", "body { color: red; }</style><script>", "<p>Text</p>\n")
+
+	if !strings.Contains(page, "<title>&lt;Guide&gt;</title>") {
+		t.Errorf("Expected escaped title, got %q", page)
+	}
+	if !strings.Contains(page, `body { color: red; }<\/style><script>`) {
+		t.Errorf("Expected stylesheet not to close the style element, got %q", page)
+	}
+	if !strings.Contains(page, "<article class=\"markdown-body\">\n<p>Text</p>\n</article>") {
+		t.Errorf("Expected body inside the article, got %q", page)
+	}
+	if !strings.Contains(Page("Guide", "", ""), ".markdown-body {") {
+		t.Error("Expected default stylesheet")
+	}
+}
+
+func TestToHTMLLargeDocuments(t *testing.T) {
+	var nestedList strings.Builder
+	for i := 0; i < 500; i++ {
+		nestedList.WriteString(strings.Repeat("  ", i) + "- item\n")
+	}
+
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"long paragraph", strings.Repeat("line of text\n", 100000)},
+		{"nested list", nestedList.String()},
+		{"nested list markers", strings.Repeat("- ", 50000) + "item\n"},
+		{"nested blockquotes", strings.Repeat(">", 10000) + " quote\n"},
+		{"unclosed brackets", strings.Repeat("[", 50000) + "text"},
+		{"unclosed emphasis", strings.Repeat("*a _b ", 50000)},
+		{"unclosed html tags", strings.Repeat("<b x=\"", 50000)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := time.Now()
+			ToHTML([]byte(tt.content))
+			if elapsed := time.Since(start); elapsed > 2*time.Second {
+				t.Errorf("Expected rendering within 2s, took %v", elapsed)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package markdown
+
+import (
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	referenceDefinitionPattern = regexp.MustCompile(`^ {0,3}\[[^\]]+\]:[ \t]*(<[^>\n]*>|\S+)`)
+	inlineLinkPattern          = regexp.MustCompile(`\]\([ \t]*(<[^>\n]*>|[^\s()]+)`)
+	htmlLinkPattern            = regexp.MustCompile(`(?i)\b(?:src|href)[ \t]*=[ \t]*(?:"([^"\n]*)"|'([^'\n]*)')`)
+	schemePattern              = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9+.-]*:`)
+)
+
+// Link is a link or image destination found in a Markdown document
+type Link struct {
+	Start       int // byte offset of the destination in the document
+	End         int
+	Destination string
+}
+
+// Links returns destinations of inline links and images, link reference definitions and HTML src and href attributes
+// in document order. Fenced code blocks and code spans are skipped
+func Links(content []byte) []Link {
+	var links []Link
+
+	fence := ""
+	offset := 0
+	for _, line := range strings.SplitAfter(string(content), "\n") {
+		lineStart := offset
+		offset += len(line)
+
+		trimmed := strings.TrimSpace(line)
+		if fence != "" {
+			if strings.HasPrefix(trimmed, fence) {
+				fence = ""
+			}
+			continue
+		}
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			fence = trimmed[:3]
+			continue
+		}
+
+		masked := maskCodeSpans(line)
+		if match := referenceDefinitionPattern.FindStringSubmatchIndex(masked); match != nil {
+			links = append(links, newLink(line, lineStart, match[2], match[3]))
+			continue
+		}
+		var found []Link
+		for _, match := range inlineLinkPattern.FindAllStringSubmatchIndex(masked, -1) {
+			found = append(found, newLink(line, lineStart, match[2], match[3]))
+		}
+		for _, match := range htmlLinkPattern.FindAllStringSubmatchIndex(masked, -1) {
+			if match[2] >= 0 {
+				found = append(found, newLink(line, lineStart, match[2], match[3]))
+			} else {
+				found = append(found, newLink(line, lineStart, match[4], match[5]))
+			}
+		}
+		sort.Slice(found, func(i, j int) bool { return found[i].Start < found[j].Start })
+		links = append(links, found...)
+	}
+
+	return links
+}
+
+// RewriteLinks replaces link destinations for which rewrite returns true, the rest of the document is kept as is
+func RewriteLinks(content []byte, rewrite func(destination string) (string, bool)) []byte {
+	var sb strings.Builder
+	last := 0
+	for _, link := range Links(content) {
+		replacement, ok := rewrite(link.Destination)
+		if !ok {
+			continue
+		}
+		sb.Write(content[last:link.Start])
+		sb.WriteString(replacement)
+		last = link.End
+	}
+	sb.Write(content[last:])
+	return []byte(sb.String())
+}
+
+// SplitDestination splits a relative link destination into the unescaped file path and the query and fragment suffix.
+// It returns false for absolute URLs, absolute paths and links to anchors of the same document
+func SplitDestination(destination string) (string, string, bool) {
+	if destination == "" || strings.HasPrefix(destination, "#") || strings.HasPrefix(destination, "/") ||
+		strings.HasPrefix(destination, `\`) || schemePattern.MatchString(destination) {
+		return "", "", false
+	}
+
+	path := destination
+	suffix := ""
+	if index := strings.IndexAny(destination, "?#"); index >= 0 {
+		path = destination[:index]
+		suffix = destination[index:]
+	}
+	unescaped, err := url.PathUnescape(path)
+	if err != nil || unescaped == "" {
+		return "", "", false
+	}
+	return unescaped, suffix, true
+}
+
+func newLink(line string, lineStart int, start int, end int) Link {
+	destination := line[start:end]
+	if strings.HasPrefix(destination, "<") && strings.HasSuffix(destination, ">") {
+		start++
+		end--
+		destination = line[start:end]
+	}
+	return Link{Start: lineStart + start, End: lineStart + end, Destination: destination}
+}
+
+// maskCodeSpans replaces the content of code spans with spaces keeping byte offsets
+func maskCodeSpans(line string) string {
+	masked := []byte(line)
+	for i := 0; i < len(masked); {
+		if masked[i] != '`' {
+			i++
+			continue
+		}
+		run := 1
+		for i+run < len(masked) && masked[i+run] == '`' {
+			run++
+		}
+		delimiter := strings.Repeat("`", run)
+		closing := strings.Index(line[i+run:], delimiter)
+		if closing < 0 {
+			i += run
+			continue
+		}
+		end := i + run + closing + run
+		for j := i; j < end; j++ {
+			masked[j] = ' '
+		}
+		i = end
+	}
+	return string(masked)
+}
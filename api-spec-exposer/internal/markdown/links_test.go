@@ -0,0 +1,82 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLinks(t *testing.T) {
+	content := "# Guide\n\n" +
+		"See ![flow](./images/flow.png \"Flow\") and [other page](other.md#setup).\n" +
+		"External [site](https://example.com) and [anchor](#top).\n" +
+		"Inline `[code](ignored.md)` span.\n" +
+		"```\n[fenced](ignored.md)\n```\n" +
+		"<img src=\"diagrams/arch.svg\" alt=\"arch\"> <a href='files/spec.yaml'>spec</a>\n" +
+		"[ref]: <docs/with space.md> \"Title\"\n" +
+		"![ref image][logo]\n"
+
+	var destinations []string
+	for _, link := range Links([]byte(content)) {
+		if content[link.Start:link.End] != link.Destination {
+			t.Errorf("Expected link offsets to match destination %q, got %q", link.Destination, content[link.Start:link.End])
+		}
+		destinations = append(destinations, link.Destination)
+	}
+
+	expected := []string{
+		"./images/flow.png",
+		"other.md#setup",
+		"https://example.com",
+		"#top",
+		"diagrams/arch.svg",
+		"files/spec.yaml",
+		"docs/with space.md",
+	}
+	if strings.Join(destinations, ",") != strings.Join(expected, ",") {
+		t.Errorf("Expected links %v, got %v", expected, destinations)
+	}
+}
+
+func TestRewriteLinks(t *testing.T) {
+	content := "![flow](images/flow.png) [site](https://example.com) <img src=\"images/arch.svg\">\n"
+
+	result := RewriteLinks([]byte(content), func(destination string) (string, bool) {
+		if strings.HasPrefix(destination, "images/") {
+			return "guide-md/assets/" + destination, true
+		}
+		return "", false
+	})
+
+	expected := "![flow](guide-md/assets/images/flow.png) [site](https://example.com) <img src=\"guide-md/assets/images/arch.svg\">\n"
+	if string(result) != expected {
+		t.Errorf("Expected %q, got %q", expected, string(result))
+	}
+}
+
+func TestSplitDestination(t *testing.T) {
+	tests := []struct {
+		destination    string
+		expectedPath   string
+		expectedSuffix string
+		expectedOk     bool
+	}{
+		{"images/flow.png", "images/flow.png", "", true},
+		{"./other.md#setup", "./other.md", "#setup", true},
+		{"../shared/logo%20dark.png?raw=1", "../shared/logo dark.png", "?raw=1", true},
+		{"#top", "", "", false},
+		{"/absolute/path.png", "", "", false},
+		{"https://example.com/image.png", "", "", false},
+		{"mailto:team@example.com", "", "", false},
+		{"//cdn.example.com/image.png", "", "", false},
+		{"", "", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.destination, func(t *testing.T) {
+			path, suffix, ok := SplitDestination(tt.destination)
+			if path != tt.expectedPath || suffix != tt.expectedSuffix || ok != tt.expectedOk {
+				t.Errorf("Expected (%q, %q, %v), got (%q, %q, %v)", tt.expectedPath, tt.expectedSuffix, tt.expectedOk, path, suffix, ok)
+			}
+		})
+	}
+}
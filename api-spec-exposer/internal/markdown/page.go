@@ -0,0 +1,45 @@
+package markdown
+
+import (
+	"html"
+	"strings"
+)
+
+// DefaultStylesheet is the CSS of rendered pages when no stylesheet is configured
+const DefaultStylesheet = `body { margin: 0; background: #ffffff; color: #1f2328; }
+.markdown-body { box-sizing: border-box; max-width: 980px; margin: 0 auto; padding: 32px; font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; font-size: 16px; line-height: 1.5; word-wrap: break-word; }
+.markdown-body h1, .markdown-body h2 { padding-bottom: .3em; border-bottom: 1px solid #d1d9e0; }
+.markdown-body h1, .markdown-body h2, .markdown-body h3, .markdown-body h4, .markdown-body h5, .markdown-body h6 { margin: 24px 0 16px; font-weight: 600; line-height: 1.25; }
+.markdown-body p, .markdown-body blockquote, .markdown-body ul, .markdown-body ol, .markdown-body table, .markdown-body pre { margin: 0 0 16px; }
+.markdown-body a { color: #0969da; text-decoration: none; }
+.markdown-body a:hover { text-decoration: underline; }
+.markdown-body code { padding: .2em .4em; border-radius: 6px; background: #eff1f3; font-family: ui-monospace, SFMono-Regular, Menlo, Consolas, monospace; font-size: 85%; }
+.markdown-body pre { padding: 16px; overflow: auto; border-radius: 6px; background: #f6f8fa; line-height: 1.45; }
+.markdown-body pre code { padding: 0; background: transparent; font-size: 100%; }
+.markdown-body blockquote { padding: 0 1em; border-left: .25em solid #d1d9e0; color: #59636e; }
+.markdown-body table { display: block; width: max-content; max-width: 100%; overflow: auto; border-collapse: collapse; }
+.markdown-body th, .markdown-body td { padding: 6px 13px; border: 1px solid #d1d9e0; }
+.markdown-body tr:nth-child(2n) { background: #f6f8fa; }
+.markdown-body img { max-width: 100%; }
+.markdown-body hr { height: .25em; margin: 24px 0; border: 0; background: #d1d9e0; }
+`
+
+// Page wraps rendered HTML into a standalone document with the given title and stylesheet
+func Page(title string, stylesheet string, body string) string {
+	if stylesheet == "" {
+		stylesheet = DefaultStylesheet
+	}
+	// A stylesheet cannot close the style element
+	stylesheet = strings.ReplaceAll(stylesheet, "</", `<\/`)
+
+	var sb strings.Builder
+	sb.WriteString("<!DOCTYPE html>\n<html>\n<head>\n")
+	sb.WriteString("<meta charset=\"utf-8\">\n")
+	sb.WriteString("<meta name=\"viewport\" content=\"width=device-width, initial-scale=1\">\n")
+	sb.WriteString("<title>" + html.EscapeString(title) + "</title>\n")
+	sb.WriteString("<style>\n" + stylesheet + "</style>\n")
+	sb.WriteString("</head>\n<body>\n<article class=\"markdown-body\">\n")
+	sb.WriteString(body)
+	sb.WriteString("</article>\n</body>\n</html>\n")
+	return sb.String()
+}
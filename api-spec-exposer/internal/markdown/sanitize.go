@@ -0,0 +1,80 @@
+package markdown
+
+import (
+	"regexp"
+
+	"github.com/microcosm-cc/bluemonday"
+)
+
+// allowedTags lists HTML tags kept in rendered documents along with their allowed attributes
+var allowedTags = map[string][]string{
+	"a":          {"href", "title", "name", "id"},
+	"abbr":       {"title"},
+	"b":          nil,
+	"blockquote": nil,
+	"br":         nil,
+	"code":       nil,
+	"dd":         nil,
+	"del":        nil,
+	"details":    {"open"},
+	"div":        {"align"},
+	"dl":         nil,
+	"dt":         nil,
+	"em":         nil,
+	"h1":         {"id", "align"},
+	"h2":         {"id", "align"},
+	"h3":         {"id", "align"},
+	"h4":         {"id", "align"},
+	"h5":         {"id", "align"},
+	"h6":         {"id", "align"},
+	"hr":         nil,
+	"i":          nil,
+	"img":        {"src", "alt", "title", "width", "height", "align"},
+	"ins":        nil,
+	"kbd":        nil,
+	"li":         nil,
+	"ol":         {"start"},
+	"p":          {"align"},
+	"picture":    nil,
+	"pre":        nil,
+	"s":          nil,
+	"source":     {"srcset", "media", "type"},
+	"span":       nil,
+	"strong":     nil,
+	"sub":        nil,
+	"summary":    nil,
+	"sup":        nil,
+	"table":      nil,
+	"tbody":      nil,
+	"td":         {"align", "colspan", "rowspan"},
+	"th":         {"align", "colspan", "rowspan"},
+	"thead":      nil,
+	"tr":         nil,
+	"ul":         nil,
+}
+
+// allowedSchemes are URL schemes allowed in links and images, relative URLs are allowed as well
+var allowedSchemes = []string{"http", "https", "mailto", "tel"}
+
+// sanitizer keeps allowed tags and attributes of rendered documents, other tags are dropped along with
+// the content of script and style elements, and URLs with other schemes are removed
+var sanitizer = newSanitizer()
+
+func newSanitizer() *bluemonday.Policy {
+	policy := bluemonday.NewPolicy()
+	for tag, attributes := range allowedTags {
+		policy.AllowElements(tag)
+		if len(attributes) > 0 {
+			policy.AllowAttrs(attributes...).OnElements(tag)
+		}
+	}
+	policy.AllowURLSchemes(allowedSchemes...)
+	policy.AllowRelativeURLs(true)
+	policy.RequireParseableURLs(true)
+
+	// Fenced code languages and task list checkboxes produced by the renderer
+	policy.AllowAttrs("class").Matching(regexp.MustCompile(`^language-[\w.+#-]+$`)).OnElements("code")
+	policy.AllowAttrs("type").Matching(regexp.MustCompile(`^checkbox$`)).OnElements("input")
+	policy.AllowAttrs("checked", "disabled").OnElements("input")
+	return policy
+}
@@ -0,0 +1,129 @@
+package odata
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"sort"
+	"strings"
+)
+
+// Namespaces of the EDMX wrapper of CSDL XML documents
+const (
+	NamespaceEDMX4 = "http://docs.oasis-open.org/odata/ns/edmx"
+	NamespaceEDMX1 = "http://schemas.microsoft.com/ado/2007/06/edmx"
+)
+
+// Metadata describes an OData CSDL document
+type Metadata struct {
+	Version         string
+	Namespaces      []string // namespaces of the schemas
+	EntityContainer string   // qualified name of the entity container
+	EntitySetCount  int
+}
+
+// ParseXML reads a CSDL XML document with a streaming decoder.
+// It returns nil without an error when the root element is not 'edmx:Edmx'
+func ParseXML(content []byte) (*Metadata, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(content))
+	decoder.CharsetReader = func(charset string, input io.Reader) (io.Reader, error) {
+		return input, nil
+	}
+
+	var metadata *Metadata
+	var parents []string
+	namespace := ""
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch element := token.(type) {
+		case xml.StartElement:
+			if metadata == nil {
+				if element.Name.Local != "Edmx" || (element.Name.Space != NamespaceEDMX4 && element.Name.Space != NamespaceEDMX1) {
+					return nil, nil
+				}
+				metadata = &Metadata{Version: attributeValue(element, "Version")}
+			}
+			switch element.Name.Local {
+			case "Schema":
+				namespace = attributeValue(element, "Namespace")
+				if namespace != "" {
+					metadata.Namespaces = append(metadata.Namespaces, namespace)
+				}
+			case "EntityContainer":
+				if metadata.EntityContainer == "" {
+					metadata.EntityContainer = qualifiedName(namespace, attributeValue(element, "Name"))
+				}
+			case "EntitySet":
+				if len(parents) > 0 && parents[len(parents)-1] == "EntityContainer" {
+					metadata.EntitySetCount++
+				}
+			}
+			parents = append(parents, element.Name.Local)
+		case xml.EndElement:
+			parents = parents[:len(parents)-1]
+		}
+	}
+
+	if metadata == nil {
+		return nil, errors.New("document has no root element")
+	}
+	return metadata, nil
+}
+
+// FromJSON reads a CSDL JSON document, nil if the document does not declare '$Version'
+func FromJSON(data map[string]interface{}) *Metadata {
+	version, ok := data["$Version"].(string)
+	if !ok || version == "" {
+		return nil
+	}
+
+	metadata := &Metadata{Version: version}
+	metadata.EntityContainer, _ = data["$EntityContainer"].(string)
+
+	for key, value := range data {
+		schema, ok := value.(map[string]interface{})
+		if !ok || strings.HasPrefix(key, "$") {
+			continue
+		}
+		metadata.Namespaces = append(metadata.Namespaces, key)
+
+		for name, member := range schema {
+			container, ok := member.(map[string]interface{})
+			if !ok || container["$Kind"] != "EntityContainer" || qualifiedName(key, name) != metadata.EntityContainer {
+				continue
+			}
+			for _, item := range container {
+				if entitySet, ok := item.(map[string]interface{}); ok && entitySet["$Collection"] == true {
+					metadata.EntitySetCount++
+				}
+			}
+		}
+	}
+	sort.Strings(metadata.Namespaces)
+
+	return metadata
+}
+
+func qualifiedName(namespace string, name string) string {
+	if namespace == "" || name == "" {
+		return name
+	}
+	return namespace + "." + name
+}
+
+func attributeValue(element xml.StartElement, name string) string {
+	for _, attribute := range element.Attr {
+		if attribute.Name.Space == "" && attribute.Name.Local == name {
+			return attribute.Value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,108 @@
+package odata
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestParseXML(t *testing.T) {
+	content := `<?xml version="1.0" encoding="utf-8"?>
+<edmx:Edmx Version="4.0" xmlns:edmx="http://docs.oasis-open.org/odata/ns/edmx">
+  <edmx:DataServices>
+    <Schema Namespace="Example.Model" xmlns="http://docs.oasis-open.org/odata/ns/edm">
+      <EntityType Name="Order"><Key><PropertyRef Name="Id"/></Key><Property Name="Id" Type="Edm.Int32"/></EntityType>
+    </Schema>
+    <Schema Namespace="Example.Service" xmlns="http://docs.oasis-open.org/odata/ns/edm">
+      <EntityContainer Name="Container">
+        <EntitySet Name="Orders" EntityType="Example.Model.Order"/>
+        <EntitySet Name="Customers" EntityType="Example.Model.Customer"/>
+        <FunctionImport Name="TopOrders" Function="Example.Model.TopOrders"/>
+      </EntityContainer>
+    </Schema>
+  </edmx:DataServices>
+</edmx:Edmx>`
+
+	metadata, err := ParseXML([]byte(content))
+	if err != nil || metadata == nil {
+		t.Fatalf("Expected metadata, got error: %v", err)
+	}
+	if metadata.Version != "4.0" {
+		t.Errorf("Expected version 4.0, got %s", metadata.Version)
+	}
+	if strings.Join(metadata.Namespaces, ",") != "Example.Model,Example.Service" {
+		t.Errorf("Expected schema namespaces, got %v", metadata.Namespaces)
+	}
+	if metadata.EntityContainer != "Example.Service.Container" {
+		t.Errorf("Expected entity container 'Example.Service.Container', got '%s'", metadata.EntityContainer)
+	}
+	if metadata.EntitySetCount != 2 {
+		t.Errorf("Expected 2 entity sets, got %d", metadata.EntitySetCount)
+	}
+}
+
+func TestParseXMLOtherDocuments(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr bool
+	}{
+		{"other root", `<Edmx xmlns="http://example.com/other"/>`, false},
+		{"wsdl", `<definitions xmlns="http://schemas.xmlsoap.org/wsdl/"/>`, false},
+		{"malformed", `<edmx:Edmx xmlns:edmx="http://docs.oasis-open.org/odata/ns/edmx">`, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metadata, err := ParseXML([]byte(tt.content))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			if metadata != nil {
+				t.Errorf("Expected no metadata, got %v", metadata)
+			}
+		})
+	}
+}
+
+func TestFromJSON(t *testing.T) {
+	content := `{
+  "$Version": "4.01",
+  "$EntityContainer": "Example.Service.Container",
+  "$Reference": {"https://example.com/vocabularies/Core.json": {"$Include": [{"$Namespace": "Org.OData.Core.V1"}]}},
+  "Example.Model": {"Order": {"$Kind": "EntityType", "$Key": ["Id"], "Id": {"$Type": "Edm.Int32"}}},
+  "Example.Service": {
+    "Container": {
+      "$Kind": "EntityContainer",
+      "Orders": {"$Collection": true, "$Type": "Example.Model.Order"},
+      "Me": {"$Type": "Example.Model.Customer"}
+    }
+  }
+}`
+
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(content), &data); err != nil {
+		t.Fatalf("Failed to parse test content: %v", err)
+	}
+
+	metadata := FromJSON(data)
+	if metadata == nil {
+		t.Fatal("Expected metadata")
+	}
+	if metadata.Version != "4.01" {
+		t.Errorf("Expected version 4.01, got %s", metadata.Version)
+	}
+	if strings.Join(metadata.Namespaces, ",") != "Example.Model,Example.Service" {
+		t.Errorf("Expected schema namespaces, got %v", metadata.Namespaces)
+	}
+	if metadata.EntityContainer != "Example.Service.Container" {
+		t.Errorf("Expected entity container 'Example.Service.Container', got '%s'", metadata.EntityContainer)
+	}
+	if metadata.EntitySetCount != 1 {
+		t.Errorf("Expected 1 entity set, got %d", metadata.EntitySetCount)
+	}
+
+	if FromJSON(map[string]interface{}{"openapi": "3.0.0"}) != nil {
+		t.Error("Expected no metadata for documents without $Version")
+	}
+}
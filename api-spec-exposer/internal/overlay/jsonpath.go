@@ -0,0 +1,364 @@
+package overlay
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/document"
+)
+
+// Path is the location of a node within a document, a sequence of object keys (string) and array indexes (int)
+type Path []interface{}
+
+type segmentKind int
+
+const (
+	segmentName segmentKind = iota
+	segmentIndex
+	segmentWildcard
+	segmentFilter
+)
+
+// segment is a selector of a JSONPath expression, applied to the children of the matched nodes
+// or, for recursive descent, to all their descendants
+type segment struct {
+	kind      segmentKind
+	name      string
+	index     int
+	filter    *filter
+	recursive bool
+}
+
+// filter is a '?(@.a.b)' existence test or a '?(@.a.b == value)' comparison
+type filter struct {
+	path     []string
+	operator string
+	value    interface{}
+}
+
+// Select returns the paths of the nodes matched by a JSONPath expression. The supported subset covers
+// child names ('.name', ['name']), indexes ('[0]', '[-1]'), wildcards ('.*', '[*]'), recursive descent ('..name')
+// and filters comparing a member of the current node with a literal ('[?(@.name == 'value')]', '[?@.deprecated]')
+func Select(document interface{}, expression string) ([]Path, error) {
+	segments, err := parse(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	type match struct {
+		path  Path
+		value interface{}
+	}
+	matches := []match{{path: Path{}, value: document}}
+	for _, seg := range segments {
+		var next []match
+		seen := make(map[string]bool)
+		add := func(path Path, value interface{}) {
+			key := fmt.Sprintf("%#v", path)
+			if !seen[key] {
+				seen[key] = true
+				next = append(next, match{path: path, value: value})
+			}
+		}
+
+		for _, current := range matches {
+			candidates := []match{current}
+			if seg.recursive {
+				candidates = nil
+				walk(current.path, current.value, func(path Path, value interface{}) {
+					candidates = append(candidates, match{path: path, value: value})
+				})
+			}
+			for _, candidate := range candidates {
+				forEachChild(candidate.value, func(key interface{}, value interface{}, position int, size int) {
+					if seg.matches(key, value, position, size) {
+						add(appendPath(candidate.path, key), value)
+					}
+				})
+			}
+		}
+		matches = next
+	}
+
+	paths := make([]Path, len(matches))
+	for i, m := range matches {
+		paths[i] = m.path
+	}
+	return paths, nil
+}
+
+func (s segment) matches(key interface{}, value interface{}, position int, size int) bool {
+	switch s.kind {
+	case segmentName:
+		name, ok := key.(string)
+		return ok && name == s.name
+	case segmentIndex:
+		if _, ok := key.(int); !ok {
+			return false
+		}
+		index := s.index
+		if index < 0 {
+			index += size
+		}
+		return position == index
+	case segmentWildcard:
+		return true
+	case segmentFilter:
+		return s.filter.matches(value)
+	}
+	return false
+}
+
+func (f *filter) matches(value interface{}) bool {
+	current := value
+	for _, name := range f.path {
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		if current, ok = object[name]; !ok {
+			return false
+		}
+	}
+
+	switch f.operator {
+	case "":
+		return current != nil
+	case "==":
+		return valuesEqual(current, f.value)
+	case "!=":
+		return !valuesEqual(current, f.value)
+	}
+	return false
+}
+
+// forEachChild calls fn for every member of an object in key order and every element of an array
+func forEachChild(value interface{}, fn func(key interface{}, value interface{}, position int, size int)) {
+	switch node := value.(type) {
+	case map[string]interface{}:
+		keys := document.SortedKeys(node)
+		for i, key := range keys {
+			fn(key, node[key], i, len(keys))
+		}
+	case []interface{}:
+		for i, item := range node {
+			fn(i, item, i, len(node))
+		}
+	}
+}
+
+// walk calls fn for a node and all its descendants
+func walk(path Path, value interface{}, fn func(path Path, value interface{})) {
+	fn(path, value)
+	forEachChild(value, func(key interface{}, child interface{}, _ int, _ int) {
+		walk(appendPath(path, key), child, fn)
+	})
+}
+
+func appendPath(path Path, key interface{}) Path {
+	result := make(Path, len(path), len(path)+1)
+	copy(result, path)
+	return append(result, key)
+}
+
+func parse(expression string) ([]segment, error) {
+	if !strings.HasPrefix(expression, "$") {
+		return nil, fmt.Errorf("JSONPath expression '%s' must start with '$'", expression)
+	}
+
+	var segments []segment
+	rest := expression[1:]
+	for rest != "" {
+		recursive := false
+		switch {
+		case strings.HasPrefix(rest, ".."):
+			recursive = true
+			rest = rest[2:]
+		case rest[0] == '.':
+			rest = rest[1:]
+		case rest[0] != '[':
+			return nil, fmt.Errorf("unexpected '%s' in JSONPath expression '%s'", rest, expression)
+		}
+
+		var seg segment
+		var err error
+		if strings.HasPrefix(rest, "[") {
+			seg, rest, err = parseBracket(rest)
+			if err != nil {
+				return nil, fmt.Errorf("%v in JSONPath expression '%s'", err, expression)
+			}
+		} else {
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			name := rest[:end]
+			rest = rest[end:]
+			if name == "" {
+				return nil, fmt.Errorf("empty name in JSONPath expression '%s'", expression)
+			}
+			if name == "*" {
+				seg = segment{kind: segmentWildcard}
+			} else {
+				seg = segment{kind: segmentName, name: name}
+			}
+		}
+		seg.recursive = recursive
+		segments = append(segments, seg)
+	}
+	return segments, nil
+}
+
+// parseBracket parses a bracketed selector and returns it along with the rest of the expression
+func parseBracket(expression string) (segment, string, error) {
+	end := closingBracket(expression)
+	if end < 0 {
+		return segment{}, "", fmt.Errorf("unclosed '['")
+	}
+	content := strings.TrimSpace(expression[1:end])
+	rest := expression[end+1:]
+
+	switch {
+	case content == "*":
+		return segment{kind: segmentWildcard}, rest, nil
+	case strings.HasPrefix(content, "?"):
+		f, err := parseFilter(strings.TrimSpace(content[1:]))
+		if err != nil {
+			return segment{}, "", err
+		}
+		return segment{kind: segmentFilter, filter: f}, rest, nil
+	case strings.HasPrefix(content, "'") || strings.HasPrefix(content, "\""):
+		name, err := unquote(content)
+		if err != nil {
+			return segment{}, "", err
+		}
+		return segment{kind: segmentName, name: name}, rest, nil
+	default:
+		index, err := strconv.Atoi(content)
+		if err != nil {
+			return segment{}, "", fmt.Errorf("unsupported selector '[%s]'", content)
+		}
+		return segment{kind: segmentIndex, index: index}, rest, nil
+	}
+}
+
+// closingBracket returns the index of the ']' closing the '[' at the start of the expression, skipping quoted strings
+func closingBracket(expression string) int {
+	var quote byte
+	depth := 0
+	for i := 0; i < len(expression); i++ {
+		c := expression[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '[':
+			depth++
+		case c == ']':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func parseFilter(expression string) (*filter, error) {
+	if strings.HasPrefix(expression, "(") && strings.HasSuffix(expression, ")") {
+		expression = strings.TrimSpace(expression[1 : len(expression)-1])
+	}
+	if !strings.HasPrefix(expression, "@") {
+		return nil, fmt.Errorf("unsupported filter '%s'", expression)
+	}
+
+	f := &filter{}
+	operand := expression[1:]
+	for _, operator := range []string{"==", "!="} {
+		if index := strings.Index(operand, operator); index >= 0 {
+			f.operator = operator
+			value, err := parseLiteral(strings.TrimSpace(operand[index+len(operator):]))
+			if err != nil {
+				return nil, err
+			}
+			f.value = value
+			operand = strings.TrimSpace(operand[:index])
+			break
+		}
+	}
+
+	for _, name := range strings.Split(operand, ".") {
+		if name == "" {
+			continue
+		}
+		f.path = append(f.path, name)
+	}
+	if len(f.path) == 0 {
+		return nil, fmt.Errorf("unsupported filter '%s'", expression)
+	}
+	return f, nil
+}
+
+func parseLiteral(literal string) (interface{}, error) {
+	switch literal {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+	if strings.HasPrefix(literal, "'") || strings.HasPrefix(literal, "\"") {
+		return unquote(literal)
+	}
+	number, err := strconv.ParseFloat(literal, 64)
+	if err != nil {
+		return nil, fmt.Errorf("unsupported filter value '%s'", literal)
+	}
+	return number, nil
+}
+
+func unquote(literal string) (string, error) {
+	if len(literal) < 2 || literal[len(literal)-1] != literal[0] {
+		return "", fmt.Errorf("unterminated string '%s'", literal)
+	}
+	content := literal[1 : len(literal)-1]
+	var result strings.Builder
+	for i := 0; i < len(content); i++ {
+		if content[i] == '\\' && i+1 < len(content) {
+			i++
+		}
+		result.WriteByte(content[i])
+	}
+	return result.String(), nil
+}
+
+func valuesEqual(left interface{}, right interface{}) bool {
+	if leftNumber, ok := toFloat(left); ok {
+		rightNumber, ok := toFloat(right)
+		return ok && leftNumber == rightNumber
+	}
+	switch left.(type) {
+	case map[string]interface{}, []interface{}:
+		return false
+	}
+	return left == right
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch number := value.(type) {
+	case int:
+		return float64(number), true
+	case int64:
+		return float64(number), true
+	case float64:
+		return number, true
+	}
+	return 0, false
+}
@@ -0,0 +1,70 @@
+package overlay
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+const testDocument = `{
+  "info": {"title": "Pets", "x-internal": true},
+  "tags": [{"name": "pets"}, {"name": "admin", "x-internal": true}, {"name": "store"}],
+  "paths": {
+    "/pets": {
+      "get": {"operationId": "listPets", "tags": ["pets"], "responses": {"200": {"description": "OK"}}},
+      "post": {"operationId": "createPet", "deprecated": true, "x-rank": 2}
+    },
+    "/pets.{id}": {"get": {"operationId": "getPet", "x-rank": 1}}
+  }
+}`
+
+func TestSelect(t *testing.T) {
+	var document interface{}
+	if err := json.Unmarshal([]byte(testDocument), &document); err != nil {
+		t.Fatalf("Failed to parse test document: %v", err)
+	}
+
+	tests := []struct {
+		expression string
+		expected   string
+		wantErr    bool
+	}{
+		{"$", "[[]]", false},
+		{"$.info.title", "[[info title]]", false},
+		{"$['paths']['/pets.{id}'].get", "[[paths /pets.{id} get]]", false},
+		{"$.tags[1].name", "[[tags 1 name]]", false},
+		{"$.tags[-1]", "[[tags 2]]", false},
+		{"$.paths['/pets'].*", "[[paths /pets get] [paths /pets post]]", false},
+		{"$.tags[*].name", "[[tags 0 name] [tags 1 name] [tags 2 name]]", false},
+		{"$..operationId", "[[paths /pets get operationId] [paths /pets post operationId] [paths /pets.{id} get operationId]]", false},
+		{"$.tags[?(@.name == 'admin')]", "[[tags 1]]", false},
+		{"$.tags[?(@.name != \"admin\")].name", "[[tags 0 name] [tags 2 name]]", false},
+		{"$.tags[?@.x-internal]", "[[tags 1]]", false},
+		{"$.paths.*[?(@.deprecated == true)]", "[[paths /pets post]]", false},
+		{"$.paths.*.*[?(@.x-rank == 1)]", "[]", false},
+		{"$..[?(@.x-rank == 1)]", "[[paths /pets.{id} get]]", false},
+		{"$.missing.name", "[]", false},
+		{"info.title", "", true},
+		{"$.tags[", "", true},
+		{"$.tags[a]", "", true},
+		{"$.tags[?(size() > 1)]", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			paths, err := Select(document, tt.expression)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Expected error, got %v", paths)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result := fmt.Sprint(paths); result != tt.expected {
+				t.Errorf("Expected paths %s, got %s", tt.expected, result)
+			}
+		})
+	}
+}
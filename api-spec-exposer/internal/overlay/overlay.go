@@ -0,0 +1,167 @@
+package overlay
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Apply applies the actions of an Overlay 1.0 document to a decoded OpenAPI document in order and returns the result.
+// Objects of an 'update' are merged recursively into the selected nodes, arrays are appended and other values replaced;
+// nodes selected by a 'remove' action are deleted. Targets matching no nodes are skipped
+func Apply(document map[string]interface{}, overlay map[string]interface{}) (map[string]interface{}, error) {
+	actions, ok := overlay["actions"].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("'actions' field is missing or not an array")
+	}
+
+	var root interface{} = document
+	for i, item := range actions {
+		action, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("action %d is not an object", i)
+		}
+		target, _ := action["target"].(string)
+		if target == "" {
+			return nil, fmt.Errorf("action %d: 'target' field is required", i)
+		}
+		paths, err := Select(root, target)
+		if err != nil {
+			return nil, fmt.Errorf("action %d: %w", i, err)
+		}
+
+		if remove, _ := action["remove"].(bool); remove {
+			// Later array elements are removed first so that the indexes of the remaining paths stay valid
+			sort.Slice(paths, func(a, b int) bool { return comparePaths(paths[a], paths[b]) > 0 })
+			for _, path := range paths {
+				if len(path) == 0 {
+					return nil, fmt.Errorf("action %d: the document root cannot be removed", i)
+				}
+				root = removeAt(root, path)
+			}
+			continue
+		}
+
+		update, ok := action["update"]
+		if !ok {
+			continue
+		}
+		for _, path := range paths {
+			root = setAt(root, path, merge(getAt(root, path), update))
+		}
+	}
+
+	result, ok := root.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("document root is not an object")
+	}
+	return result, nil
+}
+
+// merge returns the target with the update applied
+func merge(target interface{}, update interface{}) interface{} {
+	switch node := target.(type) {
+	case map[string]interface{}:
+		members, ok := update.(map[string]interface{})
+		if !ok {
+			return deepCopy(update)
+		}
+		for key, value := range members {
+			if existing, ok := node[key]; ok {
+				node[key] = merge(existing, value)
+			} else {
+				node[key] = deepCopy(value)
+			}
+		}
+		return node
+	case []interface{}:
+		if items, ok := update.([]interface{}); ok {
+			return append(node, deepCopy(items).([]interface{})...)
+		}
+		return append(node, deepCopy(update))
+	default:
+		return deepCopy(update)
+	}
+}
+
+func getAt(root interface{}, path Path) interface{} {
+	current := root
+	for _, key := range path {
+		switch node := current.(type) {
+		case map[string]interface{}:
+			current = node[key.(string)]
+		case []interface{}:
+			current = node[key.(int)]
+		}
+	}
+	return current
+}
+
+// setAt stores a value at a path and returns the root, which changes when the path is empty
+func setAt(root interface{}, path Path, value interface{}) interface{} {
+	if len(path) == 0 {
+		return value
+	}
+	switch parent := getAt(root, path[:len(path)-1]).(type) {
+	case map[string]interface{}:
+		parent[path[len(path)-1].(string)] = value
+	case []interface{}:
+		parent[path[len(path)-1].(int)] = value
+	}
+	return root
+}
+
+// removeAt deletes the node at a non-empty path and returns the root
+func removeAt(root interface{}, path Path) interface{} {
+	parentPath := path[:len(path)-1]
+	switch parent := getAt(root, parentPath).(type) {
+	case map[string]interface{}:
+		delete(parent, path[len(path)-1].(string))
+	case []interface{}:
+		index := path[len(path)-1].(int)
+		remaining := append(parent[:index:index], parent[index+1:]...)
+		return setAt(root, parentPath, remaining)
+	}
+	return root
+}
+
+// comparePaths orders paths by their keys and indexes, a path goes after its prefixes
+func comparePaths(left Path, right Path) int {
+	for i := 0; i < len(left) && i < len(right); i++ {
+		switch l := left[i].(type) {
+		case int:
+			if r, ok := right[i].(int); ok && l != r {
+				if l < r {
+					return -1
+				}
+				return 1
+			}
+		case string:
+			if r, ok := right[i].(string); ok && l != r {
+				if l < r {
+					return -1
+				}
+				return 1
+			}
+		}
+	}
+	return len(left) - len(right)
+}
+
+func deepCopy(value interface{}) interface{} {
+	switch node := value.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(node))
+		for key, item := range node {
+			result[key] = deepCopy(item)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(node))
+		for i, item := range node {
+			result[i] = deepCopy(item)
+		}
+		return result
+	default:
+		return value
+	}
+}
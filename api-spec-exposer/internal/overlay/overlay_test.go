@@ -0,0 +1,88 @@
+package overlay
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestApply(t *testing.T) {
+	overlayContent := `{
+  "overlay": "1.0.0",
+  "info": {"title": "Public API", "version": "1.0.0"},
+  "actions": [
+    {"target": "$.info", "update": {"title": "Pets (public)", "contact": {"name": "API team"}}},
+    {"target": "$.tags", "update": {"name": "public"}},
+    {"target": "$.tags[?(@.x-internal == true)]", "remove": true},
+    {"target": "$.paths.*[?(@.deprecated == true)]", "remove": true},
+    {"target": "$.paths['/pets'].get", "update": {"tags": ["listing"], "responses": {"200": {"description": "Pets"}}}},
+    {"target": "$.servers", "update": [{"url": "https://example.com"}]},
+    {"target": "$.missing", "remove": true}
+  ]
+}`
+	expected := `{"info":{"contact":{"name":"API team"},"title":"Pets (public)","x-internal":true},"paths":{"/pets":{"get":{"operationId":"listPets","responses":{"200":{"description":"Pets"}},"tags":["pets","listing"]}},"/pets.{id}":{"get":{"operationId":"getPet","x-rank":1}}},"tags":[{"name":"pets"},{"name":"store"},{"name":"public"}]}`
+
+	var document, overlayDocument map[string]interface{}
+	if err := json.Unmarshal([]byte(testDocument), &document); err != nil {
+		t.Fatalf("Failed to parse test document: %v", err)
+	}
+	if err := json.Unmarshal([]byte(overlayContent), &overlayDocument); err != nil {
+		t.Fatalf("Failed to parse test overlay: %v", err)
+	}
+
+	result, err := Apply(document, overlayDocument)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	content, _ := json.Marshal(result)
+	if string(content) != expected {
+		t.Errorf("Expected document %s, got %s", expected, content)
+	}
+}
+
+func TestApplyRemovesSeveralArrayElements(t *testing.T) {
+	var document map[string]interface{}
+	if err := json.Unmarshal([]byte(`{"tags": [{"name": "a", "drop": true}, {"name": "b"}, {"name": "c", "drop": true}, {"name": "d", "drop": true}, {"name": "e"}]}`), &document); err != nil {
+		t.Fatalf("Failed to parse test document: %v", err)
+	}
+	overlayDocument := map[string]interface{}{
+		"actions": []interface{}{
+			map[string]interface{}{"target": "$.tags[?(@.drop)]", "remove": true},
+		},
+	}
+
+	result, err := Apply(document, overlayDocument)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	content, _ := json.Marshal(result)
+	if string(content) != `{"tags":[{"name":"b"},{"name":"e"}]}` {
+		t.Errorf("Unexpected document %s", content)
+	}
+}
+
+func TestApplyErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		overlay string
+	}{
+		{"missing actions", `{"overlay": "1.0.0"}`},
+		{"action without target", `{"actions": [{"update": {}}]}`},
+		{"invalid target", `{"actions": [{"target": "info", "update": {}}]}`},
+		{"root removal", `{"actions": [{"target": "$", "remove": true}]}`},
+		{"root replaced", `{"actions": [{"target": "$", "update": "text"}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var overlayDocument map[string]interface{}
+			if err := json.Unmarshal([]byte(tt.overlay), &overlayDocument); err != nil {
+				t.Fatalf("Failed to parse test overlay: %v", err)
+			}
+			if _, err := Apply(map[string]interface{}{"openapi": "3.0.0"}, overlayDocument); err == nil {
+				t.Error("Expected error")
+			}
+		})
+	}
+}
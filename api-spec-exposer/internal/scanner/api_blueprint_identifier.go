@@ -0,0 +1,102 @@
+package scanner
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+)
+
+var (
+	// blueprintMetadataPattern matches a 'Key: value' line of the metadata section at the beginning of a blueprint
+	blueprintMetadataPattern = regexp.MustCompile(`^([A-Za-z][A-Za-z0-9_-]*)[ \t]*:[ \t]*(.*?)[ \t]*$`)
+
+	// blueprintActionPattern matches action headings, '## Retrieve Note [GET]', '### Create [POST /notes]' or '# GET /message'
+	blueprintActionPattern = regexp.MustCompile(`^#{1,6}[ \t]+(?:.*\[(?:GET|POST|PUT|PATCH|DELETE|HEAD|OPTIONS|TRACE|CONNECT|LINK|UNLINK)(?:[ \t]+[^\]]*)?\]|(?:GET|POST|PUT|PATCH|DELETE|HEAD|OPTIONS|TRACE|CONNECT|LINK|UNLINK)[ \t]+/.*)[ \t#]*$`)
+)
+
+// APIBlueprintIdentifier identifies API Blueprint documents, including blueprints saved with a Markdown extension
+type APIBlueprintIdentifier struct{}
+
+func (i *APIBlueprintIdentifier) CanHandle(path string) bool {
+	ext := getFileExtension(path)
+	return ext == "apib" || ext == "md" || ext == "markdown"
+}
+
+func (i *APIBlueprintIdentifier) ApiType() config.ApiType {
+	return config.ApiTypeAPIBlueprint
+}
+
+func (i *APIBlueprintIdentifier) Identify(path string, content []byte) (*config.SpecMetadata, []string, []error) {
+	var warnings []string
+
+	metadata := blueprintMetadata(content)
+	if !strings.EqualFold(metadata["format"], "1A") {
+		// Markdown files are blueprints only when they declare the format, plain documentation is left to MarkdownIdentifier
+		if getFileExtension(path) != "apib" {
+			return nil, nil, nil
+		}
+		warnings = append(warnings, fmt.Sprintf("file %s: 'FORMAT: 1A' metadata is missing", path))
+	}
+
+	name := getFileName(path)
+	if heading := firstHeading(content); heading != "" {
+		name = heading
+	}
+
+	var details config.SpecDetails
+	if host := metadata["host"]; host != "" {
+		details.Servers = []string{host}
+	}
+	details.OperationCount = countBlueprintActions(content)
+
+	return &config.SpecMetadata{
+		Name:        name,
+		FilePath:    path,
+		Type:        config.DocTypeAPIBlueprint,
+		ApiType:     config.ApiTypeAPIBlueprint,
+		Format:      config.FormatAPIBlueprint,
+		FileId:      generateFileId(path),
+		XApiKind:    getXApiKind(path),
+		SpecDetails: details,
+	}, warnings, nil
+}
+
+// blueprintMetadata returns the metadata section of a blueprint keyed by lower case names
+func blueprintMetadata(content []byte) map[string]string {
+	metadata := make(map[string]string)
+	text := string(bytes.TrimPrefix(content, []byte("\xef\xbb\xbf")))
+	for _, line := range strings.Split(text, "\n") {
+		match := blueprintMetadataPattern.FindStringSubmatch(strings.TrimRight(line, "\r"))
+		if match == nil {
+			break
+		}
+		metadata[strings.ToLower(match[1])] = match[2]
+	}
+	return metadata
+}
+
+// countBlueprintActions counts the action headings of a blueprint outside fenced code blocks
+func countBlueprintActions(content []byte) int {
+	count := 0
+	fence := ""
+	for _, line := range strings.Split(string(content), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if fence != "" {
+			if strings.HasPrefix(trimmed, fence) {
+				fence = ""
+			}
+			continue
+		}
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			fence = trimmed[:3]
+			continue
+		}
+		if blueprintActionPattern.MatchString(strings.TrimRight(line, " \t\r")) {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,88 @@
+package scanner
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+)
+
+const testBlueprint = "FORMAT: 1A\nHOST: https://notes.example.com\n\n# Notes API\n\nNotes service.\n\n## Notes Collection [/notes]\n\n### List Notes [GET]\n\n+ Response 200 (application/json)\n\n### Create a Note [POST]\n\n```\n# GET /not-an-action\n```\n\n## Note [/notes/{id}]\n\n### Remove [DELETE /notes/{id}]\n\n# GET /message\n\n+ Response 200 (text/plain)\n\n        # GET /indented\n"
+
+func TestAPIBlueprintIdentifierIdentify(t *testing.T) {
+	identifier := &APIBlueprintIdentifier{}
+
+	tests := []struct {
+		name               string
+		path               string
+		content            string
+		expectedName       string
+		expectedOperations int
+		expectedWarnings   int
+		expectedNoMatch    bool
+	}{
+		{"apib file", "/docs/notes.apib", testBlueprint, "Notes API", 4, 0, false},
+		{"blueprint saved as markdown", "/docs/notes.md", "\xef\xbb\xbfformat: 1a\r\n\r\n# Notes\r\n\r\n## Note [GET /notes/{id}]\r\n", "Notes", 1, 0, false},
+		{"apib without format", "/docs/legacy.apib", "# Legacy API\n\n## Ping [GET /ping]\n", "Legacy API", 1, 1, false},
+		{"plain markdown", "/docs/guide.md", "# Guide\n\nFORMAT: 1A\n", "", 0, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec, warnings, errors := identifier.Identify(tt.path, []byte(tt.content))
+			if tt.expectedNoMatch {
+				if spec != nil || len(errors) > 0 {
+					t.Errorf("Expected file to be skipped, got spec %v, errors %v", spec, errors)
+				}
+				return
+			}
+			if spec == nil {
+				t.Fatalf("Expected spec to be identified, got errors %v", errors)
+			}
+			if spec.Type != config.DocTypeAPIBlueprint || spec.ApiType != config.ApiTypeAPIBlueprint || spec.Format != config.FormatAPIBlueprint {
+				t.Errorf("Expected API Blueprint spec, got %s/%s/%s", spec.Type, spec.ApiType, spec.Format)
+			}
+			if spec.Name != tt.expectedName {
+				t.Errorf("Expected name '%s', got '%s'", tt.expectedName, spec.Name)
+			}
+			if spec.OperationCount != tt.expectedOperations {
+				t.Errorf("Expected %d operations, got %d", tt.expectedOperations, spec.OperationCount)
+			}
+			if len(warnings) != tt.expectedWarnings {
+				t.Errorf("Expected %d warnings, got %v", tt.expectedWarnings, warnings)
+			}
+		})
+	}
+
+	spec, _, _ := identifier.Identify("/docs/notes.apib", []byte(testBlueprint))
+	if strings.Join(spec.Servers, ",") != "https://notes.example.com" {
+		t.Errorf("Expected HOST metadata as server, got %v", spec.Servers)
+	}
+}
+
+func TestIdentifierChainMarkdownBlueprints(t *testing.T) {
+	chain := newTestIdentifierChain(nil, false)
+
+	tests := []struct {
+		path         string
+		content      string
+		expectedType config.DocumentType
+	}{
+		{"/docs/notes.md", testBlueprint, config.DocTypeAPIBlueprint},
+		{"/docs/guide.md", "# Guide\n", config.DocTypeMarkdown},
+		{"/specs/api.raml", "#%RAML 1.0\ntitle: API\n", config.DocTypeRAML10},
+		{"/specs/types.raml", "#%RAML 1.0 DataType\ntype: object\n", config.DocTypeUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			spec, _, errors := chain.Identify(tt.path, []byte(tt.content))
+			if spec == nil {
+				t.Fatalf("Expected spec to be identified, got errors %v", errors)
+			}
+			if spec.Type != tt.expectedType {
+				t.Errorf("Expected type %s, got %s", tt.expectedType, spec.Type)
+			}
+		})
+	}
+}
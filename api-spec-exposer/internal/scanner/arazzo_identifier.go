@@ -0,0 +1,89 @@
+package scanner
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+)
+
+// ArazzoIdentifier identifies Arazzo workflow descriptions
+type ArazzoIdentifier struct{}
+
+func (i *ArazzoIdentifier) CanHandle(path string) bool {
+	ext := getFileExtension(path)
+	return ext == "json" || ext == "yaml" || ext == "yml"
+}
+
+func (i *ArazzoIdentifier) ApiType() config.ApiType {
+	return config.ApiTypeArazzo
+}
+
+func (i *ArazzoIdentifier) Identify(path string, content []byte) (*config.SpecMetadata, []string, []error) {
+	data, format, err := parseDocument(path, content)
+	if err != nil {
+		return nil, nil, nil
+	}
+
+	version := getScalarString(data, "arazzo")
+	if version == "" {
+		return nil, nil, nil
+	}
+	// Later major versions are not recognized, such documents are exposed as unknown
+	if !strings.HasPrefix(version, "1.") {
+		return nil, nil, nil
+	}
+
+	var warnings []string
+	name, details := documentInfo(data)
+	if name == "" {
+		name = getFileName(path)
+		warnings = append(warnings, fmt.Sprintf("file %s: 'info.title' field is missing, using filename as name", path))
+	}
+
+	if sources, ok := data["sourceDescriptions"].([]interface{}); ok {
+		for _, item := range sources {
+			source, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			// Source descriptions of type 'arazzo' refer to other workflow documents
+			if sourceType := getString(source, "type"); sourceType != "" && sourceType != "openapi" {
+				continue
+			}
+			if url := getString(source, "url"); url != "" {
+				details.SourceDescriptions = append(details.SourceDescriptions, url)
+			}
+		}
+	}
+	if workflows, ok := data["workflows"].([]interface{}); ok {
+		details.WorkflowCount = len(workflows)
+	}
+
+	return &config.SpecMetadata{
+		Name:        name,
+		FilePath:    path,
+		Type:        config.DocTypeArazzo10,
+		ApiType:     config.ApiTypeArazzo,
+		Format:      format,
+		FileId:      generateFileId(path),
+		XApiKind:    getXApiKind(path),
+		SpecDetails: details,
+	}, warnings, nil
+}
+
+// documentInfo returns the title and the version and description details of the 'info' object of Arazzo and Overlay documents
+func documentInfo(data map[string]interface{}) (string, config.SpecDetails) {
+	var details config.SpecDetails
+	info, ok := data["info"].(map[string]interface{})
+	if !ok {
+		return "", details
+	}
+
+	details.Version = getScalarString(info, "version")
+	details.Description = getString(info, "description")
+	if details.Description == "" {
+		details.Description = getString(info, "summary")
+	}
+	return getString(info, "title"), details
+}
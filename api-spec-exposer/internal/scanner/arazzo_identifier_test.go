@@ -0,0 +1,67 @@
+package scanner
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+)
+
+func TestArazzoIdentifierIdentify(t *testing.T) {
+	identifier := &ArazzoIdentifier{}
+
+	content := `arazzo: 1.0.1
+info:
+  title: Pet adoption
+  summary: Adopt a pet
+  version: 1.0.0
+sourceDescriptions:
+  - name: pets
+    url: ./openapi/pets.yaml
+    type: openapi
+  - name: shared
+    url: https://example.com/workflows.arazzo.yaml
+    type: arazzo
+  - name: store
+    url: https://example.com/store.json
+workflows:
+  - workflowId: adopt
+  - workflowId: return
+`
+	spec, warnings, errors := identifier.Identify("/workflows/adoption.arazzo.yaml", []byte(content))
+	if spec == nil {
+		t.Fatalf("Expected spec to be identified, got errors %v", errors)
+	}
+	if len(warnings) != 0 {
+		t.Errorf("Expected no warnings, got %v", warnings)
+	}
+	if spec.Type != config.DocTypeArazzo10 || spec.ApiType != config.ApiTypeArazzo || spec.Format != config.FormatYAML {
+		t.Errorf("Expected Arazzo spec in YAML format, got %s/%s/%s", spec.Type, spec.ApiType, spec.Format)
+	}
+	if spec.Name != "Pet adoption" || spec.Version != "1.0.0" || spec.Description != "Adopt a pet" {
+		t.Errorf("Unexpected info details: %s, %s, %s", spec.Name, spec.Version, spec.Description)
+	}
+	if strings.Join(spec.SourceDescriptions, ",") != "./openapi/pets.yaml,https://example.com/store.json" {
+		t.Errorf("Expected OpenAPI source descriptions, got %v", spec.SourceDescriptions)
+	}
+	if spec.WorkflowCount != 2 {
+		t.Errorf("Expected 2 workflows, got %d", spec.WorkflowCount)
+	}
+
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"openapi document", `{"openapi": "3.0.0", "info": {"title": "API"}}`},
+		{"later major version", `{"arazzo": "2.0.0", "info": {"title": "Workflows"}}`},
+		{"invalid json", `{"arazzo": `},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec, warnings, errors := identifier.Identify("/workflows/other.json", []byte(tt.content))
+			if spec != nil || len(warnings) > 0 || len(errors) > 0 {
+				t.Errorf("Expected file to be skipped, got spec %v, warnings %v, errors %v", spec, warnings, errors)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package scanner
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/archive"
+)
+
+// scanArchive identifies the files of an archive like the files of the scan directory. The path of a file
+// is the path of the archive joined with its path inside the archive, so exclusion patterns apply to it as well
+func (s *Scanner) scanArchive(path string) ([]config.SpecMetadata, []config.Diagnostic, []string, []error) {
+	var specs []config.SpecMetadata
+	var diagnostics []config.Diagnostic
+	var warnings []string
+	var errors []error
+
+	limits := archive.Limits{
+		MaxEntries:   s.config.ArchiveScanning.MaxEntries,
+		MaxFileSize:  s.config.ArchiveScanning.MaxFileSize,
+		MaxTotalSize: s.config.ArchiveScanning.MaxTotalSize,
+	}
+	archiveWarnings, err := archive.Walk(path, limits, func(name string, content []byte) {
+		if s.shouldExcludeInArchive(path, name) {
+			return
+		}
+
+		fileSpecs, fileDiagnostics, specWarnings, specErrors := s.identifyFile(filepath.Join(path, filepath.FromSlash(name)), content)
+		for i := range fileSpecs {
+			fileSpecs[i].Archive = path
+			fileSpecs[i].ArchiveLimits = s.config.ArchiveScanning
+		}
+		specs = append(specs, fileSpecs...)
+		diagnostics = append(diagnostics, fileDiagnostics...)
+		warnings = append(warnings, specWarnings...)
+		errors = append(errors, specErrors...)
+	})
+	warnings = append(warnings, archiveWarnings...)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("cannot scan archive %s: %w", path, err))
+	}
+
+	return specs, diagnostics, warnings, errors
+}
+
+// shouldExcludeInArchive reports whether a file of an archive or one of its directories is excluded
+func (s *Scanner) shouldExcludeInArchive(archivePath string, name string) bool {
+	current := archivePath
+	for _, segment := range strings.Split(name, "/") {
+		current = filepath.Join(current, segment)
+		if s.shouldExclude(current) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,118 @@
+package scanner
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+)
+
+func writeTestZip(t *testing.T, path string, files map[string]string) {
+	t.Helper()
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("Failed to create archive: %v", err)
+	}
+	defer file.Close()
+
+	writer := zip.NewWriter(file)
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		w, err := writer.Create(name)
+		if err != nil {
+			t.Fatalf("Failed to add archive entry: %v", err)
+		}
+		w.Write([]byte(files[name]))
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Failed to write archive: %v", err)
+	}
+}
+
+func TestScannerArchives(t *testing.T) {
+	tempDir := writeStitchingFiles(t, map[string]string{
+		"api.yaml": "openapi: 3.0.0\ninfo:\n  title: Outside\n  version: 1.0.0\npaths: {}\n",
+	})
+	defer os.RemoveAll(tempDir)
+
+	archivePath := filepath.Join(tempDir, "api-specs.zip")
+	writeTestZip(t, archivePath, map[string]string{
+		"openapi/pets.yaml":            "openapi: 3.0.0\ninfo:\n  title: Pets\n  version: 1.0.0\npaths: {}\n",
+		"graphql/schema.graphql":       "type Query { pets: [String] }",
+		"bundle.yaml":                  "kind: ConfigMap\n---\nopenapi: 3.1.0\ninfo:\n  title: Bundled\n  version: 1.0.0\npaths: {}\n",
+		"__MACOSX/openapi/._pets.yaml": "binary",
+		".git/config":                  "[core]",
+		"internal/secret.yaml":         "openapi: 3.0.0\ninfo:\n  title: Secret\n  version: 1.0.0\npaths: {}\n",
+		"../evil.yaml":                 "openapi: 3.0.0\ninfo:\n  title: Evil\n  version: 1.0.0\npaths: {}\n",
+	})
+	if err := os.WriteFile(filepath.Join(tempDir, "broken.tar.gz"), []byte("not an archive"), 0644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		specs, _, _, errors := New(config.DiscoveryConfig{ScanDirectory: tempDir}).Scan()
+		if len(errors) != 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+		if len(specs) != 3 {
+			t.Fatalf("Expected the spec and both archives as files, got %d specs", len(specs))
+		}
+		for _, spec := range specs {
+			if spec.Archive != "" {
+				t.Errorf("Expected no specs from archives, got %s", spec.FilePath)
+			}
+		}
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		cfg := config.DiscoveryConfig{
+			ScanDirectory:   tempDir,
+			ExcludePatterns: []string{"api-specs.zip/internal"},
+			ArchiveScanning: config.ArchiveScanningConfig{Enabled: true},
+		}
+		specs, _, warnings, errors := New(cfg).Scan()
+		if len(errors) != 1 || !strings.Contains(errors[0].Error(), "cannot scan archive") {
+			t.Errorf("Expected an error for the broken archive, got %v", errors)
+		}
+		if len(warnings) != 1 || !strings.Contains(warnings[0], "../evil.yaml points outside the archive") {
+			t.Errorf("Expected zip slip warning, got %v", warnings)
+		}
+
+		byName := make(map[string]config.SpecMetadata)
+		for _, spec := range specs {
+			byName[spec.Name] = spec
+		}
+		if len(specs) != 4 {
+			t.Fatalf("Expected 4 specs, got %d: %v", len(specs), specs)
+		}
+
+		pets, ok := byName["Pets"]
+		if !ok {
+			t.Fatal("Expected spec 'Pets' from the archive")
+		}
+		if pets.Archive != archivePath || pets.FilePath != filepath.Join(archivePath, "openapi", "pets.yaml") {
+			t.Errorf("Expected spec in archive %s at %s, got %s at %s", archivePath, filepath.Join(archivePath, "openapi", "pets.yaml"), pets.Archive, pets.FilePath)
+		}
+		if pets.FileId != "pets-yaml" {
+			t.Errorf("Expected FileId 'pets-yaml', got '%s'", pets.FileId)
+		}
+
+		if bundled := byName["Bundled"]; bundled.DocumentIndex != 2 || bundled.Archive != archivePath {
+			t.Errorf("Expected second document of the bundle in the archive, got document %d in '%s'", bundled.DocumentIndex, bundled.Archive)
+		}
+		if schema := byName["schema"]; schema.Type != config.DocTypeGraphQL {
+			t.Errorf("Expected GraphQL schema from the archive, got %v", schema)
+		}
+		if outside := byName["Outside"]; outside.Archive != "" {
+			t.Errorf("Expected spec outside archives, got archive '%s'", outside.Archive)
+		}
+	})
+}
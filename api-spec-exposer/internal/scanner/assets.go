@@ -0,0 +1,76 @@
+package scanner
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var (
+	errAssetOutside  = errors.New("is outside the scan directory")
+	errAssetExcluded = errors.New("is excluded from the scan")
+	errAssetMissing  = errors.New("does not exist")
+)
+
+// checkAsset reports whether a file referenced by a document of the scan directory can be served with it.
+// The file must be a regular file inside the scan directory, also after resolving symbolic links,
+// and neither the file nor one of its directories may be hidden or excluded, by its own or its resolved path
+func (s *Scanner) checkAsset(path string) error {
+	relPath, ok := relativeInside(s.config.ScanDirectory, path)
+	if !ok {
+		return errAssetOutside
+	}
+
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return errAssetMissing
+	}
+	scanDirectory, err := filepath.EvalSymlinks(s.config.ScanDirectory)
+	if err != nil {
+		return errAssetOutside
+	}
+	resolvedRelPath, ok := relativeInside(scanDirectory, resolved)
+	if !ok {
+		return errAssetOutside
+	}
+
+	if s.shouldExcludeInScanDirectory(relPath) || s.shouldExcludeInScanDirectory(resolvedRelPath) {
+		return errAssetExcluded
+	}
+
+	info, err := os.Stat(resolved)
+	if err != nil || !info.Mode().IsRegular() {
+		return errAssetMissing
+	}
+	return nil
+}
+
+// shouldExcludeInScanDirectory reports whether a file given relative to the scan directory or one of its directories is excluded
+func (s *Scanner) shouldExcludeInScanDirectory(relPath string) bool {
+	current := s.config.ScanDirectory
+	for _, segment := range strings.Split(relPath, string(filepath.Separator)) {
+		current = filepath.Join(current, segment)
+		if s.shouldExclude(current) {
+			return true
+		}
+	}
+	return false
+}
+
+// relativeInside returns the path of a file relative to a directory if the file is inside it
+func relativeInside(directory string, path string) (string, bool) {
+	absDirectory, err := filepath.Abs(directory)
+	if err != nil {
+		return "", false
+	}
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return "", false
+	}
+	relPath, err := filepath.Rel(absDirectory, absPath)
+	if err != nil || relPath == "." || relPath == ".." || strings.HasPrefix(relPath, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return relPath, true
+}
@@ -0,0 +1,68 @@
+package scanner
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/avro"
+)
+
+// AvroIdentifier identifies Avro schemas (.avsc), protocols (.avpr) and IDL documents (.avdl)
+type AvroIdentifier struct{}
+
+func (i *AvroIdentifier) CanHandle(path string) bool {
+	ext := getFileExtension(path)
+	return ext == "avsc" || ext == "avpr" || ext == "avdl"
+}
+
+func (i *AvroIdentifier) ApiType() config.ApiType {
+	return config.ApiTypeAvro
+}
+
+func (i *AvroIdentifier) Identify(path string, content []byte) (*config.SpecMetadata, []string, []error) {
+	ext := getFileExtension(path)
+
+	var schema *avro.Schema
+	var docType config.DocumentType
+	var format config.Format
+	if ext == "avdl" || (ext != "avsc" && ext != "avpr" && !looksLikeJSON(content)) {
+		var err error
+		schema, err = avro.ParseIDL(string(content))
+		if err != nil {
+			return nil, nil, []error{fmt.Errorf("failed to parse Avro IDL file %s: %w", path, err)}
+		}
+		docType, format = config.DocTypeAvroIDL, config.FormatAvroIDL
+	} else {
+		var data interface{}
+		if err := json.Unmarshal(content, &data); err != nil {
+			return nil, nil, []error{fmt.Errorf("failed to parse Avro file %s: %w", path, err)}
+		}
+		// Primitive types and top-level unions have no name, such files are exposed as unknown
+		schema = avro.FromJSON(data)
+		if schema == nil {
+			return nil, nil, nil
+		}
+		docType, format = config.DocTypeAvroSchema, config.FormatJSON
+		if schema.Kind == avro.KindProtocol {
+			docType = config.DocTypeAvroProtocol
+		}
+	}
+
+	return &config.SpecMetadata{
+		Name:     schema.Name,
+		FilePath: path,
+		Type:     docType,
+		ApiType:  config.ApiTypeAvro,
+		Format:   format,
+		FileId:   generateFileId(path),
+		XApiKind: getXApiKind(path),
+		SpecDetails: config.SpecDetails{
+			Description: schema.Doc,
+			AvroDetails: config.AvroDetails{
+				NamedTypeCount: schema.NamedTypes,
+				MessageCount:   schema.Messages,
+			},
+		},
+	}, nil, nil
+}
@@ -0,0 +1,109 @@
+package scanner
+
+import (
+	"testing"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+)
+
+func TestAvroIdentifierIdentify(t *testing.T) {
+	identifier := &AvroIdentifier{}
+
+	tests := []struct {
+		name            string
+		path            string
+		content         string
+		expectedType    config.DocumentType
+		expectedFormat  config.Format
+		expectedName    string
+		expectedNoMatch bool
+		expectedError   bool
+	}{
+		{
+			name:           "record schema",
+			path:           "/schemas/order-created.avsc",
+			content:        `{"type": "record", "name": "OrderCreated", "namespace": "com.example.orders", "fields": []}`,
+			expectedType:   config.DocTypeAvroSchema,
+			expectedFormat: config.FormatJSON,
+			expectedName:   "com.example.orders.OrderCreated",
+		},
+		{
+			name:           "protocol",
+			path:           "/schemas/orders.avpr",
+			content:        `{"protocol": "Orders", "namespace": "com.example", "types": [], "messages": {}}`,
+			expectedType:   config.DocTypeAvroProtocol,
+			expectedFormat: config.FormatJSON,
+			expectedName:   "com.example.Orders",
+		},
+		{
+			name:           "idl",
+			path:           "/schemas/orders.avdl",
+			content:        "@namespace(\"com.example\")\nprotocol Orders {\n  record Order { string id; }\n}\n",
+			expectedType:   config.DocTypeAvroIDL,
+			expectedFormat: config.FormatAvroIDL,
+			expectedName:   "com.example.Orders",
+		},
+		{
+			name:            "union schema",
+			path:            "/schemas/payload.avsc",
+			content:         `["null", "string"]`,
+			expectedNoMatch: true,
+		},
+		{
+			name:          "invalid json",
+			path:          "/schemas/broken.avsc",
+			content:       `{"type": "record"`,
+			expectedError: true,
+		},
+		{
+			name:          "invalid idl",
+			path:          "/schemas/broken.avdl",
+			content:       "record Order { string id; }",
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec, _, errors := identifier.Identify(tt.path, []byte(tt.content))
+			if tt.expectedError {
+				if spec != nil || len(errors) == 0 {
+					t.Errorf("Expected parse error, got spec %v, errors %v", spec, errors)
+				}
+				return
+			}
+			if tt.expectedNoMatch {
+				if spec != nil || len(errors) > 0 {
+					t.Errorf("Expected file to be skipped, got spec %v, errors %v", spec, errors)
+				}
+				return
+			}
+			if spec == nil {
+				t.Fatalf("Expected spec to be identified, got errors %v", errors)
+			}
+			if spec.Type != tt.expectedType || spec.ApiType != config.ApiTypeAvro || spec.Format != tt.expectedFormat {
+				t.Errorf("Expected %s Avro spec in %s format, got %s/%s/%s", tt.expectedType, tt.expectedFormat, spec.Type, spec.ApiType, spec.Format)
+			}
+			if spec.Name != tt.expectedName {
+				t.Errorf("Expected name '%s', got '%s'", tt.expectedName, spec.Name)
+			}
+		})
+	}
+}
+
+func TestAvroIdentifierDetails(t *testing.T) {
+	content := `{"protocol": "Orders", "namespace": "com.example", "doc": "Order events",
+  "types": [{"type": "record", "name": "Order", "fields": []}, {"type": "enum", "name": "Status", "symbols": ["NEW"]}],
+  "messages": {"create": {"request": [], "response": "null"}}}`
+
+	spec, _, errors := (&AvroIdentifier{}).Identify("/schemas/orders.avpr", []byte(content))
+	if spec == nil {
+		t.Fatalf("Expected spec to be identified, got errors %v", errors)
+	}
+	if spec.Description != "Order events" || spec.NamedTypeCount != 2 || spec.MessageCount != 1 {
+		t.Errorf("Expected description, 2 named types and 1 message, got '%s', %d and %d", spec.Description, spec.NamedTypeCount, spec.MessageCount)
+	}
+	if spec.OperationCount != 0 || spec.DefinitionCount != 0 {
+		t.Errorf("Expected no REST or JSON Schema counts, got %d and %d", spec.OperationCount, spec.DefinitionCount)
+	}
+}
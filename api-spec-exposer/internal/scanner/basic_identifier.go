@@ -11,6 +11,10 @@ func (i *BasicIdentifier) CanHandle(path string) bool {
 	return true
 }
 
+func (i *BasicIdentifier) ApiType() config.ApiType {
+	return config.ApiTypeUnknown
+}
+
 func (i *BasicIdentifier) Identify(path string, content []byte) (*config.SpecMetadata, []string, []error) {
 	return &config.SpecMetadata{
 		Name:     getFileName(path),
@@ -0,0 +1,40 @@
+package scanner
+
+import (
+	"fmt"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/charset"
+)
+
+// identifyFile identifies and validates the specs of a file after converting its content to UTF-8.
+// The original encoding is kept in the Encoding of the specs, so that they are served converted,
+// and the conversion is reported as a diagnostic. Unknown files are served unchanged
+func (s *Scanner) identifyFile(path string, content []byte) ([]config.SpecMetadata, []config.Diagnostic, []string, []error) {
+	content, encoding := charset.Normalize(content)
+	documents, warnings, errors := s.identify(path, content)
+
+	var specs []config.SpecMetadata
+	var diagnostics []config.Diagnostic
+	for _, doc := range documents {
+		if encoding != "" && doc.spec.ApiType != config.ApiTypeUnknown {
+			doc.spec.Encoding = encoding
+			diagnostics = append(diagnostics, encodingDiagnostic(doc.spec))
+		}
+		diagnostics = append(diagnostics, validate(s.validators, doc.spec, doc.content)...)
+		specs = append(specs, *doc.spec)
+	}
+	return specs, diagnostics, warnings, errors
+}
+
+func encodingDiagnostic(spec *config.SpecMetadata) config.Diagnostic {
+	message := fmt.Sprintf("content converted from %s to UTF-8", spec.Encoding)
+	if spec.Encoding == charset.UTF8BOM {
+		message = "UTF-8 byte order mark removed"
+	}
+	return config.Diagnostic{
+		FilePath: spec.FilePath,
+		Severity: config.SeverityInfo,
+		Message:  message,
+	}
+}
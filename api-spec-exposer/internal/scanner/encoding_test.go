@@ -0,0 +1,82 @@
+package scanner
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/charset"
+)
+
+func TestScannerEncodings(t *testing.T) {
+	utf16LE := []byte{0xff, 0xfe}
+	for _, unit := range utf16.Encode([]rune(`{"openapi": "3.0.0", "info": {"title": "Café", "version": "1.0.0"}, "paths": {}}`)) {
+		utf16LE = append(utf16LE, byte(unit), byte(unit>>8))
+	}
+
+	tempDir := writeStitchingFiles(t, map[string]string{
+		"pets.json":   string(utf16LE),
+		"orders.yaml": "\xef\xbb\xbfopenapi: 3.0.0\ninfo:\n  title: Orders\n  version: 1.0.0\npaths: {}\n",
+		"guide.md":    "# Caf\xe9 guide\n\nSee the menu.\n",
+		"plain.yaml":  "openapi: 3.0.0\ninfo:\n  title: Plain\n  version: 1.0.0\npaths: {}\n",
+		"logo.bin":    "\x89PNG\x00\x00\x1a\n\xff",
+	})
+	defer os.RemoveAll(tempDir)
+
+	specs, diagnostics, _, errors := New(config.DiscoveryConfig{ScanDirectory: tempDir}).Scan()
+	if len(errors) != 0 {
+		t.Fatalf("Expected no errors, got %v", errors)
+	}
+
+	byFile := make(map[string]config.SpecMetadata)
+	for _, spec := range specs {
+		byFile[filepath.Base(spec.FilePath)] = spec
+	}
+
+	tests := []struct {
+		file     string
+		name     string
+		apiType  config.ApiType
+		encoding string
+		message  string
+	}{
+		{"pets.json", "Café", config.ApiTypeRest, charset.UTF16LE, "content converted from UTF-16LE to UTF-8"},
+		{"orders.yaml", "Orders", config.ApiTypeRest, charset.UTF8BOM, "UTF-8 byte order mark removed"},
+		{"guide.md", "Café guide", config.ApiTypeMarkdown, charset.Latin1, "content converted from ISO-8859-1 to UTF-8"},
+		{"plain.yaml", "Plain", config.ApiTypeRest, "", ""},
+		{"logo.bin", "logo", config.ApiTypeUnknown, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			spec, ok := byFile[tt.file]
+			if !ok {
+				t.Fatalf("Expected spec for %s", tt.file)
+			}
+			if spec.ApiType != tt.apiType {
+				t.Errorf("Expected ApiType '%s', got '%s'", tt.apiType, spec.ApiType)
+			}
+			if tt.apiType != config.ApiTypeUnknown && spec.Name != tt.name {
+				t.Errorf("Expected name '%s', got '%s'", tt.name, spec.Name)
+			}
+			if spec.Encoding != tt.encoding {
+				t.Errorf("Expected encoding '%s', got '%s'", tt.encoding, spec.Encoding)
+			}
+
+			var messages []string
+			for _, diagnostic := range diagnostics {
+				if diagnostic.FilePath == spec.FilePath && diagnostic.Severity == config.SeverityInfo && diagnostic.Rule == "" {
+					messages = append(messages, diagnostic.Message)
+				}
+			}
+			if tt.message == "" && len(messages) != 0 {
+				t.Errorf("Expected no conversion diagnostic, got %v", messages)
+			}
+			if tt.message != "" && (len(messages) != 1 || messages[0] != tt.message) {
+				t.Errorf("Expected diagnostic '%s', got %v", tt.message, messages)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package scanner
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/graphql"
+	"github.com/gosimple/slug"
+)
+
+// defaultSupergraphName is the name of the composed supergraph spec if no name is configured
+const defaultSupergraphName = "Supergraph"
+
+// composeSupergraph composes the GraphQL federation subgraphs outside archives into a supergraph spec,
+// nil is returned when less than two subgraphs are discovered. The supergraph is 'no-BWC' if one of its subgraphs is,
+// and its file ID is made unique among the discovered specs
+func (s *Scanner) composeSupergraph(specs []config.SpecMetadata) (*config.SpecMetadata, error) {
+	var sourceFiles []string
+	var subgraphs []string
+	xApiKind := "BWC"
+	for _, spec := range specs {
+		if spec.FederationSubgraph && spec.Archive == "" {
+			sourceFiles = append(sourceFiles, spec.FilePath)
+			subgraphs = append(subgraphs, spec.SubgraphName)
+			if strings.EqualFold(spec.XApiKind, "no-BWC") {
+				xApiKind = "no-BWC"
+			}
+		}
+	}
+	if len(sourceFiles) < 2 {
+		return nil, nil
+	}
+
+	schema, err := graphql.LoadSupergraph(sourceFiles)
+	if err != nil {
+		return nil, fmt.Errorf("cannot compose GraphQL supergraph: %w", err)
+	}
+
+	name := s.config.SupergraphName
+	if name == "" {
+		name = defaultSupergraphName
+	}
+
+	details := extractSDLDetails(schema)
+	details.Subgraphs = subgraphs
+	return &config.SpecMetadata{
+		Name:        name,
+		FilePath:    s.config.ScanDirectory,
+		Type:        config.DocTypeGraphQL,
+		ApiType:     config.ApiTypeGraphQL,
+		Format:      config.FormatGraphQL,
+		FileId:      uniqueFileId(specs, slug.Make(name)),
+		XApiKind:    xApiKind,
+		SourceFiles: sourceFiles,
+		Supergraph:  true,
+		SpecDetails: details,
+	}, nil
+}
+
+// uniqueFileId returns the file ID, suffixed with a number if one of the specs already has it
+func uniqueFileId(specs []config.SpecMetadata, fileId string) string {
+	used := make(map[string]bool)
+	for _, spec := range specs {
+		used[spec.FileId] = true
+	}
+	result := fileId
+	for suffix := 1; used[result]; suffix++ {
+		result = fmt.Sprintf("%s-%d", fileId, suffix)
+	}
+	return result
+}
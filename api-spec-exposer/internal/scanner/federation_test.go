@@ -0,0 +1,188 @@
+package scanner
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+)
+
+func TestGraphQLIdentifierFederationSubgraph(t *testing.T) {
+	identifier := &GraphQLIdentifier{}
+
+	tests := []struct {
+		name             string
+		path             string
+		content          string
+		expectedSubgraph bool
+		expectedName     string
+	}{
+		{
+			name:             "Subgraph with entity",
+			path:             "/specs/users.graphql",
+			content:          "type Query { users: [User] }\ntype User @key(fields: \"id\") { id: ID! }",
+			expectedSubgraph: true,
+			expectedName:     "users",
+		},
+		{
+			name:             "Subgraph linking federation",
+			path:             "/specs/inventory.graphqls",
+			content:          "extend schema @link(url: \"https://specs.apollo.dev/federation/v2.0\")\ntype Query { stock: Int }",
+			expectedSubgraph: true,
+			expectedName:     "inventory",
+		},
+		{
+			name:             "Subgraph declaring its name",
+			path:             "/specs/inventory-v2.graphql",
+			content:          "extend schema @link(url: \"https://specs.apollo.dev/federation/v2.3\", import: [\"@key\"]) @link(url: \"https://example.com/stock/v1.0\")\ntype Query { stock: Int }",
+			expectedSubgraph: true,
+			expectedName:     "stock",
+		},
+		{
+			name:             "Plain schema",
+			path:             "/specs/schema.graphql",
+			content:          "type Query { users: [String] }",
+			expectedSubgraph: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec, _, errs := identifier.Identify(tt.path, []byte(tt.content))
+			if len(errs) != 0 {
+				t.Fatalf("Expected no errors, got %v", errs)
+			}
+			if spec.FederationSubgraph != tt.expectedSubgraph {
+				t.Errorf("Expected FederationSubgraph %v, got %v", tt.expectedSubgraph, spec.FederationSubgraph)
+			}
+			if spec.SubgraphName != tt.expectedName {
+				t.Errorf("Expected SubgraphName %q, got %q", tt.expectedName, spec.SubgraphName)
+			}
+		})
+	}
+}
+
+func TestScannerComposeSupergraph(t *testing.T) {
+	tempDir := writeStitchingFiles(t, map[string]string{
+		"users.graphql":  "type Query { users: [User] }\ntype User @key(fields: \"id\") { id: ID! name: String }",
+		"orders.graphql": "type Query { orders: [Order] }\ntype Order { id: ID! buyer: User }\ntype User @key(fields: \"id\") { id: ID! orders: [Order] }",
+		"other.graphql":  "type Query { other: String }",
+	})
+	defer os.RemoveAll(tempDir)
+
+	tests := []struct {
+		name          string
+		compose       bool
+		expectedSpecs int
+	}{
+		{"composition disabled", false, 3},
+		{"composition enabled", true, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.DiscoveryConfig{
+				ScanDirectory:     tempDir,
+				ComposeSupergraph: tt.compose,
+			}
+
+			specs, _, _, errors := New(cfg).Scan()
+
+			if len(errors) != 0 {
+				t.Errorf("Expected no errors, got %v", errors)
+			}
+
+			if len(specs) != tt.expectedSpecs {
+				t.Fatalf("Expected %d specs, got %d", tt.expectedSpecs, len(specs))
+			}
+
+			if !tt.compose {
+				return
+			}
+
+			supergraph := specs[len(specs)-1]
+			if !supergraph.Supergraph || supergraph.FileId != "supergraph" {
+				t.Fatalf("Expected supergraph spec, got %+v", supergraph)
+			}
+			if len(supergraph.SourceFiles) != 2 {
+				t.Errorf("Expected 2 source files, got %v", supergraph.SourceFiles)
+			}
+			if strings.Join(supergraph.Subgraphs, ",") != "orders,users" {
+				t.Errorf("Expected subgraphs orders,users, got %v", supergraph.Subgraphs)
+			}
+			if supergraph.QueryCount != 2 || supergraph.TypeCount != 3 {
+				t.Errorf("Expected 2 queries and 3 types, got %d and %d", supergraph.QueryCount, supergraph.TypeCount)
+			}
+		})
+	}
+}
+
+func TestScannerComposeSupergraphNaming(t *testing.T) {
+	tempDir := writeStitchingFiles(t, map[string]string{
+		"users.graphql":              "type Query { users: [User] }\ntype User @key(fields: \"id\") { id: ID! }",
+		"orders_internal.graphql":    "type Query { orders: [String] }\ntype User @key(fields: \"id\") { id: ID! }",
+		"supergraph/query.graphqls":  "type Query { reports: [Report] }",
+		"supergraph/report.graphqls": "type Report { id: ID! }",
+	})
+	defer os.RemoveAll(tempDir)
+
+	tests := []struct {
+		name           string
+		supergraphName string
+		expectedName   string
+		expectedFileId string
+	}{
+		{"default name taken by a stitched schema", "", "Supergraph", "supergraph-1"},
+		{"configured name", "Shop Graph", "Shop Graph", "shop-graph"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.DiscoveryConfig{
+				ScanDirectory:     tempDir,
+				GraphQLStitching:  config.GraphQLStitchingConfig{Enabled: true},
+				ComposeSupergraph: true,
+				SupergraphName:    tt.supergraphName,
+			}
+
+			specs, _, _, errors := New(cfg).Scan()
+			if len(errors) != 0 {
+				t.Fatalf("Expected no errors, got %v", errors)
+			}
+
+			supergraph := specs[len(specs)-1]
+			if !supergraph.Supergraph {
+				t.Fatalf("Expected supergraph spec, got %+v", supergraph)
+			}
+			if supergraph.Name != tt.expectedName || supergraph.FileId != tt.expectedFileId {
+				t.Errorf("Expected name '%s' and FileId '%s', got '%s' and '%s'", tt.expectedName, tt.expectedFileId, supergraph.Name, supergraph.FileId)
+			}
+			if supergraph.XApiKind != "no-BWC" {
+				t.Errorf("Expected x-api-kind of the internal subgraph 'no-BWC', got '%s'", supergraph.XApiKind)
+			}
+		})
+	}
+}
+
+func TestScannerComposeSupergraphError(t *testing.T) {
+	tempDir := writeStitchingFiles(t, map[string]string{
+		"users.graphql":  "type Query { users: [User] }\ntype User @key(fields: \"id\") { id: ID! }",
+		"orders.graphql": "type User @key(fields: \"id\") { id: String! }",
+	})
+	defer os.RemoveAll(tempDir)
+
+	cfg := config.DiscoveryConfig{
+		ScanDirectory:     tempDir,
+		ComposeSupergraph: true,
+	}
+
+	specs, _, _, errors := New(cfg).Scan()
+
+	if len(specs) != 2 {
+		t.Errorf("Expected subgraph specs only, got %d specs", len(specs))
+	}
+	if len(errors) != 1 || !strings.Contains(errors[0].Error(), "cannot compose GraphQL supergraph") {
+		t.Errorf("Expected composition error, got %v", errors)
+	}
+}
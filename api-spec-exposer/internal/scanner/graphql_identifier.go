@@ -2,61 +2,161 @@ package scanner
 
 import (
 	"fmt"
-	"regexp"
+	"strings"
 
 	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/graphql"
 )
 
-var sdlPattern = regexp.MustCompile(`type\s+\S+\s+\{`)
-
 // GraphQLIdentifier identifies GraphQL specifications and introspection JSON files
 type GraphQLIdentifier struct{}
 
+// graphQLSchemaExtensions are extensions of GraphQL SDL files
+var graphQLSchemaExtensions = map[string]bool{
+	"graphql":  true,
+	"graphqls": true,
+	"gql":      true,
+	"sdl":      true,
+}
+
 func (i *GraphQLIdentifier) CanHandle(path string) bool {
 	ext := getFileExtension(path)
-	return ext == "graphql" || ext == "gql" || ext == "json"
+	return graphQLSchemaExtensions[ext] || ext == "json"
+}
+
+func (i *GraphQLIdentifier) ApiType() config.ApiType {
+	return config.ApiTypeGraphQL
 }
 
 func (i *GraphQLIdentifier) Identify(path string, content []byte) (*config.SpecMetadata, []string, []error) {
+	// Files with other extensions reach the identifier through extension mappings or content sniffing
 	ext := getFileExtension(path)
+	introspection := ext == "json" || (!graphQLSchemaExtensions[ext] && looksLikeJSON(content))
 
-	if ext == "graphql" || ext == "gql" {
-		if sdlPattern.Match(content) {
-			return &config.SpecMetadata{
-				Name:     getFileName(path),
-				FilePath: path,
-				Type:     config.DocTypeGraphQL,
-				ApiType:  config.ApiTypeGraphQL,
-				Format:   config.FormatGraphQL,
-				FileId:   generateFileId(path),
-				XApiKind: getXApiKind(path),
-			}, nil, nil
-		} else {
-			return nil, nil, []error{fmt.Errorf("file %s is not a valid GraphQL schema", path)}
+	if !introspection {
+		schema, err := graphql.Parse(string(content))
+		if err != nil {
+			return nil, nil, []error{fmt.Errorf("file %s is not a valid GraphQL schema: %w", path, err)}
+		}
+		details := extractSDLDetails(schema)
+		if graphql.IsFederationSubgraph(schema) {
+			details.FederationSubgraph = true
+			details.SubgraphName = graphql.SubgraphName(schema)
+			if details.SubgraphName == "" {
+				details.SubgraphName = getFileName(path)
+			}
 		}
+		return &config.SpecMetadata{
+			Name:        graphQLSpecName(path, details),
+			FilePath:    path,
+			Type:        config.DocTypeGraphQL,
+			ApiType:     config.ApiTypeGraphQL,
+			Format:      config.FormatGraphQL,
+			FileId:      generateFileId(path),
+			XApiKind:    getXApiKind(path),
+			SpecDetails: details,
+		}, nil, nil
 	}
 
-	if ext == "json" {
-		data, err := parseJSON(content)
-		if err != nil {
-			return nil, nil, []error{fmt.Errorf("failed to parse JSON file %s: %w", path, err)}
-		}
-		if hasKey(data, "data") {
-			if dataField, ok := data["data"].(map[string]interface{}); ok {
-				if hasKey(dataField, "__schema") {
-					return &config.SpecMetadata{
-						Name:     getFileName(path),
-						FilePath: path,
-						Type:     config.DocTypeIntrospection,
-						ApiType:  config.ApiTypeGraphQL,
-						Format:   config.FormatJSON,
-						FileId:   generateFileId(path),
-						XApiKind: getXApiKind(path),
-					}, nil, nil
-				}
+	data, err := parseJSON(content)
+	if err != nil {
+		return nil, nil, []error{fmt.Errorf("failed to parse JSON file %s: %w", path, err)}
+	}
+	if !graphql.IsIntrospectionResult(data) {
+		return nil, nil, nil
+	}
+
+	var warnings []string
+	for _, message := range graphql.IntrospectionErrors(data) {
+		warnings = append(warnings, fmt.Sprintf("file %s: introspection result contains error: %s", path, message))
+	}
+
+	schema := graphql.IntrospectionSchema(data)
+	if schema == nil {
+		return nil, warnings, []error{fmt.Errorf("file %s is not a valid introspection result: '__schema' is missing or not an object", path)}
+	}
+
+	problems, err := graphql.CheckIntrospection(schema)
+	if err != nil {
+		return nil, warnings, []error{fmt.Errorf("file %s is not a valid introspection result: %w", path, err)}
+	}
+	for _, problem := range problems {
+		warnings = append(warnings, fmt.Sprintf("file %s: introspection result is partial: %s", path, problem))
+	}
+
+	details := extractIntrospectionDetails(schema)
+	return &config.SpecMetadata{
+		Name:        graphQLSpecName(path, details),
+		FilePath:    path,
+		Type:        config.DocTypeIntrospection,
+		ApiType:     config.ApiTypeGraphQL,
+		Format:      config.FormatJSON,
+		FileId:      generateFileId(path),
+		XApiKind:    getXApiKind(path),
+		SpecDetails: details,
+	}, warnings, nil
+}
+
+// graphQLSpecName derives the spec name from the first line of the schema description
+// or a custom query root type name, falling back to the file name
+func graphQLSpecName(path string, details config.SpecDetails) string {
+	if description := strings.TrimSpace(strings.SplitN(strings.TrimSpace(details.Description), "\n", 2)[0]); description != "" {
+		return description
+	}
+	if details.QueryType != "" && details.QueryType != "Query" {
+		return details.QueryType
+	}
+	return getFileName(path)
+}
+
+// extractSDLDetails collects schema description, root types and statistics (@config.SpecDetails) from a parsed GraphQL SDL
+func extractSDLDetails(schema *graphql.Document) config.SpecDetails {
+	roots := schema.RootTypes()
+
+	return config.SpecDetails{
+		Description:      schema.SchemaDescription(),
+		TypeCount:        schema.TypeCount(),
+		QueryCount:       len(schema.ObjectFields(roots.Query)),
+		MutationCount:    len(schema.ObjectFields(roots.Mutation)),
+		QueryType:        roots.Query,
+		MutationType:     roots.Mutation,
+		SubscriptionType: roots.Subscription,
+	}
+}
+
+// extractIntrospectionDetails collects schema statistics (@config.SpecDetails) from an introspection '__schema' object
+func extractIntrospectionDetails(schema map[string]interface{}) config.SpecDetails {
+	var details config.SpecDetails
+	if schema == nil {
+		return details
+	}
+
+	details.Description = getString(schema, "description")
+
+	typeFields := make(map[string]int)
+	if types, ok := schema["types"].([]interface{}); ok {
+		for _, item := range types {
+			typeDef, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			name := getString(typeDef, "name")
+			if name == "" || strings.HasPrefix(name, "__") || graphql.IsBuiltInScalar(name) {
+				continue
+			}
+			details.TypeCount++
+			if fields, ok := typeDef["fields"].([]interface{}); ok {
+				typeFields[name] = len(fields)
 			}
 		}
 	}
 
-	return nil, nil, nil
+	roots := graphql.IntrospectionRootTypes(schema)
+	details.QueryType = roots.Query
+	details.MutationType = roots.Mutation
+	details.SubscriptionType = roots.Subscription
+	details.QueryCount = typeFields[roots.Query]
+	details.MutationCount = typeFields[roots.Mutation]
+
+	return details
 }
@@ -15,6 +15,8 @@ func TestGraphQLIdentifierCanHandle(t *testing.T) {
 	}{
 		{"/path/to/schema.graphql", true},
 		{"/path/to/schema.gql", true},
+		{"/path/to/schema.graphqls", true},
+		{"/path/to/schema.sdl", true},
 		{"/path/to/introspection.json", true},
 		{"/path/to/schema.GRAPHQL", true},
 		{"/path/to/schema.GQL", true},
@@ -222,3 +224,350 @@ func TestGraphQLIdentifierIdentifyInvalidJSON(t *testing.T) {
 		t.Errorf("Expected 1 error, got %d", len(errors))
 	}
 }
+
+func TestGraphQLIdentifierIdentifySchemaDetails(t *testing.T) {
+	identifier := &GraphQLIdentifier{}
+	content := []byte(`
+"""
+Root query type { not: a field }
+"""
+type Query {
+	# comment: with colon
+	user(id: ID!, filter: Filter = {name: "x"}): User @deprecated(reason: "use users")
+	users: [User!]!
+}
+
+type Mutation {
+	createUser(name: String!): User
+}
+
+extend type Query {
+	me: User
+}
+
+type User {
+	id: ID!
+	name: String
+}
+
+input Filter {
+	name: String
+}
+
+enum Role { ADMIN USER }
+
+scalar Date
+`)
+
+	spec, _, errors := identifier.Identify("schema.graphql", content)
+
+	if len(errors) != 0 {
+		t.Fatalf("Expected no errors, got %v", errors)
+	}
+
+	if spec.TypeCount != 6 {
+		t.Errorf("Expected 6 types, got %d", spec.TypeCount)
+	}
+
+	if spec.QueryCount != 3 {
+		t.Errorf("Expected 3 queries, got %d", spec.QueryCount)
+	}
+
+	if spec.MutationCount != 1 {
+		t.Errorf("Expected 1 mutation, got %d", spec.MutationCount)
+	}
+}
+
+func TestGraphQLIdentifierIdentifySchemaDetailsCustomRoots(t *testing.T) {
+	identifier := &GraphQLIdentifier{}
+	content := []byte(`schema {
+	query: RootQuery
+}
+
+type RootQuery {
+	a: String
+	b: String
+}
+
+type Query {
+	ignored: String
+}`)
+
+	spec, _, _ := identifier.Identify("schema.graphql", content)
+
+	if spec == nil {
+		t.Fatal("Expected spec to be identified, got nil")
+	}
+
+	if spec.QueryCount != 2 {
+		t.Errorf("Expected 2 queries from custom root type, got %d", spec.QueryCount)
+	}
+
+	if spec.MutationCount != 0 {
+		t.Errorf("Expected 0 mutations, got %d", spec.MutationCount)
+	}
+
+	if spec.QueryType != "RootQuery" || spec.MutationType != "" || spec.SubscriptionType != "" {
+		t.Errorf("Expected only query root type 'RootQuery', got '%s', '%s', '%s'", spec.QueryType, spec.MutationType, spec.SubscriptionType)
+	}
+}
+
+func TestGraphQLIdentifierIdentifySchemaDescription(t *testing.T) {
+	identifier := &GraphQLIdentifier{}
+	content := []byte(`"""
+User service schema
+"""
+schema {
+	query: Query
+	subscription: Events
+}
+
+type Query {
+	users: [String]
+}
+
+type Events {
+	userAdded: String
+}`)
+
+	spec, _, errors := identifier.Identify("schema.graphql", content)
+
+	if len(errors) != 0 {
+		t.Fatalf("Expected no errors, got %v", errors)
+	}
+
+	if spec.Description != "User service schema" {
+		t.Errorf("Expected description 'User service schema', got '%s'", spec.Description)
+	}
+
+	if spec.QueryType != "Query" || spec.SubscriptionType != "Events" {
+		t.Errorf("Expected root types 'Query' and 'Events', got '%s' and '%s'", spec.QueryType, spec.SubscriptionType)
+	}
+}
+
+func TestGraphQLIdentifierIdentifyTypeSystemDefinitions(t *testing.T) {
+	identifier := &GraphQLIdentifier{}
+
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"type extension", "extend type Query {\n\tusers: [User]\n}"},
+		{"input", "input UserFilter {\n\tname: String\n}"},
+		{"enum", "enum Role { ADMIN USER }"},
+		{"interface", "interface Node { id: ID! }"},
+		{"union", "union SearchResult = User | Group"},
+		{"scalar", "scalar DateTime"},
+		{"directive", "directive @auth(role: String) on FIELD_DEFINITION"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec, _, errors := identifier.Identify("schema.graphql", []byte(tt.content))
+
+			if len(errors) != 0 {
+				t.Errorf("Expected no errors, got %v", errors)
+			}
+
+			if spec == nil || spec.Type != config.DocTypeGraphQL {
+				t.Errorf("Expected GraphQL spec to be identified, got %v", spec)
+			}
+		})
+	}
+}
+
+func TestGraphQLIdentifierIdentifySyntaxError(t *testing.T) {
+	identifier := &GraphQLIdentifier{}
+
+	tests := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{
+			name:     "type definition only in comment",
+			content:  "# type Query {\nnot a schema",
+			expected: "file schema.graphql is not a valid GraphQL schema: syntax error at line 2, column 1: expected definition, found Name \"not\"",
+		},
+		{
+			name:     "missing closing brace",
+			content:  "type Query {\n\tusers: [User]\n",
+			expected: "file schema.graphql is not a valid GraphQL schema: syntax error at line 3, column 1: expected '}', found <EOF>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec, _, errors := identifier.Identify("schema.graphql", []byte(tt.content))
+
+			if spec != nil {
+				t.Error("Expected spec to be nil for invalid schema")
+			}
+
+			if len(errors) != 1 {
+				t.Fatalf("Expected 1 error, got %d", len(errors))
+			}
+
+			if errors[0].Error() != tt.expected {
+				t.Errorf("Expected error '%s', got '%s'", tt.expected, errors[0].Error())
+			}
+		})
+	}
+}
+
+func TestGraphQLIdentifierIdentifyIntrospectionDetails(t *testing.T) {
+	identifier := &GraphQLIdentifier{}
+	content := []byte(`{
+		"data": {
+			"__schema": {
+				"description": "User service",
+				"queryType": {"name": "Query"},
+				"mutationType": {"name": "Mutation"},
+				"types": [
+					{"kind": "OBJECT", "name": "Query", "fields": [{"name": "user"}, {"name": "users"}]},
+					{"kind": "OBJECT", "name": "Mutation", "fields": [{"name": "createUser"}]},
+					{"kind": "OBJECT", "name": "User", "fields": [{"name": "id"}]},
+					{"kind": "SCALAR", "name": "String"},
+					{"kind": "OBJECT", "name": "__Schema", "fields": []}
+				]
+			}
+		}
+	}`)
+
+	spec, _, errors := identifier.Identify("introspection.json", content)
+
+	if len(errors) != 0 {
+		t.Fatalf("Expected no errors, got %v", errors)
+	}
+
+	if spec.Description != "User service" {
+		t.Errorf("Expected description 'User service', got '%s'", spec.Description)
+	}
+
+	if spec.TypeCount != 3 {
+		t.Errorf("Expected 3 types, got %d", spec.TypeCount)
+	}
+
+	if spec.QueryCount != 2 {
+		t.Errorf("Expected 2 queries, got %d", spec.QueryCount)
+	}
+
+	if spec.MutationCount != 1 {
+		t.Errorf("Expected 1 mutation, got %d", spec.MutationCount)
+	}
+}
+
+func TestGraphQLIdentifierIdentifyIntrospectionShapes(t *testing.T) {
+	identifier := &GraphQLIdentifier{}
+
+	tests := []struct {
+		name     string
+		content  string
+		warnings int
+		errors   int
+	}{
+		{
+			name:    "bare schema",
+			content: `{"__schema": {"queryType": {"name": "Query"}, "types": []}}`,
+		},
+		{
+			name:     "data with errors",
+			content:  `{"data": {"__schema": {"queryType": {"name": "Query"}, "types": []}}, "errors": [{"message": "field 'secret' is forbidden"}]}`,
+			warnings: 1,
+		},
+		{
+			name:     "failed response",
+			content:  `{"data": null, "errors": [{"message": "introspection is disabled"}]}`,
+			warnings: 1,
+			errors:   1,
+		},
+		{
+			name:    "missing query type",
+			content: `{"__schema": {"types": []}}`,
+			errors:  1,
+		},
+		{
+			name:     "partial schema",
+			content:  `{"__schema": {"queryType": {"name": "Query"}, "types": [{"kind": "OBJECT", "name": "Query", "fields": [{"name": "user", "type": {"kind": "OBJECT", "name": "User"}}]}]}}`,
+			warnings: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec, warnings, errors := identifier.Identify("introspection.json", []byte(tt.content))
+
+			if len(warnings) != tt.warnings {
+				t.Errorf("Expected %d warnings, got %d: %v", tt.warnings, len(warnings), warnings)
+			}
+
+			if len(errors) != tt.errors {
+				t.Errorf("Expected %d errors, got %d: %v", tt.errors, len(errors), errors)
+			}
+
+			if tt.errors == 0 && (spec == nil || spec.Type != config.DocTypeIntrospection) {
+				t.Errorf("Expected introspection spec to be identified, got %v", spec)
+			}
+
+			if tt.errors > 0 && spec != nil {
+				t.Error("Expected spec to be nil for invalid introspection")
+			}
+		})
+	}
+}
+
+func TestGraphQLIdentifierIdentifyName(t *testing.T) {
+	identifier := &GraphQLIdentifier{}
+
+	tests := []struct {
+		name     string
+		path     string
+		content  string
+		expected string
+	}{
+		{
+			name:     "introspection description",
+			path:     "introspection.json",
+			content:  `{"__schema": {"description": "User service\nManages users", "queryType": {"name": "Query"}}}`,
+			expected: "User service",
+		},
+		{
+			name:     "introspection custom query type",
+			path:     "introspection.json",
+			content:  `{"__schema": {"queryType": {"name": "UserServiceQuery"}}}`,
+			expected: "UserServiceQuery",
+		},
+		{
+			name:     "introspection default query type",
+			path:     "introspection.json",
+			content:  `{"__schema": {"queryType": {"name": "Query"}}}`,
+			expected: "introspection",
+		},
+		{
+			name:     "schema description",
+			path:     "schema.graphql",
+			content:  "\"Billing API\"\nschema { query: Query }\ntype Query { invoices: [String] }",
+			expected: "Billing API",
+		},
+		{
+			name:     "schema without description",
+			path:     "schema.graphql",
+			content:  "type Query { invoices: [String] }",
+			expected: "schema",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec, _, errors := identifier.Identify(tt.path, []byte(tt.content))
+
+			if len(errors) != 0 {
+				t.Fatalf("Expected no errors, got %v", errors)
+			}
+
+			if spec.Name != tt.expected {
+				t.Errorf("Expected name '%s', got '%s'", tt.expected, spec.Name)
+			}
+		})
+	}
+}
@@ -1,14 +1,15 @@
 package scanner
 
 import (
-	"encoding/json"
+	"bytes"
 	"fmt"
 	"path/filepath"
 	"strings"
 
 	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/document"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/graphql"
 	"github.com/gosimple/slug"
-	"gopkg.in/yaml.v3"
 )
 
 // Identifier interface for spec type identification
@@ -18,17 +19,33 @@ type Identifier interface {
 
 	// CanHandle returns true if this identifier can handle the file
 	CanHandle(path string) bool
+
+	// ApiType returns the API type of the specs recognized by this identifier
+	ApiType() config.ApiType
 }
 
 // IdentifierChain manages a chain of identifiers
 type IdentifierChain struct {
 	identifiers []Identifier
+
+	// API types of files with custom extensions, keyed by lower case extension without the dot
+	extensionMappings map[string]config.ApiType
+
+	// Detect the API type of extensionless and .txt files from their content
+	contentSniffing bool
 }
 
-// Identify tries each identifier in order until one succeeds
+// Identify tries each identifier in order until one succeeds.
+// Files with mapped extensions or sniffed content are passed to the identifiers of their API type only
 func (ic *IdentifierChain) Identify(path string, content []byte) (*config.SpecMetadata, []string, []error) {
+	apiType, mapped := ic.resolveApiType(path, content)
+
 	for _, identifier := range ic.identifiers {
-		if identifier.CanHandle(path) {
+		handles := identifier.CanHandle(path)
+		if mapped {
+			handles = identifier.ApiType() == apiType || identifier.ApiType() == config.ApiTypeUnknown
+		}
+		if handles {
 			spec, warnings, errors := identifier.Identify(path, content)
 			if spec != nil {
 				return spec, warnings, errors
@@ -42,58 +59,91 @@ func (ic *IdentifierChain) Identify(path string, content []byte) (*config.SpecMe
 	return nil, nil, nil
 }
 
-// Helper functions
-
-func parseJSON(content []byte) (map[string]interface{}, error) {
-	var data map[string]interface{}
-	err := json.Unmarshal(content, &data)
-	return data, err
+// resolveApiType returns the API type of a file assigned by extension mappings or content sniffing
+func (ic *IdentifierChain) resolveApiType(path string, content []byte) (config.ApiType, bool) {
+	ext := getFileExtension(path)
+	if apiType, ok := ic.extensionMappings[ext]; ok {
+		return apiType, true
+	}
+	if ic.contentSniffing && (ext == "" || ext == "txt") {
+		if apiType := sniffApiType(content); apiType != "" {
+			return apiType, true
+		}
+	}
+	return "", false
 }
 
-func parseYAML(content []byte) (map[string]interface{}, error) {
-	var data map[string]interface{}
-	var yamlData map[interface{}]interface{}
-	err := yaml.Unmarshal(content, &yamlData)
-	if err != nil {
-		return nil, err
-	}
-	data = convertYamlToJsonMap(yamlData)
-	return data, err
-}
-
-func convertYamlToJsonMap(yaml map[interface{}]interface{}) map[string]interface{} {
-	mapStringInterface := convertMapI2MapS(yaml)
-	if result, ok := mapStringInterface.(map[string]interface{}); ok {
-		return result
-	}
-	return nil
-}
-
-func convertMapI2MapS(v interface{}) interface{} {
-	switch x := v.(type) {
-	case map[interface{}]interface{}:
-		m := map[string]interface{}{}
-		for k, v2 := range x {
-			switch k2 := k.(type) {
-			case string:
-				m[k2] = convertMapI2MapS(v2)
-			default:
-				m[fmt.Sprint(k)] = convertMapI2MapS(v2)
-			}
-		}
-		v = m
+// sniffApiType guesses the API type of a document from its content, empty if the content is not recognized
+func sniffApiType(content []byte) config.ApiType {
+	if version, _ := ramlHeader(content); version != "" {
+		return config.ApiTypeRAML
+	}
+	if strings.EqualFold(blueprintMetadata(content)["format"], "1A") {
+		return config.ApiTypeAPIBlueprint
+	}
 
-	case []interface{}:
-		for i, v2 := range x {
-			x[i] = convertMapI2MapS(v2)
+	data, format, err := parseDocument("", content)
+	if format == config.FormatJSON && err != nil {
+		return ""
+	}
+	switch {
+	case jsonSchemaDialect(data) != "":
+		return config.ApiTypeJSONSchema
+	case hasKey(data, "openapi") || hasKey(data, "swagger"):
+		return config.ApiTypeRest
+	case hasKey(data, "arazzo"):
+		return config.ApiTypeArazzo
+	case hasKey(data, "overlay"):
+		return config.ApiTypeOverlay
+	}
+	if format == config.FormatJSON {
+		if graphql.IsIntrospectionResult(data) {
+			return config.ApiTypeGraphQL
 		}
+		return ""
+	}
+	if _, err := graphql.Parse(string(content)); err == nil {
+		return config.ApiTypeGraphQL
+	}
+	return ""
+}
 
-	case map[string]interface{}:
-		for k, v2 := range x {
-			x[k] = convertMapI2MapS(v2)
-		}
+// newExtensionMappings normalizes configured extension mappings
+func newExtensionMappings(mappings map[string]config.ApiType) map[string]config.ApiType {
+	result := make(map[string]config.ApiType)
+	for ext, apiType := range mappings {
+		result[strings.ToLower(strings.TrimPrefix(ext, "."))] = apiType
 	}
-	return v
+	return result
+}
+
+// Helper functions
+
+// looksLikeJSON reports whether the content starts with a JSON object
+func looksLikeJSON(content []byte) bool {
+	trimmed := bytes.TrimLeft(bytes.TrimPrefix(content, []byte("\xef\xbb\xbf")), " \t\r\n")
+	return len(trimmed) > 0 && trimmed[0] == '{'
+}
+
+// parseDocument parses a JSON or YAML document, the format is detected from the extension and, for other extensions, from the content.
+// Parse errors are reported by RestIdentifier only, the other identifiers of JSON and YAML documents skip files that cannot be parsed
+// and leave them to the next identifiers of the chain
+func parseDocument(path string, content []byte) (map[string]interface{}, config.Format, error) {
+	ext := getFileExtension(path)
+	if ext == "json" || (ext != "yaml" && ext != "yml" && looksLikeJSON(content)) {
+		data, err := parseJSON(content)
+		return data, config.FormatJSON, err
+	}
+	data, err := parseYAML(content)
+	return data, config.FormatYAML, err
+}
+
+func parseJSON(content []byte) (map[string]interface{}, error) {
+	return document.Parse(content, config.FormatJSON)
+}
+
+func parseYAML(content []byte) (map[string]interface{}, error) {
+	return document.Parse(content, config.FormatYAML)
 }
 
 func getFileExtension(path string) string {
@@ -119,6 +169,18 @@ func getXApiKind(path string) string {
 	return "BWC"
 }
 
+// resolveXApiKind validates the 'x-api-kind' value declared in a document, falling back to the file name based kind when it is not declared.
+// Invalid values are replaced with 'BWC' and reported by the returned warning
+func resolveXApiKind(path string, xApiKind string) (string, string) {
+	if xApiKind == "" {
+		return getXApiKind(path), ""
+	}
+	if val := strings.ToLower(xApiKind); val != "bwc" && val != "no-bwc" {
+		return "BWC", fmt.Sprintf("file %s: 'x-api-kind' has invalid value '%s', using default 'BWC'", path, xApiKind)
+	}
+	return xApiKind, ""
+}
+
 func getString(data map[string]interface{}, key string) string {
 	if val, ok := data[key].(string); ok {
 		return val
@@ -126,6 +188,18 @@ func getString(data map[string]interface{}, key string) string {
 	return ""
 }
 
+// getScalarString returns a string representation of a scalar value (e.g. unquoted YAML version 1.0)
+func getScalarString(data map[string]interface{}, key string) string {
+	switch val := data[key].(type) {
+	case string:
+		return val
+	case int, int64, float64, bool:
+		return fmt.Sprint(val)
+	default:
+		return ""
+	}
+}
+
 func hasKey(data map[string]interface{}, key string) bool {
 	_, ok := data[key]
 	return ok
@@ -3,6 +3,8 @@ package scanner
 import (
 	"path/filepath"
 	"testing"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
 )
 
 func TestParseJSON(t *testing.T) {
@@ -152,110 +154,6 @@ version: "1.0"`),
 	}
 }
 
-func TestConvertYamlToJsonMap(t *testing.T) {
-	tests := []struct {
-		name     string
-		input    map[interface{}]interface{}
-		validate func(*testing.T, map[string]interface{})
-	}{
-		{
-			name: "Simple conversion",
-			input: map[interface{}]interface{}{
-				"name":    "test",
-				"version": "1.0",
-			},
-			validate: func(t *testing.T, result map[string]interface{}) {
-				if result["name"] != "test" {
-					t.Errorf("Expected name 'test', got %v", result["name"])
-				}
-				if result["version"] != "1.0" {
-					t.Errorf("Expected version '1.0', got %v", result["version"])
-				}
-			},
-		},
-		{
-			name: "Nested conversion",
-			input: map[interface{}]interface{}{
-				"info": map[interface{}]interface{}{
-					"title": "API",
-				},
-			},
-			validate: func(t *testing.T, result map[string]interface{}) {
-				info, ok := result["info"].(map[string]interface{})
-				if !ok {
-					t.Error("Expected 'info' to be a map")
-					return
-				}
-				if info["title"] != "API" {
-					t.Errorf("Expected title 'API', got %v", info["title"])
-				}
-			},
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			result := convertYamlToJsonMap(tt.input)
-			if result == nil {
-				t.Error("Expected non-nil result")
-				return
-			}
-			tt.validate(t, result)
-		})
-	}
-}
-
-func TestConvertMapI2MapS(t *testing.T) {
-	tests := []struct {
-		name     string
-		input    interface{}
-		expected interface{}
-	}{
-		{
-			name:     "String value",
-			input:    "test",
-			expected: "test",
-		},
-		{
-			name:     "Integer value",
-			input:    42,
-			expected: 42,
-		},
-		{
-			name: "Map with string keys",
-			input: map[interface{}]interface{}{
-				"key": "value",
-			},
-			expected: map[string]interface{}{
-				"key": "value",
-			},
-		},
-		{
-			name: "Map with integer keys",
-			input: map[interface{}]interface{}{
-				1: "value",
-			},
-			expected: map[string]interface{}{
-				"1": "value",
-			},
-		},
-		{
-			name:     "Slice of values",
-			input:    []interface{}{"a", "b", "c"},
-			expected: []interface{}{"a", "b", "c"},
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			result := convertMapI2MapS(tt.input)
-			if result == nil && tt.expected != nil {
-				t.Error("Expected non-nil result")
-			}
-		})
-	}
-}
-
 func TestGetFileExtension(t *testing.T) {
 	tests := []struct {
 		path     string
@@ -441,3 +339,102 @@ func TestHasKey(t *testing.T) {
 		})
 	}
 }
+
+func newTestIdentifierChain(mappings map[string]config.ApiType, sniffing bool) *IdentifierChain {
+	return &IdentifierChain{
+		identifiers: []Identifier{
+			&JSONSchemaIdentifier{},
+			&RestIdentifier{},
+			&ArazzoIdentifier{},
+			&OverlayIdentifier{},
+			&PostmanIdentifier{},
+			&GraphQLIdentifier{},
+			&RAMLIdentifier{},
+			&APIBlueprintIdentifier{},
+			&MarkdownIdentifier{},
+			&ODataIdentifier{},
+			&AvroIdentifier{},
+			&SOAPIdentifier{},
+			&BasicIdentifier{},
+		},
+		extensionMappings: newExtensionMappings(mappings),
+		contentSniffing:   sniffing,
+	}
+}
+
+func TestIdentifierChainExtensionMappings(t *testing.T) {
+	chain := newTestIdentifierChain(map[string]config.ApiType{
+		".OAS":   config.ApiTypeRest,
+		"schema": config.ApiTypeGraphQL,
+		"doc":    config.ApiTypeMarkdown,
+	}, false)
+
+	tests := []struct {
+		name           string
+		path           string
+		content        string
+		expectedType   config.DocumentType
+		expectedFormat config.Format
+	}{
+		{"yaml openapi", "/specs/api.oas", "openapi: 3.0.0\ninfo:\n  title: API\n  version: 1.0.0\npaths: {}", config.DocTypeOpenAPI30, config.FormatYAML},
+		{"json openapi", "/specs/api.oas", `{"swagger": "2.0", "info": {"title": "API", "version": "1.0.0"}, "paths": {}}`, config.DocTypeOpenAPI20, config.FormatJSON},
+		{"graphql schema", "/specs/api.schema", "type Query { users: [String] }", config.DocTypeGraphQL, config.FormatGraphQL},
+		{"introspection", "/specs/api.schema", `{"__schema": {"queryType": {"name": "Query"}}}`, config.DocTypeIntrospection, config.FormatJSON},
+		{"markdown", "/specs/guide.doc", "# Guide", config.DocTypeMarkdown, config.FormatMarkdown},
+		{"mapped type mismatch", "/specs/api.oas", "asyncapi: 2.6.0\ninfo:\n  title: Events", config.DocTypeUnknown, config.FormatUnknown},
+		{"unmapped extension", "/specs/api.txt", "type Query { users: [String] }", config.DocTypeUnknown, config.FormatUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec, _, errors := chain.Identify(tt.path, []byte(tt.content))
+			if spec == nil {
+				t.Fatalf("Expected spec to be identified, got errors %v", errors)
+			}
+			if spec.Type != tt.expectedType {
+				t.Errorf("Expected type %s, got %s", tt.expectedType, spec.Type)
+			}
+			if spec.Format != tt.expectedFormat {
+				t.Errorf("Expected format %s, got %s", tt.expectedFormat, spec.Format)
+			}
+		})
+	}
+}
+
+func TestIdentifierChainContentSniffing(t *testing.T) {
+	tests := []struct {
+		name         string
+		path         string
+		content      string
+		sniffing     bool
+		expectedType config.DocumentType
+	}{
+		{"extensionless openapi", "/specs/api", "openapi: 3.1.0\ninfo:\n  title: API\n  version: 1.0.0\npaths: {}", true, config.DocTypeOpenAPI31},
+		{"txt openapi json", "/specs/api.txt", `{"openapi": "3.0.0", "info": {"title": "API", "version": "1.0.0"}, "paths": {}}`, true, config.DocTypeOpenAPI30},
+		{"txt graphql schema", "/specs/schema.txt", "type Query { users: [String] }", true, config.DocTypeGraphQL},
+		{"extensionless introspection", "/specs/schema", `{"data": {"__schema": {"queryType": {"name": "Query"}}}}`, true, config.DocTypeIntrospection},
+		{"extensionless json schema", "/specs/event", `{"$schema": "https://json-schema.org/draft/2020-12/schema", "type": "object"}`, true, config.DocTypeJSONSchema},
+		{"txt json schema yaml", "/specs/event.txt", "$schema: http://json-schema.org/draft-07/schema#\ntype: object", true, config.DocTypeJSONSchema},
+		{"txt arazzo yaml", "/specs/flow.txt", "arazzo: 1.0.0\ninfo:\n  title: Flow\n  version: 1.0.0", true, config.DocTypeArazzo10},
+		{"extensionless overlay json", "/specs/overlay", `{"overlay": "1.0.0", "info": {"title": "Overlay", "version": "1.0.0"}, "actions": []}`, true, config.DocTypeOverlay10},
+		{"extensionless raml", "/specs/api", "#%RAML 1.0\ntitle: API", true, config.DocTypeRAML10},
+		{"txt api blueprint", "/specs/api.txt", "FORMAT: 1A\n\n# API", true, config.DocTypeAPIBlueprint},
+		{"plain text", "/specs/notes.txt", "Release notes: nothing changed", true, config.DocTypeUnknown},
+		{"unrelated json", "/specs/data", `{"key": "value"}`, true, config.DocTypeUnknown},
+		{"other extension", "/specs/schema.bak", "type Query { users: [String] }", true, config.DocTypeUnknown},
+		{"sniffing disabled", "/specs/schema.txt", "type Query { users: [String] }", false, config.DocTypeUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain := newTestIdentifierChain(nil, tt.sniffing)
+			spec, _, errors := chain.Identify(tt.path, []byte(tt.content))
+			if spec == nil {
+				t.Fatalf("Expected spec to be identified, got errors %v", errors)
+			}
+			if spec.Type != tt.expectedType {
+				t.Errorf("Expected type %s, got %s", tt.expectedType, spec.Type)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package scanner
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+)
+
+// jsonSchemaDialectPattern matches '$schema' URIs of JSON Schema draft-04 through 2020-12, capturing the draft name
+var jsonSchemaDialectPattern = regexp.MustCompile(`^https?://json-schema\.org/(draft-0[4-7]|draft/(?:2019-09|2020-12))/(?:hyper-)?schema#?$`)
+
+// JSONSchemaIdentifier identifies standalone JSON Schema documents declaring a supported draft in '$schema'
+type JSONSchemaIdentifier struct{}
+
+func (i *JSONSchemaIdentifier) CanHandle(path string) bool {
+	ext := getFileExtension(path)
+	return ext == "json" || ext == "yaml" || ext == "yml"
+}
+
+func (i *JSONSchemaIdentifier) ApiType() config.ApiType {
+	return config.ApiTypeJSONSchema
+}
+
+func (i *JSONSchemaIdentifier) Identify(path string, content []byte) (*config.SpecMetadata, []string, []error) {
+	data, format, err := parseDocument(path, content)
+	if err != nil {
+		return nil, nil, nil
+	}
+
+	dialect := jsonSchemaDialect(data)
+	if dialect == "" {
+		return nil, nil, nil
+	}
+
+	var warnings []string
+	schemaId := getString(data, "$id")
+	if schemaId == "" {
+		// draft-04 declares the identifier in 'id'
+		schemaId = getString(data, "id")
+	}
+
+	name := getFileName(path)
+	if title := getString(data, "title"); title != "" {
+		name = title
+	} else if schemaId != "" {
+		name = schemaId
+	}
+
+	xApiKind, warning := resolveXApiKind(path, getString(data, "x-api-kind"))
+	if warning != "" {
+		warnings = append(warnings, warning)
+	}
+
+	definitions, ok := data["$defs"].(map[string]interface{})
+	if !ok {
+		definitions, _ = data["definitions"].(map[string]interface{})
+	}
+
+	return &config.SpecMetadata{
+		Name:     name,
+		FilePath: path,
+		Type:     config.DocTypeJSONSchema,
+		ApiType:  config.ApiTypeJSONSchema,
+		Format:   format,
+		FileId:   generateFileId(path),
+		XApiKind: xApiKind,
+		SpecDetails: config.SpecDetails{
+			Description: getString(data, "description"),
+			JSONSchemaDetails: config.JSONSchemaDetails{
+				SchemaId:        schemaId,
+				SchemaDialect:   dialect,
+				DefinitionCount: len(definitions),
+			},
+		},
+	}, warnings, nil
+}
+
+// jsonSchemaDialect returns the draft declared by the '$schema' keyword of a document, empty if it is not a supported JSON Schema draft
+func jsonSchemaDialect(data map[string]interface{}) string {
+	match := jsonSchemaDialectPattern.FindStringSubmatch(getString(data, "$schema"))
+	if match == nil {
+		return ""
+	}
+	return strings.TrimPrefix(match[1], "draft/")
+}
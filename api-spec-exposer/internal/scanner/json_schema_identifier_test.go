@@ -0,0 +1,147 @@
+package scanner
+
+import (
+	"testing"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+)
+
+func TestJSONSchemaIdentifierCanHandle(t *testing.T) {
+	identifier := &JSONSchemaIdentifier{}
+
+	tests := []struct {
+		path     string
+		expected bool
+	}{
+		{"/path/to/schema.json", true},
+		{"/path/to/schema.yaml", true},
+		{"/path/to/schema.YML", true},
+		{"/path/to/schema.graphql", false},
+		{"/path/to/schema.md", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			result := identifier.CanHandle(tt.path)
+			if result != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestJSONSchemaIdentifierIdentify(t *testing.T) {
+	identifier := &JSONSchemaIdentifier{}
+
+	tests := []struct {
+		name            string
+		path            string
+		content         string
+		expectedName    string
+		expectedDialect string
+		expectedId      string
+		expectedFormat  config.Format
+		expectedDefs    int
+	}{
+		{
+			name:            "draft 2020-12 with title",
+			path:            "/schemas/order-created.json",
+			content:         `{"$schema": "https://json-schema.org/draft/2020-12/schema", "$id": "https://example.com/order-created", "title": "Order Created", "type": "object", "$defs": {"item": {}, "price": {}}}`,
+			expectedName:    "Order Created",
+			expectedDialect: "2020-12",
+			expectedId:      "https://example.com/order-created",
+			expectedFormat:  config.FormatJSON,
+			expectedDefs:    2,
+		},
+		{
+			name:            "draft-07 yaml named by id",
+			path:            "/schemas/config.yaml",
+			content:         "$schema: http://json-schema.org/draft-07/schema#\n$id: urn:example:config\ntype: object\ndefinitions:\n  port: {}",
+			expectedName:    "urn:example:config",
+			expectedDialect: "draft-07",
+			expectedId:      "urn:example:config",
+			expectedFormat:  config.FormatYAML,
+			expectedDefs:    1,
+		},
+		{
+			name:            "draft-04 id keyword",
+			path:            "/schemas/legacy.json",
+			content:         `{"$schema": "http://json-schema.org/draft-04/schema#", "id": "http://example.com/legacy#"}`,
+			expectedName:    "http://example.com/legacy#",
+			expectedDialect: "draft-04",
+			expectedId:      "http://example.com/legacy#",
+			expectedFormat:  config.FormatJSON,
+		},
+		{
+			name:            "draft 2019-09 named by file",
+			path:            "/schemas/payload.json",
+			content:         `{"$schema": "https://json-schema.org/draft/2019-09/schema"}`,
+			expectedName:    "payload",
+			expectedDialect: "2019-09",
+			expectedFormat:  config.FormatJSON,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec, warnings, errors := identifier.Identify(tt.path, []byte(tt.content))
+			if spec == nil {
+				t.Fatalf("Expected spec to be identified, got errors %v", errors)
+			}
+			if len(warnings) > 0 {
+				t.Errorf("Expected no warnings, got %v", warnings)
+			}
+			if spec.Type != config.DocTypeJSONSchema || spec.ApiType != config.ApiTypeJSONSchema {
+				t.Errorf("Expected JSON Schema type, got %s/%s", spec.Type, spec.ApiType)
+			}
+			if spec.Name != tt.expectedName {
+				t.Errorf("Expected name '%s', got '%s'", tt.expectedName, spec.Name)
+			}
+			if spec.SchemaDialect != tt.expectedDialect {
+				t.Errorf("Expected dialect '%s', got '%s'", tt.expectedDialect, spec.SchemaDialect)
+			}
+			if spec.SchemaId != tt.expectedId {
+				t.Errorf("Expected id '%s', got '%s'", tt.expectedId, spec.SchemaId)
+			}
+			if spec.Format != tt.expectedFormat {
+				t.Errorf("Expected format %s, got %s", tt.expectedFormat, spec.Format)
+			}
+			if spec.DefinitionCount != tt.expectedDefs {
+				t.Errorf("Expected %d definitions, got %d", tt.expectedDefs, spec.DefinitionCount)
+			}
+		})
+	}
+}
+
+func TestJSONSchemaIdentifierNotSchema(t *testing.T) {
+	identifier := &JSONSchemaIdentifier{}
+
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"openapi", `{"openapi": "3.0.0", "info": {"title": "API", "version": "1.0.0"}}`},
+		{"draft-03", `{"$schema": "http://json-schema.org/draft-03/schema#"}`},
+		{"custom meta schema", `{"$schema": "https://example.com/meta-schema"}`},
+		{"invalid json", `{"$schema": `},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec, warnings, errors := identifier.Identify("schema.json", []byte(tt.content))
+			if spec != nil || len(warnings) > 0 || len(errors) > 0 {
+				t.Errorf("Expected file to be skipped, got spec %v, warnings %v, errors %v", spec, warnings, errors)
+			}
+		})
+	}
+}
+
+func TestRestIdentifierSkipsJSONSchema(t *testing.T) {
+	identifier := &RestIdentifier{}
+	content := []byte(`{"$schema": "http://json-schema.org/draft-07/schema#", "type": "object", "swagger": "2.0"}`)
+
+	spec, _, errors := identifier.Identify("schema.json", content)
+	if spec != nil || len(errors) > 0 {
+		t.Errorf("Expected JSON Schema to be skipped, got spec %v, errors %v", spec, errors)
+	}
+}
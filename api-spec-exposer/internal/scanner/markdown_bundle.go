@@ -0,0 +1,61 @@
+package scanner
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/charset"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/markdown"
+)
+
+// bundleMarkdownAssets collects files referenced by relative links of Markdown documents into their asset bundles.
+// Referenced files that are not identified as specs are served with the document only and removed from the spec list
+func (s *Scanner) bundleMarkdownAssets(specs []config.SpecMetadata) ([]config.SpecMetadata, []string) {
+	var warnings []string
+
+	bundled := make(map[string]bool)
+	for i := range specs {
+		spec := &specs[i]
+		if spec.ApiType != config.ApiTypeMarkdown || spec.Archive != "" {
+			continue
+		}
+		content, err := s.readFile(spec.FilePath)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("file %s: cannot collect linked assets: %v", spec.FilePath, err))
+			continue
+		}
+		content, _ = charset.Normalize(content)
+
+		seen := make(map[string]bool)
+		for _, link := range markdown.Links(content) {
+			linkPath, _, ok := markdown.SplitDestination(link.Destination)
+			if !ok {
+				continue
+			}
+			assetPath := filepath.Join(filepath.Dir(spec.FilePath), filepath.FromSlash(linkPath))
+			if seen[assetPath] || assetPath == filepath.Clean(spec.FilePath) {
+				continue
+			}
+			seen[assetPath] = true
+
+			if err := s.checkAsset(assetPath); err != nil {
+				warnings = append(warnings, fmt.Sprintf("file %s: linked file %s %v", spec.FilePath, link.Destination, err))
+				continue
+			}
+
+			spec.Assets = append(spec.Assets, assetPath)
+			bundled[assetPath] = true
+		}
+	}
+
+	var result []config.SpecMetadata
+	for _, spec := range specs {
+		if spec.ApiType == config.ApiTypeUnknown && bundled[filepath.Clean(spec.FilePath)] {
+			continue
+		}
+		result = append(result, spec)
+	}
+
+	return result, warnings
+}
@@ -0,0 +1,161 @@
+package scanner
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+)
+
+func TestScannerBundleMarkdownAssets(t *testing.T) {
+	tempDir := writeStitchingFiles(t, map[string]string{
+		"docs/guide.md":          "# Guide\n\n![flow](./images/flow.png)\n[Other](other.md#top) [Spec](../api.yaml) [Missing](missing.png) [Home](https://example.com)\n",
+		"docs/other.md":          "# Other\n\n![flow](images/flow.png)\n",
+		"docs/images/flow.png":   "png",
+		"docs/images/unused.png": "png",
+		"api.yaml":               "openapi: 3.0.0\ninfo:\n  title: API\n  version: 1.0.0\npaths: {}",
+	})
+	defer os.RemoveAll(tempDir)
+
+	tests := []struct {
+		name          string
+		enabled       bool
+		expectedSpecs int
+	}{
+		{"bundling disabled", false, 5},
+		{"bundling enabled", true, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.DiscoveryConfig{
+				ScanDirectory:        tempDir,
+				BundleMarkdownAssets: tt.enabled,
+			}
+
+			specs, _, warnings, errors := New(cfg).Scan()
+
+			if len(errors) != 0 {
+				t.Errorf("Expected no errors, got %v", errors)
+			}
+
+			if len(specs) != tt.expectedSpecs {
+				t.Fatalf("Expected %d specs, got %d", tt.expectedSpecs, len(specs))
+			}
+
+			if !tt.enabled {
+				return
+			}
+
+			if len(warnings) != 1 || !strings.Contains(warnings[0], "linked file missing.png does not exist") {
+				t.Errorf("Expected missing file warning, got %v", warnings)
+			}
+
+			var guide *config.SpecMetadata
+			for i := range specs {
+				if filepath.Base(specs[i].FilePath) == "guide.md" {
+					guide = &specs[i]
+				}
+				if filepath.Base(specs[i].FilePath) == "flow.png" {
+					t.Error("Expected bundled image to be removed from specs")
+				}
+			}
+			if guide == nil {
+				t.Fatal("Expected guide spec")
+			}
+
+			expected := []string{
+				filepath.Join(tempDir, "docs", "images", "flow.png"),
+				filepath.Join(tempDir, "docs", "other.md"),
+				filepath.Join(tempDir, "api.yaml"),
+			}
+			if strings.Join(guide.Assets, ",") != strings.Join(expected, ",") {
+				t.Errorf("Expected assets %v, got %v", expected, guide.Assets)
+			}
+		})
+	}
+}
+
+func TestScannerBundleMarkdownAssetsOutsideScanDirectory(t *testing.T) {
+	tempDir := writeStitchingFiles(t, map[string]string{
+		"docs/guide.md":  "![secret](../../outside.png)\n",
+		"docs/other.png": "png",
+	})
+	defer os.RemoveAll(tempDir)
+
+	cfg := config.DiscoveryConfig{
+		ScanDirectory:        filepath.Join(tempDir, "docs"),
+		BundleMarkdownAssets: true,
+	}
+
+	specs, _, warnings, _ := New(cfg).Scan()
+
+	for _, spec := range specs {
+		if len(spec.Assets) != 0 {
+			t.Errorf("Expected no assets, got %v", spec.Assets)
+		}
+	}
+
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "is outside the scan directory") {
+		t.Errorf("Expected outside scan directory warning, got %v", warnings)
+	}
+}
+
+func TestScannerBundleMarkdownAssetsRestricted(t *testing.T) {
+	outsideDir := writeStitchingFiles(t, map[string]string{"secret.txt": "secret"})
+	defer os.RemoveAll(outsideDir)
+
+	tempDir := writeStitchingFiles(t, map[string]string{
+		"docs/guide.md":      "[env](../.env) [git](../.git/config) [internal](../internal/notes.txt) [secret](secret.txt) [hidden](hidden.txt) [flow](flow.png)\n",
+		"docs/flow.png":      "png",
+		".env":               "TOKEN=secret",
+		".git/config":        "[core]",
+		"internal/notes.txt": "notes",
+	})
+	defer os.RemoveAll(tempDir)
+
+	if err := os.Symlink(filepath.Join(outsideDir, "secret.txt"), filepath.Join(tempDir, "docs", "secret.txt")); err != nil {
+		t.Skipf("Symbolic links are not supported: %v", err)
+	}
+	if err := os.Symlink(filepath.Join(tempDir, ".env"), filepath.Join(tempDir, "docs", "hidden.txt")); err != nil {
+		t.Fatalf("Failed to create symbolic link: %v", err)
+	}
+
+	cfg := config.DiscoveryConfig{
+		ScanDirectory:        tempDir,
+		ExcludePatterns:      []string{"internal"},
+		BundleMarkdownAssets: true,
+	}
+	specs, _, warnings, _ := New(cfg).Scan()
+
+	var guide *config.SpecMetadata
+	for i := range specs {
+		if filepath.Base(specs[i].FilePath) == "guide.md" {
+			guide = &specs[i]
+		}
+	}
+	if guide == nil {
+		t.Fatal("Expected guide spec")
+	}
+	if expected := filepath.Join(tempDir, "docs", "flow.png"); len(guide.Assets) != 1 || guide.Assets[0] != expected {
+		t.Errorf("Expected only asset %s, got %v", expected, guide.Assets)
+	}
+
+	expectedWarnings := []string{
+		"linked file ../.env is excluded from the scan",
+		"linked file ../.git/config is excluded from the scan",
+		"linked file ../internal/notes.txt is excluded from the scan",
+		"linked file secret.txt is outside the scan directory",
+		"linked file hidden.txt is excluded from the scan",
+	}
+	if len(warnings) != len(expectedWarnings) {
+		t.Fatalf("Expected %d warnings, got %v", len(expectedWarnings), warnings)
+	}
+	for i, expected := range expectedWarnings {
+		if !strings.Contains(warnings[i], expected) {
+			t.Errorf("Expected warning containing '%s', got '%s'", expected, warnings[i])
+		}
+	}
+}
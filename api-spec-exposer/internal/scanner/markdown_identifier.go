@@ -1,7 +1,11 @@
 package scanner
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/markdown"
 )
 
 // MarkdownIdentifier identifies Markdown documentation files
@@ -12,14 +16,105 @@ func (i *MarkdownIdentifier) CanHandle(path string) bool {
 	return ext == "md" || ext == "markdown"
 }
 
+func (i *MarkdownIdentifier) ApiType() config.ApiType {
+	return config.ApiTypeMarkdown
+}
+
 func (i *MarkdownIdentifier) Identify(path string, content []byte) (*config.SpecMetadata, []string, []error) {
+	var warnings []string
+
+	frontMatter, body, err := splitFrontMatter(content)
+	if err != nil {
+		warnings = append(warnings, fmt.Sprintf("file %s: front matter is ignored: %v", path, err))
+	}
+
+	name := getFileName(path)
+	if title := getScalarString(frontMatter, "title"); title != "" {
+		name = title
+	} else if heading := firstHeading(body); heading != "" {
+		name = heading
+	}
+
+	xApiKind, warning := resolveXApiKind(path, getScalarString(frontMatter, "x-api-kind"))
+	if warning != "" {
+		warnings = append(warnings, warning)
+	}
+
+	var details config.SpecDetails
+	details.Description = getScalarString(frontMatter, "description")
+
+	switch related := frontMatter["related-spec"].(type) {
+	case nil:
+	case string:
+		details.RelatedSpecs = []string{related}
+	case []interface{}:
+		for _, item := range related {
+			if value, ok := item.(string); ok && value != "" {
+				details.RelatedSpecs = append(details.RelatedSpecs, value)
+			}
+		}
+	default:
+		warnings = append(warnings, fmt.Sprintf("file %s: 'related-spec' must be a string or a list of strings", path))
+	}
+
+	if value, ok := frontMatter["order"]; ok {
+		if order, ok := value.(int); ok {
+			details.Order = order
+		} else {
+			warnings = append(warnings, fmt.Sprintf("file %s: 'order' must be an integer", path))
+		}
+	}
+
 	return &config.SpecMetadata{
-		Name:     getFileName(path),
-		FilePath: path,
-		Type:     config.DocTypeMarkdown,
-		ApiType:  config.ApiTypeMarkdown,
-		Format:   config.FormatMarkdown,
-		FileId:   generateFileId(path),
-		XApiKind: getXApiKind(path),
-	}, nil, nil
+		Name:        name,
+		FilePath:    path,
+		Type:        config.DocTypeMarkdown,
+		ApiType:     config.ApiTypeMarkdown,
+		Format:      config.FormatMarkdown,
+		FileId:      generateFileId(path),
+		XApiKind:    xApiKind,
+		SpecDetails: details,
+	}, warnings, nil
+}
+
+// splitFrontMatter parses the YAML front matter of a Markdown document and returns it along with the body.
+// The whole content is returned as the body when there is no front matter or it cannot be parsed
+func splitFrontMatter(content []byte) (map[string]interface{}, []byte, error) {
+	frontMatter, body, err := markdown.SplitFrontMatter(content)
+	if err != nil || strings.TrimSpace(string(frontMatter)) == "" {
+		return nil, body, err
+	}
+	data, err := parseYAML(frontMatter)
+	if err != nil {
+		return nil, content, err
+	}
+	return data, body, nil
+}
+
+// firstHeading returns the text of the first level one ATX heading outside fenced code blocks
+func firstHeading(body []byte) string {
+	fence := ""
+	for _, line := range strings.Split(string(body), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if fence != "" {
+			if strings.HasPrefix(trimmed, fence) {
+				fence = ""
+			}
+			continue
+		}
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			fence = trimmed[:3]
+			continue
+		}
+		if trimmed == "#" || strings.HasPrefix(trimmed, "# ") {
+			heading := strings.TrimSpace(strings.TrimPrefix(trimmed, "#"))
+			if index := strings.LastIndex(heading, " #"); index >= 0 && strings.Trim(heading[index:], " #") == "" {
+				heading = strings.TrimSpace(heading[:index])
+			}
+			if heading != "" {
+				return heading
+			}
+		}
+	}
+	return ""
 }
@@ -56,8 +56,8 @@ Creates a new user.
 		t.Fatal("Expected spec to be identified, got nil")
 	}
 
-	if spec.Name != "api-docs" {
-		t.Errorf("Expected name 'api-docs', got '%s'", spec.Name)
+	if spec.Name != "API Documentation" {
+		t.Errorf("Expected name 'API Documentation', got '%s'", spec.Name)
 	}
 
 	if spec.Type != config.DocTypeMarkdown {
@@ -88,3 +88,110 @@ Creates a new user.
 		t.Errorf("Expected no errors, got %d", len(errors))
 	}
 }
+
+func TestMarkdownIdentifierName(t *testing.T) {
+	identifier := &MarkdownIdentifier{}
+
+	tests := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{"front matter title", "---\ntitle: User Guide\n---\n# Heading\n", "User Guide"},
+		{"first heading", "Intro text\n\n## Section\n\n# Main Title #\n", "Main Title"},
+		{"heading with hash", "# Using C#\n", "Using C#"},
+		{"heading in code block", "```\n# comment\n```\n\nNo headings here\n", "guide"},
+		{"empty front matter", "---\n---\n# Heading\n", "Heading"},
+		{"no heading", "Just text\n", "guide"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec, _, _ := identifier.Identify("/docs/guide.md", []byte(tt.content))
+			if spec.Name != tt.expected {
+				t.Errorf("Expected name '%s', got '%s'", tt.expected, spec.Name)
+			}
+		})
+	}
+}
+
+func TestMarkdownIdentifierFrontMatter(t *testing.T) {
+	identifier := &MarkdownIdentifier{}
+	content := []byte(`---
+title: Orders Guide
+x-api-kind: no-BWC
+description: How to place orders
+related-spec:
+  - orders.yaml
+  - orders.graphql
+order: 2
+---
+# Orders
+`)
+
+	spec, warnings, errors := identifier.Identify("/docs/orders.md", content)
+
+	if len(warnings) != 0 || len(errors) != 0 {
+		t.Fatalf("Expected no warnings and errors, got %v and %v", warnings, errors)
+	}
+
+	if spec.Name != "Orders Guide" {
+		t.Errorf("Expected name 'Orders Guide', got '%s'", spec.Name)
+	}
+
+	if spec.XApiKind != "no-BWC" {
+		t.Errorf("Expected XApiKind 'no-BWC', got '%s'", spec.XApiKind)
+	}
+
+	if spec.Description != "How to place orders" {
+		t.Errorf("Expected description 'How to place orders', got '%s'", spec.Description)
+	}
+
+	if len(spec.RelatedSpecs) != 2 || spec.RelatedSpecs[0] != "orders.yaml" || spec.RelatedSpecs[1] != "orders.graphql" {
+		t.Errorf("Expected related specs [orders.yaml orders.graphql], got %v", spec.RelatedSpecs)
+	}
+
+	if spec.Order != 2 {
+		t.Errorf("Expected order 2, got %d", spec.Order)
+	}
+}
+
+func TestMarkdownIdentifierFrontMatterWarnings(t *testing.T) {
+	identifier := &MarkdownIdentifier{}
+
+	tests := []struct {
+		name             string
+		path             string
+		content          string
+		expectedName     string
+		expectedXApiKind string
+	}{
+		{"invalid x-api-kind", "/docs/guide.md", "---\nx-api-kind: legacy\n---\n# Guide\n", "Guide", "BWC"},
+		{"invalid order", "/docs/guide.md", "---\norder: first\n---\n# Guide\n", "Guide", "BWC"},
+		{"invalid related-spec", "/docs/guide.md", "---\nrelated-spec:\n  name: orders\n---\n# Guide\n", "Guide", "BWC"},
+		{"invalid yaml", "/docs/guide_internal.md", "---\ntitle: [unclosed\n---\n# Guide\n", "Guide", "no-BWC"},
+		{"unclosed front matter", "/docs/guide.md", "---\ntitle: Guide\n", "guide", "BWC"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec, warnings, errors := identifier.Identify(tt.path, []byte(tt.content))
+
+			if len(errors) != 0 {
+				t.Errorf("Expected no errors, got %v", errors)
+			}
+
+			if len(warnings) != 1 {
+				t.Errorf("Expected 1 warning, got %v", warnings)
+			}
+
+			if spec.Name != tt.expectedName {
+				t.Errorf("Expected name '%s', got '%s'", tt.expectedName, spec.Name)
+			}
+
+			if spec.XApiKind != tt.expectedXApiKind {
+				t.Errorf("Expected XApiKind '%s', got '%s'", tt.expectedXApiKind, spec.XApiKind)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package scanner
+
+import (
+	"fmt"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/document"
+)
+
+// identifiedDocument is a spec along with the content it was identified from
+type identifiedDocument struct {
+	spec    *config.SpecMetadata
+	content []byte
+}
+
+// identify identifies the specs of a file. The documents of a multi-document YAML file are identified separately
+// and every recognized one becomes a spec of its own; files without recognized documents are identified as a whole,
+// reporting the warnings and errors of their documents unless the whole file is recognized
+func (s *Scanner) identify(path string, content []byte) ([]identifiedDocument, []string, []error) {
+	var documentWarnings []string
+	var documentErrors []error
+	multiDocument := false
+	if ext := getFileExtension(path); ext == "yaml" || ext == "yml" {
+		if documents := document.SplitYAML(content); len(documents) > 1 {
+			result, warnings, errors := s.identifyDocuments(path, documents)
+			if len(result) > 0 {
+				return result, warnings, errors
+			}
+			documentWarnings, documentErrors, multiDocument = warnings, errors, true
+		}
+	}
+
+	spec, warnings, errors := s.identifierChain.Identify(path, content)
+	if multiDocument && (spec == nil || spec.ApiType == config.ApiTypeUnknown) {
+		warnings, errors = documentWarnings, documentErrors
+	}
+	if spec == nil {
+		return nil, warnings, errors
+	}
+	return []identifiedDocument{{spec: spec, content: content}}, warnings, errors
+}
+
+// identifyDocuments returns the documents identified as a known spec type. The position of a document in the file
+// is kept in DocumentIndex and appended to the FileId, so that every document gets a stable endpoint
+func (s *Scanner) identifyDocuments(path string, documents [][]byte) ([]identifiedDocument, []string, []error) {
+	var result []identifiedDocument
+	var warnings []string
+	var errors []error
+	for i, content := range documents {
+		spec, specWarnings, specErrors := s.identifierChain.Identify(path, content)
+		warnings = append(warnings, specWarnings...)
+		errors = append(errors, specErrors...)
+		if spec == nil || spec.ApiType == config.ApiTypeUnknown {
+			continue
+		}
+
+		spec.DocumentIndex = i + 1
+		spec.FileId = fmt.Sprintf("%s-doc-%d", spec.FileId, spec.DocumentIndex)
+		result = append(result, identifiedDocument{spec: spec, content: content})
+	}
+	return result, warnings, errors
+}
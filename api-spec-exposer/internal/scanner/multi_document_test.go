@@ -0,0 +1,82 @@
+package scanner
+
+import (
+	"os"
+	"sort"
+	"testing"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+)
+
+func TestScannerMultiDocumentYAML(t *testing.T) {
+	tempDir := writeStitchingFiles(t, map[string]string{
+		"deploy/bundle.yaml": `# Deployment bundle
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: pets
+---
+openapi: 3.0.0
+info:
+  title: Pets
+  version: 1.0.0
+paths: {}
+---
+# empty document
+---
+swagger: "2.0"
+info:
+  title: Legacy
+  version: 1.0.0
+paths: {}
+...
+`,
+		"deploy/manifests.yml": "apiVersion: v1\nkind: Service\n---\napiVersion: apps/v1\nkind: Deployment\n",
+		"api.yaml":             "---\nopenapi: 3.1.0\ninfo:\n  title: Single\n  version: 1.0.0\npaths: {}\n",
+	})
+	defer os.RemoveAll(tempDir)
+
+	specs, _, _, errors := New(config.DiscoveryConfig{ScanDirectory: tempDir}).Scan()
+	if len(errors) != 0 {
+		t.Errorf("Expected no errors, got %v", errors)
+	}
+
+	sort.Slice(specs, func(i, j int) bool { return specs[i].FileId < specs[j].FileId })
+	expected := []struct {
+		fileId        string
+		name          string
+		specType      config.DocumentType
+		documentIndex int
+	}{
+		{"api-yaml", "Single", config.DocTypeOpenAPI31, 0},
+		{"bundle-yaml-doc-2", "Pets", config.DocTypeOpenAPI30, 2},
+		{"bundle-yaml-doc-3", "Legacy", config.DocTypeOpenAPI20, 3},
+		{"manifests-yml", "manifests", config.DocTypeUnknown, 0},
+	}
+	if len(specs) != len(expected) {
+		t.Fatalf("Expected %d specs, got %d: %v", len(expected), len(specs), specs)
+	}
+	for i, exp := range expected {
+		spec := specs[i]
+		if spec.FileId != exp.fileId || spec.Name != exp.name || spec.Type != exp.specType || spec.DocumentIndex != exp.documentIndex {
+			t.Errorf("Expected %s '%s' (%s, document %d), got %s '%s' (%s, document %d)",
+				exp.fileId, exp.name, exp.specType, exp.documentIndex, spec.FileId, spec.Name, spec.Type, spec.DocumentIndex)
+		}
+	}
+}
+
+func TestScannerMultiDocumentYAMLErrors(t *testing.T) {
+	tempDir := writeStitchingFiles(t, map[string]string{
+		"schemas.yml": "type Query {\n  users: [User\n---\ntype User {\n  id: ID!\n",
+	})
+	defer os.RemoveAll(tempDir)
+
+	cfg := config.DiscoveryConfig{ScanDirectory: tempDir, ExtensionMappings: map[string]config.ApiType{"yml": config.ApiTypeGraphQL}}
+	specs, _, _, errors := New(cfg).Scan()
+	if len(specs) != 0 {
+		t.Errorf("Expected no specs, got %v", specs)
+	}
+	if len(errors) != 2 {
+		t.Fatalf("Expected an error for each document, got %v", errors)
+	}
+}
@@ -0,0 +1,75 @@
+package scanner
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/odata"
+)
+
+// ODataIdentifier identifies OData CSDL metadata documents in XML ('edmx:Edmx' root) and JSON ('$Version') form
+type ODataIdentifier struct{}
+
+func (i *ODataIdentifier) CanHandle(path string) bool {
+	ext := getFileExtension(path)
+	return ext == "xml" || ext == "edmx" || ext == "json"
+}
+
+func (i *ODataIdentifier) ApiType() config.ApiType {
+	return config.ApiTypeOData
+}
+
+func (i *ODataIdentifier) Identify(path string, content []byte) (*config.SpecMetadata, []string, []error) {
+	ext := getFileExtension(path)
+
+	var metadata *odata.Metadata
+	var docType config.DocumentType
+	var format config.Format
+	if ext == "json" || (ext != "xml" && ext != "edmx" && looksLikeJSON(content)) {
+		data, err := parseJSON(content)
+		if err != nil {
+			return nil, nil, nil
+		}
+		metadata = odata.FromJSON(data)
+		docType, format = config.DocTypeODataCSDLJSON, config.FormatJSON
+	} else {
+		var err error
+		metadata, err = odata.ParseXML(content)
+		if err != nil {
+			// Arbitrary .xml files are left to other identifiers
+			if ext == "edmx" {
+				return nil, nil, []error{fmt.Errorf("failed to parse XML file %s: %w", path, err)}
+			}
+			return nil, nil, nil
+		}
+		docType, format = config.DocTypeODataCSDLXML, config.FormatXML
+	}
+	if metadata == nil {
+		return nil, nil, nil
+	}
+
+	name := getFileName(path)
+	if metadata.EntityContainer != "" {
+		name = metadata.EntityContainer[strings.LastIndex(metadata.EntityContainer, ".")+1:]
+	} else if len(metadata.Namespaces) > 0 {
+		name = metadata.Namespaces[0]
+	}
+
+	return &config.SpecMetadata{
+		Name:     name,
+		FilePath: path,
+		Type:     docType,
+		ApiType:  config.ApiTypeOData,
+		Format:   format,
+		FileId:   generateFileId(path),
+		XApiKind: getXApiKind(path),
+		SpecDetails: config.SpecDetails{
+			ODataDetails: config.ODataDetails{
+				ODataVersion:    metadata.Version,
+				EntityContainer: metadata.EntityContainer,
+				EntitySetCount:  metadata.EntitySetCount,
+			},
+		},
+	}, nil, nil
+}
@@ -0,0 +1,99 @@
+package scanner
+
+import (
+	"testing"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+)
+
+func TestODataIdentifierIdentify(t *testing.T) {
+	identifier := &ODataIdentifier{}
+
+	tests := []struct {
+		name            string
+		path            string
+		content         string
+		expectedType    config.DocumentType
+		expectedFormat  config.Format
+		expectedName    string
+		expectedVersion string
+		expectedNoMatch bool
+		expectedError   bool
+	}{
+		{
+			name:            "csdl xml",
+			path:            "/odata/metadata.xml",
+			content:         `<edmx:Edmx Version="4.0" xmlns:edmx="http://docs.oasis-open.org/odata/ns/edmx"><edmx:DataServices><Schema Namespace="Sales" xmlns="http://docs.oasis-open.org/odata/ns/edm"><EntityContainer Name="SalesService"/></Schema></edmx:DataServices></edmx:Edmx>`,
+			expectedType:    config.DocTypeODataCSDLXML,
+			expectedFormat:  config.FormatXML,
+			expectedName:    "SalesService",
+			expectedVersion: "4.0",
+		},
+		{
+			name:            "csdl xml version 3 named by namespace",
+			path:            "/odata/$metadata.edmx",
+			content:         `<edmx:Edmx Version="1.0" xmlns:edmx="http://schemas.microsoft.com/ado/2007/06/edmx"><edmx:DataServices><Schema Namespace="Legacy" xmlns="http://schemas.microsoft.com/ado/2009/11/edm"/></edmx:DataServices></edmx:Edmx>`,
+			expectedType:    config.DocTypeODataCSDLXML,
+			expectedFormat:  config.FormatXML,
+			expectedName:    "Legacy",
+			expectedVersion: "1.0",
+		},
+		{
+			name:            "csdl json",
+			path:            "/odata/metadata.json",
+			content:         `{"$Version": "4.01", "$EntityContainer": "Sales.Container", "Sales": {"Container": {"$Kind": "EntityContainer"}}}`,
+			expectedType:    config.DocTypeODataCSDLJSON,
+			expectedFormat:  config.FormatJSON,
+			expectedName:    "Container",
+			expectedVersion: "4.01",
+		},
+		{
+			name:            "other json",
+			path:            "/config/settings.json",
+			content:         `{"key": "value"}`,
+			expectedNoMatch: true,
+		},
+		{
+			name:            "other xml",
+			path:            "/config/pom.xml",
+			content:         `<project/>`,
+			expectedNoMatch: true,
+		},
+		{
+			name:          "malformed edmx",
+			path:          "/odata/broken.edmx",
+			content:       `<edmx:Edmx`,
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec, _, errors := identifier.Identify(tt.path, []byte(tt.content))
+			if tt.expectedError {
+				if spec != nil || len(errors) == 0 {
+					t.Errorf("Expected parse error, got spec %v, errors %v", spec, errors)
+				}
+				return
+			}
+			if tt.expectedNoMatch {
+				if spec != nil || len(errors) > 0 {
+					t.Errorf("Expected file to be skipped, got spec %v, errors %v", spec, errors)
+				}
+				return
+			}
+			if spec == nil {
+				t.Fatalf("Expected spec to be identified, got errors %v", errors)
+			}
+			if spec.Type != tt.expectedType || spec.ApiType != config.ApiTypeOData || spec.Format != tt.expectedFormat {
+				t.Errorf("Expected %s OData spec in %s format, got %s/%s/%s", tt.expectedType, tt.expectedFormat, spec.Type, spec.ApiType, spec.Format)
+			}
+			if spec.Name != tt.expectedName {
+				t.Errorf("Expected name '%s', got '%s'", tt.expectedName, spec.Name)
+			}
+			if spec.ODataVersion != tt.expectedVersion {
+				t.Errorf("Expected version '%s', got '%s'", tt.expectedVersion, spec.ODataVersion)
+			}
+		})
+	}
+}
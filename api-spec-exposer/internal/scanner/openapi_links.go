@@ -0,0 +1,65 @@
+package scanner
+
+import (
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+)
+
+// linkOpenAPIDocuments resolves the relative source descriptions of Arazzo documents and the relative 'extends' URLs
+// of Overlay documents to the discovered OpenAPI specs. Absolute URLs are left unresolved
+func (s *Scanner) linkOpenAPIDocuments(specs []config.SpecMetadata) []string {
+	var warnings []string
+
+	restSpecs := make(map[string]string)
+	for _, spec := range specs {
+		if spec.ApiType == config.ApiTypeRest && len(spec.SourceFiles) == 0 {
+			restSpecs[filepath.Clean(spec.FilePath)] = spec.FilePath
+		}
+	}
+
+	for i := range specs {
+		spec := &specs[i]
+
+		var references []string
+		switch spec.ApiType {
+		case config.ApiTypeArazzo:
+			references = spec.SourceDescriptions
+		case config.ApiTypeOverlay:
+			if spec.Extends != "" {
+				references = []string{spec.Extends}
+			}
+		default:
+			continue
+		}
+
+		for _, reference := range references {
+			location, ok := relativeLocation(reference)
+			if !ok {
+				continue
+			}
+			linked, ok := restSpecs[filepath.Join(filepath.Dir(spec.FilePath), filepath.FromSlash(location))]
+			if !ok {
+				warnings = append(warnings, fmt.Sprintf("file %s: %s is not a discovered OpenAPI spec", spec.FilePath, reference))
+				continue
+			}
+			if !containsString(spec.LinkedSpecs, linked) {
+				spec.LinkedSpecs = append(spec.LinkedSpecs, linked)
+			}
+		}
+	}
+
+	return warnings
+}
+
+// relativeLocation returns the file path of a relative URL without query and fragment
+func relativeLocation(reference string) (string, bool) {
+	parsed, err := url.Parse(reference)
+	if err != nil || parsed.Scheme != "" || parsed.Host != "" || parsed.Path == "" || strings.HasPrefix(parsed.Path, "/") {
+		return "", false
+	}
+	return parsed.Path, true
+}
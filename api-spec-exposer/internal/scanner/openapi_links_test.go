@@ -0,0 +1,42 @@
+package scanner
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+)
+
+func TestScannerLinkOpenAPIDocuments(t *testing.T) {
+	tempDir := writeStitchingFiles(t, map[string]string{
+		"openapi/pets.yaml":    "openapi: 3.0.0\ninfo:\n  title: Pets\n  version: 1.0.0\npaths: {}\n",
+		"openapi/store.json":   `{"openapi": "3.0.0", "info": {"title": "Store", "version": "1.0.0"}, "paths": {}}`,
+		"adoption.arazzo.yml":  "arazzo: 1.0.0\ninfo:\n  title: Adoption\n  version: 1.0.0\nsourceDescriptions:\n  - name: pets\n    url: openapi/pets.yaml\n  - name: store\n    url: ./openapi/store.json#/paths\n  - name: remote\n    url: https://example.com/openapi.yaml\n  - name: missing\n    url: openapi/missing.yaml\nworkflows: []\n",
+		"overlays/public.yaml": "overlay: 1.0.0\ninfo:\n  title: Public\n  version: 1.0.0\nextends: ../openapi/pets.yaml\nactions: []\n",
+	})
+	defer os.RemoveAll(tempDir)
+
+	specs, _, warnings, errors := New(config.DiscoveryConfig{ScanDirectory: tempDir}).Scan()
+	if len(errors) != 0 {
+		t.Errorf("Expected no errors, got %v", errors)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "openapi/missing.yaml is not a discovered OpenAPI spec") {
+		t.Errorf("Expected unresolved source description warning, got %v", warnings)
+	}
+
+	linked := make(map[string][]string)
+	for _, spec := range specs {
+		linked[spec.Name] = spec.LinkedSpecs
+	}
+
+	pets := filepath.Join(tempDir, "openapi", "pets.yaml")
+	store := filepath.Join(tempDir, "openapi", "store.json")
+	if strings.Join(linked["Adoption"], ",") != pets+","+store {
+		t.Errorf("Expected Arazzo document linked to %s and %s, got %v", pets, store, linked["Adoption"])
+	}
+	if strings.Join(linked["Public"], ",") != pets {
+		t.Errorf("Expected Overlay document linked to %s, got %v", pets, linked["Public"])
+	}
+}
@@ -0,0 +1,676 @@
+package scanner
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/document"
+)
+
+var (
+	responseCodePattern      = regexp.MustCompile(`^[1-5]\d\d$`)
+	responseCodeGroupPattern = regexp.MustCompile(`^[1-5]XX$`)
+	pathTemplatePattern      = regexp.MustCompile(`\{([^{}]+)\}`)
+)
+
+var schemaTypes = map[string]bool{
+	"string":  true,
+	"number":  true,
+	"integer": true,
+	"boolean": true,
+	"array":   true,
+	"object":  true,
+	"null":    true,
+}
+
+// OpenAPIValidator validates the structure of OpenAPI 2.0, 3.0 and 3.1 documents
+type OpenAPIValidator struct{}
+
+func (v *OpenAPIValidator) CanValidate(spec *config.SpecMetadata) bool {
+	return spec.Type == config.DocTypeOpenAPI20 || spec.Type == config.DocTypeOpenAPI30 || spec.Type == config.DocTypeOpenAPI31
+}
+
+func (v *OpenAPIValidator) Validate(spec *config.SpecMetadata, content []byte) []config.Diagnostic {
+	var data map[string]interface{}
+	var err error
+	if spec.Format == config.FormatJSON {
+		data, err = parseJSON(content)
+	} else {
+		data, err = parseYAML(content)
+	}
+
+	validation := &openAPIValidation{
+		filePath:     spec.FilePath,
+		docType:      spec.Type,
+		root:         data,
+		operationIds: make(map[string]string),
+	}
+
+	if err != nil {
+		validation.addError("", fmt.Sprintf("document cannot be parsed: %v", err))
+		return validation.diagnostics
+	}
+
+	validation.validateRoot()
+	validation.validateRefs(data, "")
+
+	return validation.diagnostics
+}
+
+type openAPIValidation struct {
+	filePath     string
+	docType      config.DocumentType
+	root         map[string]interface{}
+	operationIds map[string]string
+	diagnostics  []config.Diagnostic
+}
+
+func (v *openAPIValidation) addError(location string, message string) {
+	v.diagnostics = append(v.diagnostics, config.Diagnostic{
+		FilePath: v.filePath,
+		Location: location,
+		Severity: config.SeverityError,
+		Message:  message,
+	})
+}
+
+func (v *openAPIValidation) addWarning(location string, message string) {
+	v.diagnostics = append(v.diagnostics, config.Diagnostic{
+		FilePath: v.filePath,
+		Location: location,
+		Severity: config.SeverityWarning,
+		Message:  message,
+	})
+}
+
+func (v *openAPIValidation) isSwagger() bool {
+	return v.docType == config.DocTypeOpenAPI20
+}
+
+func (v *openAPIValidation) validateRoot() {
+	info, ok := v.requireObject(v.root, "", "info")
+	if ok {
+		v.requireString(info, "/info", "title")
+		v.requireScalarString(info, "/info", "version")
+	}
+
+	if v.docType == config.DocTypeOpenAPI31 {
+		if !hasKey(v.root, "paths") && !hasKey(v.root, "components") && !hasKey(v.root, "webhooks") {
+			v.addError("", "at least one of 'paths', 'components' or 'webhooks' fields is required")
+		}
+		if hasKey(v.root, "paths") {
+			v.validatePaths()
+		}
+	} else {
+		if _, ok := v.requireObject(v.root, "", "paths"); ok {
+			v.validatePaths()
+		}
+	}
+
+	if v.isSwagger() {
+		v.validateSchemaMap(v.root["definitions"], "/definitions")
+		v.validateParameterMap(v.root["parameters"], "/parameters")
+		v.validateResponseMap(v.root["responses"], "/responses")
+	} else {
+		v.validateServers(v.root["servers"], "/servers")
+		if components, ok := v.optionalObject(v.root, "", "components"); ok {
+			v.validateSchemaMap(components["schemas"], "/components/schemas")
+			v.validateParameterMap(components["parameters"], "/components/parameters")
+			v.validateResponseMap(components["responses"], "/components/responses")
+		}
+	}
+}
+
+func (v *openAPIValidation) validateServers(value interface{}, location string) {
+	if value == nil {
+		return
+	}
+	servers, ok := value.([]interface{})
+	if !ok {
+		v.addError(location, "'servers' must be an array")
+		return
+	}
+	for i, item := range servers {
+		itemLocation := appendPointer(location, fmt.Sprint(i))
+		server, ok := item.(map[string]interface{})
+		if !ok {
+			v.addError(itemLocation, "server must be an object")
+			continue
+		}
+		v.requireString(server, itemLocation, "url")
+	}
+}
+
+func (v *openAPIValidation) validatePaths() {
+	paths, ok := v.root["paths"].(map[string]interface{})
+	if !ok {
+		v.addError("/paths", "'paths' must be an object")
+		return
+	}
+
+	for _, path := range document.SortedKeys(paths) {
+		if strings.HasPrefix(path, "x-") {
+			continue
+		}
+		location := document.Pointer("paths", path)
+		if !strings.HasPrefix(path, "/") {
+			v.addError(location, fmt.Sprintf("path '%s' must begin with '/'", path))
+		}
+
+		pathItem, ok := paths[path].(map[string]interface{})
+		if !ok {
+			v.addError(location, "path item must be an object")
+			continue
+		}
+		if hasKey(pathItem, "$ref") {
+			continue
+		}
+
+		pathParameters := v.validateParameterList(pathItem["parameters"], appendPointer(location, "parameters"))
+
+		for _, method := range document.OperationMethodsOf(v.docType) {
+			value, ok := pathItem[method]
+			if !ok {
+				continue
+			}
+			operationLocation := appendPointer(location, method)
+			operation, ok := value.(map[string]interface{})
+			if !ok {
+				v.addError(operationLocation, "operation must be an object")
+				continue
+			}
+			v.validateOperation(path, operation, operationLocation, pathParameters)
+		}
+	}
+}
+
+func (v *openAPIValidation) validateOperation(path string, operation map[string]interface{}, location string, pathParameters []map[string]interface{}) {
+	if operationId, ok := operation["operationId"].(string); ok && operationId != "" {
+		if previous, exists := v.operationIds[operationId]; exists {
+			v.addError(appendPointer(location, "operationId"), fmt.Sprintf("operationId '%s' is already used at '%s'", operationId, previous))
+		} else {
+			v.operationIds[operationId] = location
+		}
+	}
+
+	operationParameters := v.validateParameterList(operation["parameters"], appendPointer(location, "parameters"))
+
+	if v.isSwagger() {
+		bodyCount, formDataCount := 0, 0
+		for _, param := range operationParameters {
+			switch param["in"] {
+			case "body":
+				bodyCount++
+			case "formData":
+				formDataCount++
+			}
+		}
+		if bodyCount > 1 {
+			v.addError(appendPointer(location, "parameters"), "operation must not have more than one body parameter")
+		}
+		if bodyCount > 0 && formDataCount > 0 {
+			v.addError(appendPointer(location, "parameters"), "body and formData parameters must not be used together")
+		}
+	} else if value, ok := operation["requestBody"]; ok {
+		v.validateRequestBody(value, appendPointer(location, "requestBody"))
+	}
+
+	declared := make(map[string]bool)
+	for _, params := range [][]map[string]interface{}{pathParameters, operationParameters} {
+		for _, param := range params {
+			if name, ok := param["name"].(string); ok && param["in"] == "path" {
+				declared[name] = true
+			}
+		}
+	}
+	for _, match := range pathTemplatePattern.FindAllStringSubmatch(path, -1) {
+		if !declared[match[1]] {
+			v.addError(location, fmt.Sprintf("path parameter '%s' is not declared", match[1]))
+		}
+	}
+
+	responsesValue, ok := operation["responses"]
+	if !ok {
+		if v.docType != config.DocTypeOpenAPI31 {
+			v.addError(location, "'responses' field is required")
+		}
+		return
+	}
+	responsesLocation := appendPointer(location, "responses")
+	responses, ok := responsesValue.(map[string]interface{})
+	if !ok {
+		v.addError(responsesLocation, "'responses' must be an object")
+		return
+	}
+
+	count := 0
+	for _, code := range document.SortedKeys(responses) {
+		if strings.HasPrefix(code, "x-") {
+			continue
+		}
+		count++
+		codeLocation := appendPointer(responsesLocation, code)
+		if !v.isValidResponseCode(code) {
+			v.addError(codeLocation, fmt.Sprintf("'%s' is not a valid response code", code))
+		}
+		v.validateResponse(responses[code], codeLocation)
+	}
+	if count == 0 {
+		v.addError(responsesLocation, "'responses' must contain at least one response")
+	}
+}
+
+func (v *openAPIValidation) isValidResponseCode(code string) bool {
+	if code == "default" || responseCodePattern.MatchString(code) {
+		return true
+	}
+	return !v.isSwagger() && responseCodeGroupPattern.MatchString(code)
+}
+
+// validateParameterList validates a list of parameters and returns the ones that could be resolved
+func (v *openAPIValidation) validateParameterList(value interface{}, location string) []map[string]interface{} {
+	if value == nil {
+		return nil
+	}
+	list, ok := value.([]interface{})
+	if !ok {
+		v.addError(location, "'parameters' must be an array")
+		return nil
+	}
+
+	var parameters []map[string]interface{}
+	seen := make(map[string]bool)
+	for i, item := range list {
+		itemLocation := appendPointer(location, fmt.Sprint(i))
+		itemObject, ok := item.(map[string]interface{})
+		if !ok {
+			v.addError(itemLocation, "parameter must be an object")
+			continue
+		}
+		param, ok := v.resolve(itemObject)
+		if !ok {
+			continue
+		}
+
+		name, _ := param["name"].(string)
+		in, _ := param["in"].(string)
+		key := in + ":" + name
+		if name != "" && in != "" {
+			if seen[key] {
+				v.addError(itemLocation, fmt.Sprintf("duplicate parameter '%s' in '%s'", name, in))
+			}
+			seen[key] = true
+		}
+
+		if !hasKey(itemObject, "$ref") {
+			v.validateParameter(param, itemLocation)
+		}
+		parameters = append(parameters, param)
+	}
+	return parameters
+}
+
+func (v *openAPIValidation) validateParameterMap(value interface{}, location string) {
+	params, ok := value.(map[string]interface{})
+	if !ok {
+		return
+	}
+	for _, name := range document.SortedKeys(params) {
+		itemLocation := appendPointer(location, name)
+		param, ok := params[name].(map[string]interface{})
+		if !ok {
+			v.addError(itemLocation, "parameter must be an object")
+			continue
+		}
+		if !hasKey(param, "$ref") {
+			v.validateParameter(param, itemLocation)
+		}
+	}
+}
+
+func (v *openAPIValidation) validateParameter(param map[string]interface{}, location string) {
+	v.requireString(param, location, "name")
+	in, ok := v.requireString(param, location, "in")
+	if !ok {
+		return
+	}
+
+	var locations []string
+	if v.isSwagger() {
+		locations = []string{"query", "header", "path", "formData", "body"}
+	} else {
+		locations = []string{"query", "header", "path", "cookie"}
+	}
+	if !containsString(locations, in) {
+		v.addError(appendPointer(location, "in"), fmt.Sprintf("'%s' is not a valid parameter location, expected one of: %s", in, strings.Join(locations, ", ")))
+		return
+	}
+
+	if in == "path" {
+		if required, _ := param["required"].(bool); !required {
+			v.addError(location, "path parameter must have 'required' set to true")
+		}
+	}
+
+	if v.isSwagger() {
+		if in == "body" {
+			if schema, ok := v.requireObject(param, location, "schema"); ok {
+				v.validateSchema(schema, appendPointer(location, "schema"))
+			}
+			return
+		}
+		paramType, ok := v.requireString(param, location, "type")
+		if !ok {
+			return
+		}
+		if paramType == "file" && in != "formData" {
+			v.addError(appendPointer(location, "type"), "'file' type is allowed only for formData parameters")
+		} else if paramType != "file" && (!schemaTypes[paramType] || paramType == "object" || paramType == "null") {
+			v.addError(appendPointer(location, "type"), fmt.Sprintf("'%s' is not a valid parameter type", paramType))
+		}
+		if paramType == "array" {
+			v.requireObject(param, location, "items")
+		}
+		return
+	}
+
+	hasSchema := hasKey(param, "schema")
+	hasContent := hasKey(param, "content")
+	if hasSchema == hasContent {
+		v.addError(location, "parameter must contain exactly one of 'schema' or 'content' fields")
+	}
+	if hasSchema {
+		v.validateSchema(param["schema"], appendPointer(location, "schema"))
+	}
+	if hasContent {
+		v.validateContent(param["content"], appendPointer(location, "content"))
+	}
+}
+
+func (v *openAPIValidation) validateRequestBody(value interface{}, location string) {
+	requestBody, ok := value.(map[string]interface{})
+	if !ok {
+		v.addError(location, "'requestBody' must be an object")
+		return
+	}
+	if hasKey(requestBody, "$ref") {
+		return
+	}
+	if _, ok := v.requireObject(requestBody, location, "content"); ok {
+		v.validateContent(requestBody["content"], appendPointer(location, "content"))
+	}
+}
+
+func (v *openAPIValidation) validateResponseMap(value interface{}, location string) {
+	responses, ok := value.(map[string]interface{})
+	if !ok {
+		return
+	}
+	for _, name := range document.SortedKeys(responses) {
+		v.validateResponse(responses[name], appendPointer(location, name))
+	}
+}
+
+func (v *openAPIValidation) validateResponse(value interface{}, location string) {
+	response, ok := value.(map[string]interface{})
+	if !ok {
+		v.addError(location, "response must be an object")
+		return
+	}
+	if hasKey(response, "$ref") {
+		return
+	}
+
+	v.requireString(response, location, "description")
+
+	if v.isSwagger() {
+		if schema, ok := response["schema"]; ok {
+			v.validateSchema(schema, appendPointer(location, "schema"))
+		}
+	} else if content, ok := response["content"]; ok {
+		v.validateContent(content, appendPointer(location, "content"))
+	}
+}
+
+func (v *openAPIValidation) validateContent(value interface{}, location string) {
+	content, ok := value.(map[string]interface{})
+	if !ok {
+		v.addError(location, "'content' must be an object")
+		return
+	}
+	for _, mediaType := range document.SortedKeys(content) {
+		mediaTypeLocation := appendPointer(location, mediaType)
+		mediaTypeObject, ok := content[mediaType].(map[string]interface{})
+		if !ok {
+			v.addError(mediaTypeLocation, "media type must be an object")
+			continue
+		}
+		if schema, ok := mediaTypeObject["schema"]; ok {
+			v.validateSchema(schema, appendPointer(mediaTypeLocation, "schema"))
+		}
+	}
+}
+
+func (v *openAPIValidation) validateSchemaMap(value interface{}, location string) {
+	if value == nil {
+		return
+	}
+	schemas, ok := value.(map[string]interface{})
+	if !ok {
+		v.addError(location, "schemas must be an object")
+		return
+	}
+	for _, name := range document.SortedKeys(schemas) {
+		v.validateSchema(schemas[name], appendPointer(location, name))
+	}
+}
+
+func (v *openAPIValidation) validateSchema(value interface{}, location string) {
+	if _, ok := value.(bool); ok && v.docType == config.DocTypeOpenAPI31 {
+		return
+	}
+	schema, ok := value.(map[string]interface{})
+	if !ok {
+		v.addError(location, "schema must be an object")
+		return
+	}
+	if hasKey(schema, "$ref") && v.docType != config.DocTypeOpenAPI31 {
+		return
+	}
+
+	if schemaType, ok := schema["type"]; ok {
+		v.validateSchemaType(schemaType, appendPointer(location, "type"))
+	}
+
+	if required, ok := schema["required"]; ok {
+		list, isList := required.([]interface{})
+		if !isList {
+			v.addError(appendPointer(location, "required"), "'required' must be an array of property names")
+		} else {
+			for i, item := range list {
+				if _, ok := item.(string); !ok {
+					v.addError(appendPointer(location, "required", fmt.Sprint(i)), "property name must be a string")
+				}
+			}
+		}
+	}
+
+	if properties, ok := schema["properties"]; ok {
+		propertiesMap, isMap := properties.(map[string]interface{})
+		if !isMap {
+			v.addError(appendPointer(location, "properties"), "'properties' must be an object")
+		} else {
+			for _, name := range document.SortedKeys(propertiesMap) {
+				v.validateSchema(propertiesMap[name], appendPointer(location, "properties", name))
+			}
+		}
+	}
+
+	if items, ok := schema["items"]; ok {
+		v.validateSchema(items, appendPointer(location, "items"))
+	} else if schema["type"] == "array" && v.docType != config.DocTypeOpenAPI31 {
+		v.addError(location, "array schema must define 'items'")
+	}
+
+	if additionalProperties, ok := schema["additionalProperties"]; ok {
+		if _, isBool := additionalProperties.(bool); !isBool {
+			v.validateSchema(additionalProperties, appendPointer(location, "additionalProperties"))
+		}
+	}
+
+	for _, keyword := range []string{"allOf", "anyOf", "oneOf"} {
+		value, ok := schema[keyword]
+		if !ok {
+			continue
+		}
+		list, isList := value.([]interface{})
+		if !isList || len(list) == 0 {
+			v.addError(appendPointer(location, keyword), fmt.Sprintf("'%s' must be a non-empty array", keyword))
+			continue
+		}
+		for i, item := range list {
+			v.validateSchema(item, appendPointer(location, keyword, fmt.Sprint(i)))
+		}
+	}
+}
+
+func (v *openAPIValidation) validateSchemaType(value interface{}, location string) {
+	switch schemaType := value.(type) {
+	case string:
+		if !schemaTypes[schemaType] || (schemaType == "null" && v.docType != config.DocTypeOpenAPI31) {
+			if !(v.isSwagger() && schemaType == "file") {
+				v.addError(location, fmt.Sprintf("'%s' is not a valid schema type", schemaType))
+			}
+		}
+	case []interface{}:
+		if v.docType != config.DocTypeOpenAPI31 {
+			v.addError(location, "schema type must be a string")
+			return
+		}
+		for i, item := range schemaType {
+			name, ok := item.(string)
+			if !ok || !schemaTypes[name] {
+				v.addError(appendPointer(location, fmt.Sprint(i)), fmt.Sprintf("'%v' is not a valid schema type", item))
+			}
+		}
+	default:
+		v.addError(location, "schema type must be a string")
+	}
+}
+
+// validateRefs reports local references that cannot be resolved within the document
+func (v *openAPIValidation) validateRefs(value interface{}, location string) {
+	switch node := value.(type) {
+	case map[string]interface{}:
+		for _, key := range document.SortedKeys(node) {
+			if ref, ok := node[key].(string); ok && key == "$ref" {
+				v.validateRef(ref, appendPointer(location, key))
+				continue
+			}
+			v.validateRefs(node[key], appendPointer(location, key))
+		}
+	case []interface{}:
+		for i, item := range node {
+			v.validateRefs(item, appendPointer(location, fmt.Sprint(i)))
+		}
+	}
+}
+
+func (v *openAPIValidation) validateRef(ref string, location string) {
+	if !strings.HasPrefix(ref, "#") {
+		return
+	}
+	if _, ok := v.lookupRef(ref); !ok {
+		v.addError(location, fmt.Sprintf("reference '%s' cannot be resolved", ref))
+	}
+}
+
+func (v *openAPIValidation) lookupRef(ref string) (interface{}, bool) {
+	pointer, err := url.PathUnescape(strings.TrimPrefix(ref, "#"))
+	if err != nil {
+		return nil, false
+	}
+	return resolvePointer(v.root, pointer)
+}
+
+// resolve follows local references (with cycle protection) and returns the referenced object
+func (v *openAPIValidation) resolve(value interface{}) (map[string]interface{}, bool) {
+	visited := make(map[string]bool)
+	for {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		ref, isRef := object["$ref"].(string)
+		if !isRef {
+			return object, true
+		}
+		if !strings.HasPrefix(ref, "#") || visited[ref] {
+			return nil, false
+		}
+		visited[ref] = true
+		target, ok := v.lookupRef(ref)
+		if !ok {
+			return nil, false
+		}
+		value = target
+	}
+}
+
+func (v *openAPIValidation) requireObject(data map[string]interface{}, location string, key string) (map[string]interface{}, bool) {
+	value, ok := data[key]
+	if !ok {
+		v.addError(location, fmt.Sprintf("'%s' field is required", key))
+		return nil, false
+	}
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		v.addError(appendPointer(location, key), fmt.Sprintf("'%s' must be an object", key))
+		return nil, false
+	}
+	return object, true
+}
+
+func (v *openAPIValidation) optionalObject(data map[string]interface{}, location string, key string) (map[string]interface{}, bool) {
+	if !hasKey(data, key) {
+		return nil, false
+	}
+	return v.requireObject(data, location, key)
+}
+
+func (v *openAPIValidation) requireString(data map[string]interface{}, location string, key string) (string, bool) {
+	value, ok := data[key]
+	if !ok {
+		v.addError(location, fmt.Sprintf("'%s' field is required", key))
+		return "", false
+	}
+	s, ok := value.(string)
+	if !ok {
+		v.addError(appendPointer(location, key), fmt.Sprintf("'%s' must be a string", key))
+		return "", false
+	}
+	return s, true
+}
+
+// requireScalarString accepts numbers and booleans with a warning, e.g. an unquoted 'version: 1.0' in YAML,
+// as the identifiers read them as strings
+func (v *openAPIValidation) requireScalarString(data map[string]interface{}, location string, key string) {
+	switch value := data[key].(type) {
+	case int, int64, float64, bool:
+		v.addWarning(appendPointer(location, key), fmt.Sprintf("'%s' should be a string, the value %v is not quoted", key, value))
+	default:
+		v.requireString(data, location, key)
+	}
+}
+
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,341 @@
+package scanner
+
+import (
+	"testing"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/document"
+)
+
+func validateOpenAPI(docType config.DocumentType, format config.Format, content string) []config.Diagnostic {
+	validator := &OpenAPIValidator{}
+	spec := &config.SpecMetadata{
+		FilePath: "spec",
+		Type:     docType,
+		ApiType:  config.ApiTypeRest,
+		Format:   format,
+	}
+	return validator.Validate(spec, []byte(content))
+}
+
+func hasDiagnostic(diagnostics []config.Diagnostic, location string) bool {
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Location == location {
+			return true
+		}
+	}
+	return false
+}
+
+func TestOpenAPIValidatorCanValidate(t *testing.T) {
+	validator := &OpenAPIValidator{}
+
+	tests := []struct {
+		docType  config.DocumentType
+		expected bool
+	}{
+		{config.DocTypeOpenAPI20, true},
+		{config.DocTypeOpenAPI30, true},
+		{config.DocTypeOpenAPI31, true},
+		{config.DocTypeGraphQL, false},
+		{config.DocTypeMarkdown, false},
+		{config.DocTypeUnknown, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.docType), func(t *testing.T) {
+			result := validator.CanValidate(&config.SpecMetadata{Type: tt.docType})
+			if result != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestOpenAPIValidatorValidDocument(t *testing.T) {
+	content := `{
+		"openapi": "3.0.0",
+		"info": {"title": "API", "version": "1.0.0"},
+		"paths": {
+			"/users/{id}": {
+				"parameters": [{"name": "id", "in": "path", "required": true, "schema": {"type": "string"}}],
+				"get": {
+					"operationId": "getUser",
+					"responses": {
+						"200": {
+							"description": "OK",
+							"content": {"application/json": {"schema": {"$ref": "#/components/schemas/User"}}}
+						},
+						"4XX": {"description": "Client error"}
+					}
+				}
+			}
+		},
+		"components": {
+			"schemas": {
+				"User": {"type": "object", "required": ["id"], "properties": {"id": {"type": "string"}}}
+			}
+		}
+	}`
+
+	diagnostics := validateOpenAPI(config.DocTypeOpenAPI30, config.FormatJSON, content)
+
+	if len(diagnostics) != 0 {
+		t.Errorf("Expected no diagnostics, got %v", diagnostics)
+	}
+}
+
+func TestOpenAPIValidatorMissingRequiredFields(t *testing.T) {
+	content := `{"openapi": "3.0.0", "info": {"title": "API"}}`
+
+	diagnostics := validateOpenAPI(config.DocTypeOpenAPI30, config.FormatJSON, content)
+
+	if len(diagnostics) != 2 {
+		t.Fatalf("Expected 2 diagnostics, got %d: %v", len(diagnostics), diagnostics)
+	}
+
+	if !hasDiagnostic(diagnostics, "/info") {
+		t.Error("Expected diagnostic for missing 'version' at '/info'")
+	}
+
+	if !hasDiagnostic(diagnostics, "") {
+		t.Error("Expected diagnostic for missing 'paths' at document root")
+	}
+
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Severity != config.SeverityError {
+			t.Errorf("Expected severity error, got %s", diagnostic.Severity)
+		}
+		if diagnostic.FilePath != "spec" {
+			t.Errorf("Expected file path 'spec', got '%s'", diagnostic.FilePath)
+		}
+	}
+}
+
+func TestOpenAPIValidatorUnquotedVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  string
+		severity config.Severity
+	}{
+		{"number", "1.0", config.SeverityWarning},
+		{"integer", "2", config.SeverityWarning},
+		{"string", "\"1.0\"", ""},
+		{"object", "{major: 1}", config.SeverityError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content := "openapi: 3.0.0\ninfo:\n  title: API\n  version: " + tt.version + "\npaths: {}\n"
+
+			diagnostics := validateOpenAPI(config.DocTypeOpenAPI30, config.FormatYAML, content)
+
+			if tt.severity == "" {
+				if len(diagnostics) != 0 {
+					t.Errorf("Expected no diagnostics, got %v", diagnostics)
+				}
+				return
+			}
+			if len(diagnostics) != 1 || diagnostics[0].Severity != tt.severity || diagnostics[0].Location != "/info/version" {
+				t.Errorf("Expected a single %s at '/info/version', got %v", tt.severity, diagnostics)
+			}
+		})
+	}
+}
+
+func TestOpenAPIValidatorOpenAPI31WithoutPaths(t *testing.T) {
+	content := `{"openapi": "3.1.0", "info": {"title": "API", "version": "1"}, "webhooks": {}}`
+
+	diagnostics := validateOpenAPI(config.DocTypeOpenAPI31, config.FormatJSON, content)
+
+	if len(diagnostics) != 0 {
+		t.Errorf("Expected no diagnostics, got %v", diagnostics)
+	}
+}
+
+func TestOpenAPIValidatorInvalidOperation(t *testing.T) {
+	content := `
+openapi: 3.0.0
+info:
+  title: API
+  version: "1"
+paths:
+  /users/{id}:
+    get:
+      parameters:
+        - name: id
+          in: body
+        - in: query
+          schema:
+            type: string
+      responses:
+        200:
+          content: {}
+        "600":
+          description: Invalid
+  users:
+    post:
+      operationId: create
+  /other:
+    post:
+      operationId: create
+      responses: {}
+`
+
+	diagnostics := validateOpenAPI(config.DocTypeOpenAPI30, config.FormatYAML, content)
+
+	expected := []string{
+		"/paths/~1users~1{id}/get/parameters/0/in",
+		"/paths/~1users~1{id}/get/parameters/1",
+		"/paths/~1users~1{id}/get",
+		"/paths/~1users~1{id}/get/responses/200",
+		"/paths/~1users~1{id}/get/responses/600",
+		"/paths/users",
+		"/paths/users/post",
+		"/paths/users/post/operationId",
+		"/paths/~1other/post/responses",
+	}
+
+	for _, location := range expected {
+		if !hasDiagnostic(diagnostics, location) {
+			t.Errorf("Expected diagnostic at '%s', got %v", location, diagnostics)
+		}
+	}
+}
+
+func TestOpenAPIValidatorSwaggerParameters(t *testing.T) {
+	content := `{
+		"swagger": "2.0",
+		"info": {"title": "API", "version": "1"},
+		"paths": {
+			"/upload": {
+				"post": {
+					"parameters": [
+						{"name": "body", "in": "body"},
+						{"name": "file", "in": "formData", "type": "file"},
+						{"name": "ids", "in": "query", "type": "array"},
+						{"name": "ids", "in": "query", "type": "array", "items": {"type": "string"}}
+					],
+					"responses": {"default": {"description": "OK"}}
+				}
+			}
+		}
+	}`
+
+	diagnostics := validateOpenAPI(config.DocTypeOpenAPI20, config.FormatJSON, content)
+
+	expected := []string{
+		"/paths/~1upload/post/parameters/0",
+		"/paths/~1upload/post/parameters/2",
+		"/paths/~1upload/post/parameters/3",
+		"/paths/~1upload/post/parameters",
+	}
+
+	for _, location := range expected {
+		if !hasDiagnostic(diagnostics, location) {
+			t.Errorf("Expected diagnostic at '%s', got %v", location, diagnostics)
+		}
+	}
+}
+
+func TestOpenAPIValidatorUnresolvedRefs(t *testing.T) {
+	content := `{
+		"openapi": "3.0.0",
+		"info": {"title": "API", "version": "1"},
+		"paths": {
+			"/users": {
+				"get": {
+					"responses": {
+						"200": {"$ref": "#/components/responses/Missing"},
+						"400": {"$ref": "#/components/responses/Error"},
+						"500": {"$ref": "external.yaml#/components/responses/Error"}
+					}
+				}
+			}
+		},
+		"components": {
+			"responses": {"Error": {"description": "Error"}}
+		}
+	}`
+
+	diagnostics := validateOpenAPI(config.DocTypeOpenAPI30, config.FormatJSON, content)
+
+	if len(diagnostics) != 1 {
+		t.Fatalf("Expected 1 diagnostic, got %d: %v", len(diagnostics), diagnostics)
+	}
+
+	if diagnostics[0].Location != "/paths/~1users/get/responses/200/$ref" {
+		t.Errorf("Expected location of the unresolved reference, got '%s'", diagnostics[0].Location)
+	}
+}
+
+func TestOpenAPIValidatorInvalidSchemas(t *testing.T) {
+	content := `{
+		"openapi": "3.0.0",
+		"info": {"title": "API", "version": "1"},
+		"paths": {},
+		"components": {
+			"schemas": {
+				"Pet": {
+					"type": "entity",
+					"required": "name",
+					"properties": {
+						"tags": {"type": "array"},
+						"kind": {"type": ["string", "null"]}
+					}
+				}
+			}
+		}
+	}`
+
+	diagnostics := validateOpenAPI(config.DocTypeOpenAPI30, config.FormatJSON, content)
+
+	expected := []string{
+		"/components/schemas/Pet/type",
+		"/components/schemas/Pet/required",
+		"/components/schemas/Pet/properties/tags",
+		"/components/schemas/Pet/properties/kind/type",
+	}
+
+	if len(diagnostics) != len(expected) {
+		t.Errorf("Expected %d diagnostics, got %d: %v", len(expected), len(diagnostics), diagnostics)
+	}
+
+	for _, location := range expected {
+		if !hasDiagnostic(diagnostics, location) {
+			t.Errorf("Expected diagnostic at '%s', got %v", location, diagnostics)
+		}
+	}
+}
+
+func TestOpenAPIValidatorUnparsableDocument(t *testing.T) {
+	diagnostics := validateOpenAPI(config.DocTypeOpenAPI30, config.FormatJSON, `{"openapi": `)
+
+	if len(diagnostics) != 1 {
+		t.Fatalf("Expected 1 diagnostic, got %d", len(diagnostics))
+	}
+}
+
+func TestJSONPointer(t *testing.T) {
+	pointer := document.Pointer("paths", "/users/{id}", "a~b")
+	if pointer != "/paths/~1users~1{id}/a~0b" {
+		t.Errorf("Expected escaped pointer, got '%s'", pointer)
+	}
+
+	data := map[string]interface{}{
+		"paths": map[string]interface{}{
+			"/users/{id}": map[string]interface{}{
+				"a~b": []interface{}{"first", "second"},
+			},
+		},
+	}
+
+	value, ok := resolvePointer(data, pointer+"/1")
+	if !ok || value != "second" {
+		t.Errorf("Expected 'second', got '%v'", value)
+	}
+
+	if _, ok := resolvePointer(data, "/paths/missing"); ok {
+		t.Error("Expected missing pointer to be unresolved")
+	}
+}
@@ -0,0 +1,61 @@
+package scanner
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+)
+
+// OverlayIdentifier identifies OpenAPI Overlay documents
+type OverlayIdentifier struct{}
+
+func (i *OverlayIdentifier) CanHandle(path string) bool {
+	ext := getFileExtension(path)
+	return ext == "json" || ext == "yaml" || ext == "yml"
+}
+
+func (i *OverlayIdentifier) ApiType() config.ApiType {
+	return config.ApiTypeOverlay
+}
+
+func (i *OverlayIdentifier) Identify(path string, content []byte) (*config.SpecMetadata, []string, []error) {
+	data, format, err := parseDocument(path, content)
+	if err != nil {
+		return nil, nil, nil
+	}
+
+	version := getScalarString(data, "overlay")
+	if version == "" {
+		return nil, nil, nil
+	}
+	// Later major versions are not recognized, such documents are exposed as unknown
+	if !strings.HasPrefix(version, "1.") {
+		return nil, nil, nil
+	}
+
+	var warnings []string
+	name, details := documentInfo(data)
+	if name == "" {
+		name = getFileName(path)
+		warnings = append(warnings, fmt.Sprintf("file %s: 'info.title' field is missing, using filename as name", path))
+	}
+
+	details.Extends = getString(data, "extends")
+	if actions, ok := data["actions"].([]interface{}); ok {
+		details.ActionCount = len(actions)
+	} else {
+		warnings = append(warnings, fmt.Sprintf("file %s: 'actions' field is missing or not an array", path))
+	}
+
+	return &config.SpecMetadata{
+		Name:        name,
+		FilePath:    path,
+		Type:        config.DocTypeOverlay10,
+		ApiType:     config.ApiTypeOverlay,
+		Format:      format,
+		FileId:      generateFileId(path),
+		XApiKind:    getXApiKind(path),
+		SpecDetails: details,
+	}, warnings, nil
+}
@@ -0,0 +1,30 @@
+package scanner
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+)
+
+func TestOverlayIdentifierIdentify(t *testing.T) {
+	identifier := &OverlayIdentifier{}
+
+	content := `{"overlay": "1.0.0", "extends": "../openapi.json", "actions": [{"target": "$.info", "update": {}}]}`
+	spec, warnings, errors := identifier.Identify("/overlays/public.json", []byte(content))
+	if spec == nil {
+		t.Fatalf("Expected spec to be identified, got errors %v", errors)
+	}
+	if spec.Type != config.DocTypeOverlay10 || spec.ApiType != config.ApiTypeOverlay || spec.Format != config.FormatJSON {
+		t.Errorf("Expected Overlay spec in JSON format, got %s/%s/%s", spec.Type, spec.ApiType, spec.Format)
+	}
+	if spec.Name != "public" {
+		t.Errorf("Expected file name as name, got '%s'", spec.Name)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "'info.title' field is missing") {
+		t.Errorf("Expected missing title warning, got %v", warnings)
+	}
+	if spec.Extends != "../openapi.json" || spec.ActionCount != 1 {
+		t.Errorf("Expected extends and 1 action, got '%s' and %d", spec.Extends, spec.ActionCount)
+	}
+}
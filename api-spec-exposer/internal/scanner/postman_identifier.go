@@ -0,0 +1,96 @@
+package scanner
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+)
+
+// PostmanIdentifier identifies Postman v2.0 and v2.1 collections by the 'info.schema' URL
+type PostmanIdentifier struct{}
+
+func (i *PostmanIdentifier) CanHandle(path string) bool {
+	return getFileExtension(path) == "json"
+}
+
+func (i *PostmanIdentifier) ApiType() config.ApiType {
+	return config.ApiTypePostman
+}
+
+func (i *PostmanIdentifier) Identify(path string, content []byte) (*config.SpecMetadata, []string, []error) {
+	data, err := parseJSON(content)
+	if err != nil {
+		return nil, nil, nil
+	}
+
+	info, ok := data["info"].(map[string]interface{})
+	if !ok {
+		return nil, nil, nil
+	}
+	schema := getString(info, "schema")
+	// e.g. https://schema.getpostman.com/json/collection/v2.1.0/collection.json
+	if !strings.Contains(schema, "postman.com/") {
+		return nil, nil, nil
+	}
+
+	var docType config.DocumentType
+	switch {
+	case strings.Contains(schema, "/v2.1."):
+		docType = config.DocTypePostmanCollection21
+	case strings.Contains(schema, "/v2.0."):
+		docType = config.DocTypePostmanCollection20
+	default:
+		// Collections of format v1 have no 'info' object, later formats are exposed as unknown
+		return nil, nil, nil
+	}
+
+	var warnings []string
+	name := getString(info, "name")
+	if name == "" {
+		name = getFileName(path)
+		warnings = append(warnings, fmt.Sprintf("file %s: 'info.name' field is missing, using filename as name", path))
+	}
+
+	var details config.SpecDetails
+	details.Version = getScalarString(info, "version")
+	switch description := info["description"].(type) {
+	case string:
+		details.Description = description
+	case map[string]interface{}:
+		details.Description = getString(description, "content")
+	}
+	details.RequestCount = countPostmanRequests(data["item"])
+
+	return &config.SpecMetadata{
+		Name:        name,
+		FilePath:    path,
+		Type:        docType,
+		ApiType:     config.ApiTypePostman,
+		Format:      config.FormatJSON,
+		FileId:      generateFileId(path),
+		XApiKind:    getXApiKind(path),
+		SpecDetails: details,
+	}, warnings, nil
+}
+
+// countPostmanRequests counts the requests of a collection item list, including the requests of nested folders
+func countPostmanRequests(items interface{}) int {
+	list, ok := items.([]interface{})
+	if !ok {
+		return 0
+	}
+
+	count := 0
+	for _, entry := range list {
+		item, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if _, ok := item["request"]; ok {
+			count++
+		}
+		count += countPostmanRequests(item["item"])
+	}
+	return count
+}
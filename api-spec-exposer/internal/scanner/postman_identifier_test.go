@@ -0,0 +1,82 @@
+package scanner
+
+import (
+	"testing"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+)
+
+const testPostmanCollection = `{
+	"info": {
+		"name": "Pet Store",
+		"version": "1.2.0",
+		"description": {"content": "Pet requests", "type": "text/markdown"},
+		"schema": "https://schema.getpostman.com/json/collection/v2.1.0/collection.json"
+	},
+	"item": [
+		{"name": "Pets", "item": [
+			{"name": "List pets", "request": {"method": "GET", "url": "{{baseUrl}}/pets"}},
+			{"name": "Nested", "item": [{"name": "Get pet", "request": "{{baseUrl}}/pets/1"}]}
+		]},
+		{"name": "Health", "request": {"method": "GET", "url": "{{baseUrl}}/health"}}
+	]
+}`
+
+func TestPostmanIdentifierIdentify(t *testing.T) {
+	identifier := &PostmanIdentifier{}
+
+	spec, warnings, errors := identifier.Identify("/collections/pets.postman_collection.json", []byte(testPostmanCollection))
+	if spec == nil {
+		t.Fatalf("Expected spec to be identified, got errors %v", errors)
+	}
+	if len(warnings) != 0 {
+		t.Errorf("Expected no warnings, got %v", warnings)
+	}
+	if spec.Type != config.DocTypePostmanCollection21 || spec.ApiType != config.ApiTypePostman || spec.Format != config.FormatJSON {
+		t.Errorf("Expected Postman v2.1 collection in JSON format, got %s/%s/%s", spec.Type, spec.ApiType, spec.Format)
+	}
+	if spec.Name != "Pet Store" || spec.Version != "1.2.0" || spec.Description != "Pet requests" {
+		t.Errorf("Unexpected info details: %s, %s, %s", spec.Name, spec.Version, spec.Description)
+	}
+	if spec.RequestCount != 3 {
+		t.Errorf("Expected 3 requests, got %d", spec.RequestCount)
+	}
+
+	spec, warnings, _ = identifier.Identify("/collections/legacy.json", []byte(`{"info": {"schema": "https://schema.getpostman.com/json/collection/v2.0.0/collection.json"}, "item": []}`))
+	if spec == nil || spec.Type != config.DocTypePostmanCollection20 {
+		t.Fatalf("Expected Postman v2.0 collection, got %v", spec)
+	}
+	if spec.Name != "legacy" || len(warnings) != 1 {
+		t.Errorf("Expected filename as name with a warning, got '%s', %v", spec.Name, warnings)
+	}
+
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"openapi document", `{"openapi": "3.0.0", "info": {"title": "API"}}`},
+		{"other schema", `{"info": {"name": "API", "schema": "https://example.com/collection.json"}}`},
+		{"unsupported version", `{"info": {"name": "API", "schema": "https://schema.postman.com/json/collection/v3.0.0/collection.json"}}`},
+		{"invalid json", `{"info": `},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec, warnings, errors := identifier.Identify("/collections/other.json", []byte(tt.content))
+			if spec != nil || len(warnings) > 0 || len(errors) > 0 {
+				t.Errorf("Expected file to be skipped, got spec %v, warnings %v, errors %v", spec, warnings, errors)
+			}
+		})
+	}
+}
+
+func TestIdentifierChainPostmanCollections(t *testing.T) {
+	chain := newTestIdentifierChain(nil, false)
+
+	spec, _, errors := chain.Identify("/collections/pets.json", []byte(testPostmanCollection))
+	if spec == nil {
+		t.Fatalf("Expected spec to be identified, got errors %v", errors)
+	}
+	if spec.ApiType != config.ApiTypePostman {
+		t.Errorf("Expected API type %s, got %s", config.ApiTypePostman, spec.ApiType)
+	}
+}
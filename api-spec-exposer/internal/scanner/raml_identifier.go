@@ -0,0 +1,123 @@
+package scanner
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/document"
+)
+
+// ramlHeaderPattern matches the '#%RAML <version> [<fragment>]' first line of RAML documents
+var ramlHeaderPattern = regexp.MustCompile(`^#%RAML[ \t]+(0\.8|1\.0)(?:[ \t]+(\S+))?[ \t]*$`)
+
+// RAMLIdentifier identifies RAML 0.8 and 1.0 API definitions
+type RAMLIdentifier struct{}
+
+func (i *RAMLIdentifier) CanHandle(path string) bool {
+	return getFileExtension(path) == "raml"
+}
+
+func (i *RAMLIdentifier) ApiType() config.ApiType {
+	return config.ApiTypeRAML
+}
+
+func (i *RAMLIdentifier) Identify(path string, content []byte) (*config.SpecMetadata, []string, []error) {
+	version, fragment := ramlHeader(content)
+	// Fragments (libraries, data types, traits, ...) are not API definitions on their own
+	if version == "" || fragment != "" {
+		return nil, nil, nil
+	}
+
+	data, err := parseYAML(content)
+	if err != nil {
+		return nil, nil, []error{fmt.Errorf("failed to parse RAML file %s: %w", path, err)}
+	}
+
+	var warnings []string
+	name := getFileName(path)
+	if title := getScalarString(data, "title"); title != "" {
+		name = title
+	} else {
+		warnings = append(warnings, fmt.Sprintf("file %s: 'title' field is missing or empty, using filename as name", path))
+	}
+
+	docType := config.DocTypeRAML10
+	if version == "0.8" {
+		docType = config.DocTypeRAML08
+	}
+
+	return &config.SpecMetadata{
+		Name:        name,
+		FilePath:    path,
+		Type:        docType,
+		ApiType:     config.ApiTypeRAML,
+		Format:      config.FormatRAML,
+		FileId:      generateFileId(path),
+		XApiKind:    getXApiKind(path),
+		SpecDetails: extractRAMLDetails(data),
+	}, warnings, nil
+}
+
+// ramlHeader returns the RAML version and the fragment type declared on the first line, empty if there is no RAML header
+func ramlHeader(content []byte) (string, string) {
+	line := bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))
+	if index := bytes.IndexByte(line, '\n'); index >= 0 {
+		line = line[:index]
+	}
+	match := ramlHeaderPattern.FindSubmatch(bytes.TrimRight(line, "\r"))
+	if match == nil {
+		return "", ""
+	}
+	return string(match[1]), string(match[2])
+}
+
+// extractRAMLDetails collects descriptive metadata (@config.SpecDetails) from a RAML API definition
+func extractRAMLDetails(data map[string]interface{}) config.SpecDetails {
+	var details config.SpecDetails
+
+	details.Version = getScalarString(data, "version")
+	details.Description = getString(data, "description")
+	if baseUri := getString(data, "baseUri"); baseUri != "" {
+		details.Servers = []string{baseUri}
+	}
+
+	// RAML 1.0 declares security schemes as a map, RAML 0.8 as a list of single-entry maps
+	switch schemes := data["securitySchemes"].(type) {
+	case map[string]interface{}:
+		details.SecuritySchemes = document.SortedKeys(schemes)
+	case []interface{}:
+		for _, item := range schemes {
+			if scheme, ok := item.(map[string]interface{}); ok {
+				details.SecuritySchemes = append(details.SecuritySchemes, document.SortedKeys(scheme)...)
+			}
+		}
+	}
+
+	details.OperationCount = countRAMLMethods(data)
+
+	return details
+}
+
+// countRAMLMethods counts the methods of the resources declared in a RAML node and its nested resources
+func countRAMLMethods(node map[string]interface{}) int {
+	count := 0
+	for key, value := range node {
+		if !strings.HasPrefix(key, "/") {
+			continue
+		}
+		resource, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, method := range document.OperationMethods {
+			if _, ok := resource[method]; ok {
+				count++
+			}
+		}
+		count += countRAMLMethods(resource)
+	}
+	return count
+}
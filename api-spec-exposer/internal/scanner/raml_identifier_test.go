@@ -0,0 +1,137 @@
+package scanner
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+)
+
+func TestRAMLIdentifierIdentify(t *testing.T) {
+	identifier := &RAMLIdentifier{}
+
+	tests := []struct {
+		name               string
+		content            string
+		expectedType       config.DocumentType
+		expectedName       string
+		expectedOperations int
+		expectedWarnings   int
+		expectedNoMatch    bool
+		expectedError      bool
+	}{
+		{
+			name: "raml 1.0",
+			content: `#%RAML 1.0
+title: Notes API
+version: v2
+baseUri: https://api.example.com/{version}
+securitySchemes:
+  oauth_2_0: !include security/oauth.raml
+types: !include types.raml
+/notes:
+  get:
+  post:
+    body:
+      application/json:
+        type: Note
+  /{id}:
+    get:
+    delete:
+/health:
+  get:
+`,
+			expectedType:       config.DocTypeRAML10,
+			expectedName:       "Notes API",
+			expectedOperations: 5,
+		},
+		{
+			name:               "raml 0.8 without title",
+			content:            "#%RAML 0.8\r\nversion: v1\r\n/users:\r\n  get:\r\n",
+			expectedType:       config.DocTypeRAML08,
+			expectedName:       "api",
+			expectedOperations: 1,
+			expectedWarnings:   1,
+		},
+		{
+			name:            "library fragment",
+			content:         "#%RAML 1.0 Library\ntypes:\n  Note: object\n",
+			expectedNoMatch: true,
+		},
+		{
+			name:            "missing header",
+			content:         "title: Notes API\n",
+			expectedNoMatch: true,
+		},
+		{
+			name:          "invalid yaml",
+			content:       "#%RAML 1.0\ntitle: [unclosed\n",
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec, warnings, errors := identifier.Identify("/specs/api.raml", []byte(tt.content))
+			if tt.expectedError {
+				if spec != nil || len(errors) == 0 {
+					t.Errorf("Expected parse error, got spec %v, errors %v", spec, errors)
+				}
+				return
+			}
+			if tt.expectedNoMatch {
+				if spec != nil || len(errors) > 0 {
+					t.Errorf("Expected file to be skipped, got spec %v, errors %v", spec, errors)
+				}
+				return
+			}
+			if spec == nil {
+				t.Fatalf("Expected spec to be identified, got errors %v", errors)
+			}
+			if spec.Type != tt.expectedType || spec.ApiType != config.ApiTypeRAML || spec.Format != config.FormatRAML {
+				t.Errorf("Expected %s RAML spec, got %s/%s/%s", tt.expectedType, spec.Type, spec.ApiType, spec.Format)
+			}
+			if spec.Name != tt.expectedName {
+				t.Errorf("Expected name '%s', got '%s'", tt.expectedName, spec.Name)
+			}
+			if spec.OperationCount != tt.expectedOperations {
+				t.Errorf("Expected %d operations, got %d", tt.expectedOperations, spec.OperationCount)
+			}
+			if len(warnings) != tt.expectedWarnings {
+				t.Errorf("Expected %d warnings, got %v", tt.expectedWarnings, warnings)
+			}
+		})
+	}
+}
+
+func TestExtractRAMLDetails(t *testing.T) {
+	content := `#%RAML 0.8
+title: Users
+version: 1.2
+description: User management
+baseUri: https://users.example.com
+securitySchemes:
+  - basic:
+      type: Basic Authentication
+  - oauth_2_0:
+      type: OAuth 2.0
+`
+	data, err := parseYAML([]byte(content))
+	if err != nil {
+		t.Fatalf("Failed to parse test content: %v", err)
+	}
+
+	details := extractRAMLDetails(data)
+	if details.Version != "1.2" {
+		t.Errorf("Expected version '1.2', got '%s'", details.Version)
+	}
+	if details.Description != "User management" {
+		t.Errorf("Expected description, got '%s'", details.Description)
+	}
+	if strings.Join(details.Servers, ",") != "https://users.example.com" {
+		t.Errorf("Expected base URI as server, got %v", details.Servers)
+	}
+	if strings.Join(details.SecuritySchemes, ",") != "basic,oauth_2_0" {
+		t.Errorf("Expected security schemes [basic oauth_2_0], got %v", details.SecuritySchemes)
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"strings"
 
 	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/converter"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/document"
 )
 
 // RestIdentifier identifies OpenAPI specifications
@@ -15,25 +17,17 @@ func (i *RestIdentifier) CanHandle(path string) bool {
 	return ext == "json" || ext == "yaml" || ext == "yml"
 }
 
+func (i *RestIdentifier) ApiType() config.ApiType {
+	return config.ApiTypeRest
+}
+
 func (i *RestIdentifier) Identify(path string, content []byte) (*config.SpecMetadata, []string, []error) {
-	var data map[string]interface{}
-	var format config.Format
-	var err error
 	var warnings []string
 
-	ext := getFileExtension(path)
-	if ext == "json" {
-		data, err = parseJSON(content)
-		format = config.FormatJSON
-	} else if ext == "yaml" || ext == "yml" {
-		data, err = parseYAML(content)
-		format = config.FormatYAML
-	} else {
-		return nil, nil, nil
-	}
-
+	// Files with other extensions reach the identifier through extension mappings or content sniffing
+	data, format, err := parseDocument(path, content)
 	if err != nil {
-		return nil, nil, []error{fmt.Errorf("failed to parse %s file %s: %w", ext, path, err)}
+		return nil, nil, []error{fmt.Errorf("failed to parse %s file %s: %w", getFileExtension(path), path, err)}
 	}
 
 	openapiVersion := getString(data, "openapi")
@@ -43,6 +37,11 @@ func (i *RestIdentifier) Identify(path string, content []byte) (*config.SpecMeta
 		return nil, nil, nil
 	}
 
+	// OpenAPI documents never declare a JSON Schema draft, such files are standalone schemas
+	if jsonSchemaDialect(data) != "" {
+		return nil, nil, nil
+	}
+
 	name := getFileName(path)
 
 	if !hasKey(data, "info") {
@@ -73,23 +72,99 @@ func (i *RestIdentifier) Identify(path string, content []byte) (*config.SpecMeta
 		return nil, nil, nil
 	}
 
-	xApiKind := getString(data, "x-api-kind")
-	if xApiKind != "" {
-		if val := strings.ToLower(xApiKind); val != "bwc" && val != "no-bwc" {
-			warnings = append(warnings, fmt.Sprintf("file %s: 'x-api-kind' has invalid value '%s', using default 'BWC'", path, xApiKind))
-			xApiKind = "BWC"
-		}
-	} else {
-		xApiKind = getXApiKind(path)
+	xApiKind, warning := resolveXApiKind(path, getString(data, "x-api-kind"))
+	if warning != "" {
+		warnings = append(warnings, warning)
 	}
 
 	return &config.SpecMetadata{
-		Name:     name,
-		FilePath: path,
-		Type:     docType,
-		ApiType:  config.ApiTypeRest,
-		Format:   format,
-		FileId:   generateFileId(path),
-		XApiKind: xApiKind,
+		Name:        name,
+		FilePath:    path,
+		Type:        docType,
+		ApiType:     config.ApiTypeRest,
+		Format:      format,
+		FileId:      generateFileId(path),
+		XApiKind:    xApiKind,
+		SpecDetails: extractRestDetails(data),
 	}, warnings, nil
 }
+
+// extractRestDetails collects descriptive metadata (@config.SpecDetails) from an OpenAPI document
+func extractRestDetails(data map[string]interface{}) config.SpecDetails {
+	var details config.SpecDetails
+
+	if info, ok := data["info"].(map[string]interface{}); ok {
+		details.Version = getScalarString(info, "version")
+		details.Description = getString(info, "description")
+
+		if contact, ok := info["contact"].(map[string]interface{}); ok {
+			details.Contact = &config.Contact{
+				Name:  getString(contact, "name"),
+				URL:   getString(contact, "url"),
+				Email: getString(contact, "email"),
+			}
+		}
+
+		if license, ok := info["license"].(map[string]interface{}); ok {
+			details.License = &config.License{
+				Name: getString(license, "name"),
+				URL:  getString(license, "url"),
+			}
+		}
+	}
+
+	if tags, ok := data["tags"].([]interface{}); ok {
+		for _, item := range tags {
+			if tag, ok := item.(map[string]interface{}); ok {
+				if name := getString(tag, "name"); name != "" {
+					details.Tags = append(details.Tags, name)
+				}
+			}
+		}
+	}
+
+	details.Servers = extractServerURLs(data)
+
+	if paths, ok := data["paths"].(map[string]interface{}); ok {
+		for _, item := range paths {
+			pathItem, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for _, method := range document.OperationMethods {
+				if _, ok := pathItem[method].(map[string]interface{}); ok {
+					details.OperationCount++
+				}
+			}
+		}
+	}
+
+	securitySchemes, ok := data["securityDefinitions"].(map[string]interface{})
+	if !ok {
+		if components, isMap := data["components"].(map[string]interface{}); isMap {
+			securitySchemes, _ = components["securitySchemes"].(map[string]interface{})
+		}
+	}
+	if len(securitySchemes) > 0 {
+		details.SecuritySchemes = document.SortedKeys(securitySchemes)
+	}
+
+	return details
+}
+
+func extractServerURLs(data map[string]interface{}) []string {
+	servers, ok := data["servers"].([]interface{})
+	if !ok {
+		return converter.Swagger20ServerURLs(data)
+	}
+
+	var urls []string
+	for _, item := range servers {
+		if server, ok := item.(map[string]interface{}); ok {
+			if url := getString(server, "url"); url != "" {
+				urls = append(urls, url)
+			}
+		}
+	}
+	return urls
+}
@@ -406,4 +406,116 @@ func TestRestIdentifierIdentifyInvalidYAML(t *testing.T) {
 	}
 }
 
+func TestRestIdentifierIdentifyDetails(t *testing.T) {
+	identifier := &RestIdentifier{}
+	content := []byte(`
+openapi: 3.0.0
+info:
+  title: Test API
+  version: 1.2
+  description: Service API
+  contact:
+    name: API Team
+    email: api@example.com
+  license:
+    name: Apache 2.0
+    url: https://www.apache.org/licenses/LICENSE-2.0
+tags:
+  - name: users
+  - name: orders
+servers:
+  - url: https://api.example.com/v1
+paths:
+  /users:
+    get:
+      responses:
+        "200":
+          description: OK
+    post:
+      responses:
+        "201":
+          description: Created
+  /orders:
+    parameters: []
+    get:
+      responses:
+        "200":
+          description: OK
+components:
+  securitySchemes:
+    oauth:
+      type: oauth2
+    apiKey:
+      type: apiKey
+`)
+
+	spec, _, errors := identifier.Identify("test.yaml", content)
+
+	if len(errors) != 0 {
+		t.Fatalf("Expected no errors, got %v", errors)
+	}
+
+	if spec.Version != "1.2" {
+		t.Errorf("Expected version '1.2', got '%s'", spec.Version)
+	}
+
+	if spec.Description != "Service API" {
+		t.Errorf("Expected description 'Service API', got '%s'", spec.Description)
+	}
+
+	if spec.Contact == nil || spec.Contact.Name != "API Team" || spec.Contact.Email != "api@example.com" {
+		t.Errorf("Expected contact to be extracted, got %+v", spec.Contact)
+	}
 
+	if spec.License == nil || spec.License.Name != "Apache 2.0" {
+		t.Errorf("Expected license to be extracted, got %+v", spec.License)
+	}
+
+	if len(spec.Tags) != 2 || spec.Tags[0] != "users" || spec.Tags[1] != "orders" {
+		t.Errorf("Expected tags [users orders], got %v", spec.Tags)
+	}
+
+	if len(spec.Servers) != 1 || spec.Servers[0] != "https://api.example.com/v1" {
+		t.Errorf("Expected servers [https://api.example.com/v1], got %v", spec.Servers)
+	}
+
+	if spec.OperationCount != 3 {
+		t.Errorf("Expected 3 operations, got %d", spec.OperationCount)
+	}
+
+	if len(spec.SecuritySchemes) != 2 || spec.SecuritySchemes[0] != "apiKey" || spec.SecuritySchemes[1] != "oauth" {
+		t.Errorf("Expected security schemes [apiKey oauth], got %v", spec.SecuritySchemes)
+	}
+}
+
+func TestRestIdentifierIdentifySwaggerDetails(t *testing.T) {
+	identifier := &RestIdentifier{}
+	content := []byte(`{
+		"swagger": "2.0",
+		"info": {"title": "Legacy API", "version": "2.0.1"},
+		"host": "legacy.example.com",
+		"basePath": "/api/",
+		"schemes": ["http", "https"],
+		"securityDefinitions": {"basic": {"type": "basic"}},
+		"paths": {}
+	}`)
+
+	spec, _, _ := identifier.Identify("test.json", content)
+
+	if spec == nil {
+		t.Fatal("Expected spec to be identified, got nil")
+	}
+
+	expectedServers := []string{"http://legacy.example.com/api", "https://legacy.example.com/api"}
+	if len(spec.Servers) != 2 || spec.Servers[0] != expectedServers[0] || spec.Servers[1] != expectedServers[1] {
+		t.Errorf("Expected servers %v, got %v", expectedServers, spec.Servers)
+	}
+
+	if len(spec.SecuritySchemes) != 1 || spec.SecuritySchemes[0] != "basic" {
+		t.Errorf("Expected security schemes [basic], got %v", spec.SecuritySchemes)
+	}
+
+	if spec.Contact != nil || spec.License != nil {
+		t.Error("Expected no contact and license")
+	}
+}
@@ -8,46 +8,68 @@ import (
 	"strings"
 
 	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/archive"
 )
 
 // Scanner handles directory scanning and file discovery
 type Scanner struct {
 	config          config.DiscoveryConfig
 	identifierChain *IdentifierChain
+	validators      []Validator
 }
 
 // New creates a new scanner instance
 func New(cfg config.DiscoveryConfig) *Scanner {
+	var validators []Validator
+	if cfg.ValidateSpecs {
+		validators = []Validator{
+			&OpenAPIValidator{},
+		}
+	}
+
 	return &Scanner{
 		config: cfg,
 		identifierChain: &IdentifierChain{
 			identifiers: []Identifier{
+				&JSONSchemaIdentifier{},
 				&RestIdentifier{},
+				&ArazzoIdentifier{},
+				&OverlayIdentifier{},
+				&PostmanIdentifier{},
 				&GraphQLIdentifier{},
+				&RAMLIdentifier{},
+				&APIBlueprintIdentifier{},
 				&MarkdownIdentifier{},
+				&ODataIdentifier{},
+				&AvroIdentifier{},
+				&SOAPIdentifier{},
 				&BasicIdentifier{},
 			},
+			extensionMappings: newExtensionMappings(cfg.ExtensionMappings),
+			contentSniffing:   cfg.ContentSniffing,
 		},
+		validators: validators,
 	}
 }
 
-// Scan scans the directory and returns spec metadata (@config.SpecMetadata), warnings, and errors
-func (s *Scanner) Scan() ([]config.SpecMetadata, []string, []error) {
+// Scan scans the directory and returns spec metadata (@config.SpecMetadata), validation diagnostics, warnings, and errors
+func (s *Scanner) Scan() ([]config.SpecMetadata, []config.Diagnostic, []string, []error) {
 	var specs []config.SpecMetadata
+	var diagnostics []config.Diagnostic
 	var warnings []string
 	var errors []error
 
 	if s.config.ScanDirectory == "" {
-		return nil, warnings, []error{fmt.Errorf("scan directory property is empty")}
+		return nil, nil, warnings, []error{fmt.Errorf("scan directory property is empty")}
 	}
 
 	info, err := os.Stat(s.config.ScanDirectory)
 	if err != nil {
-		return nil, warnings, []error{fmt.Errorf("cannot access scan directory: %w", err)}
+		return nil, nil, warnings, []error{fmt.Errorf("cannot access scan directory: %w", err)}
 	}
 
 	if !info.IsDir() {
-		return nil, warnings, []error{fmt.Errorf("scan directory is not a directory")}
+		return nil, nil, warnings, []error{fmt.Errorf("scan directory is not a directory")}
 	}
 
 	err = filepath.WalkDir(s.config.ScanDirectory, func(path string, d os.DirEntry, err error) error {
@@ -69,21 +91,28 @@ func (s *Scanner) Scan() ([]config.SpecMetadata, []string, []error) {
 			return nil
 		}
 
+		if s.config.ArchiveScanning.Enabled && archive.IsArchive(path) {
+			archiveSpecs, archiveDiagnostics, archiveWarnings, archiveErrors := s.scanArchive(path)
+			specs = append(specs, archiveSpecs...)
+			diagnostics = append(diagnostics, archiveDiagnostics...)
+			warnings = append(warnings, archiveWarnings...)
+			errors = append(errors, archiveErrors...)
+			return nil
+		}
+
 		content, err := s.readFile(path)
 		if err != nil {
 			errors = append(errors, fmt.Errorf("cannot read file %s: %w", path, err))
 			return nil
 		}
 
-		spec, specWarnings, specErrors := s.identifierChain.Identify(path, content)
+		fileSpecs, fileDiagnostics, specWarnings, specErrors := s.identifyFile(path, content)
 
+		specs = append(specs, fileSpecs...)
+		diagnostics = append(diagnostics, fileDiagnostics...)
 		warnings = append(warnings, specWarnings...)
 		errors = append(errors, specErrors...)
 
-		if spec != nil {
-			specs = append(specs, *spec)
-		}
-
 		return nil
 	})
 
@@ -91,7 +120,33 @@ func (s *Scanner) Scan() ([]config.SpecMetadata, []string, []error) {
 		errors = append(errors, fmt.Errorf("error walking directory: %w", err))
 	}
 
-	return specs, warnings, errors
+	if s.config.GraphQLStitching.Enabled {
+		var stitchingErrors []error
+		specs, stitchingErrors = s.stitchGraphQLSchemas(specs)
+		errors = append(errors, stitchingErrors...)
+	}
+
+	var importWarnings []string
+	specs, importWarnings = s.groupWSDLImports(specs)
+	warnings = append(warnings, importWarnings...)
+	warnings = append(warnings, s.linkOpenAPIDocuments(specs)...)
+
+	if s.config.BundleMarkdownAssets {
+		var bundleWarnings []string
+		specs, bundleWarnings = s.bundleMarkdownAssets(specs)
+		warnings = append(warnings, bundleWarnings...)
+	}
+
+	if s.config.ComposeSupergraph {
+		supergraph, err := s.composeSupergraph(specs)
+		if err != nil {
+			errors = append(errors, err)
+		} else if supergraph != nil {
+			specs = append(specs, *supergraph)
+		}
+	}
+
+	return specs, diagnostics, warnings, errors
 }
 
 func (s *Scanner) shouldExclude(path string) bool {
@@ -34,7 +34,7 @@ func TestScannerScanWithHiddenFiles(t *testing.T) {
 	}
 
 	scanner := New(cfg)
-	specs, warnings, errors := scanner.Scan()
+	specs, _, warnings, errors := scanner.Scan()
 
 	if len(specs) != 1 {
 		t.Fatalf("Expected 1 spec (hidden file excluded), got %d", len(specs))
@@ -60,7 +60,7 @@ func TestScannerScanInvalidDirectory(t *testing.T) {
 	}
 
 	scanner := New(cfg)
-	specs, warnings, errors := scanner.Scan()
+	specs, _, warnings, errors := scanner.Scan()
 
 	if len(specs) != 0 {
 		t.Errorf("Expected 0 specs for invalid directory, got %d", len(specs))
@@ -82,7 +82,7 @@ func TestScannerScanEmptyScanDirectory(t *testing.T) {
 	}
 
 	scanner := New(cfg)
-	specs, warnings, errors := scanner.Scan()
+	specs, _, warnings, errors := scanner.Scan()
 
 	if len(specs) != 0 {
 		t.Errorf("Expected 0 specs for empty scan directory, got %d", len(specs))
@@ -167,3 +167,64 @@ func TestScannerReadFileNonexistent(t *testing.T) {
 		t.Error("Expected error for nonexistent file, got nil")
 	}
 }
+
+func TestScannerScanWithValidation(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "scanner-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	files := map[string][]byte{
+		"valid.json":   []byte(`{"openapi": "3.0.0", "info": {"title": "Valid", "version": "1.0.0"}, "paths": {}}`),
+		"invalid.json": []byte(`{"openapi": "3.0.0", "info": {"title": "Invalid", "version": "1.0.0"}}`),
+		"schema.graphql": []byte(`type Query {
+			hello: String
+		}`),
+	}
+
+	for name, content := range files {
+		path := filepath.Join(tempDir, name)
+		err = os.WriteFile(path, content, 0644)
+		if err != nil {
+			t.Fatalf("Failed to write test file %s: %v", name, err)
+		}
+	}
+
+	tests := []struct {
+		name                string
+		validateSpecs       bool
+		expectedDiagnostics int
+	}{
+		{"validation disabled", false, 0},
+		{"validation enabled", true, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.DiscoveryConfig{
+				ScanDirectory: tempDir,
+				ValidateSpecs: tt.validateSpecs,
+			}
+
+			scanner := New(cfg)
+			specs, diagnostics, _, errors := scanner.Scan()
+
+			if len(specs) != 3 {
+				t.Errorf("Expected 3 specs (invalid specs are still exposed), got %d", len(specs))
+			}
+
+			if len(errors) != 0 {
+				t.Errorf("Expected 0 errors, got %d", len(errors))
+			}
+
+			if len(diagnostics) != tt.expectedDiagnostics {
+				t.Fatalf("Expected %d diagnostics, got %d: %v", tt.expectedDiagnostics, len(diagnostics), diagnostics)
+			}
+
+			if tt.expectedDiagnostics > 0 && diagnostics[0].FilePath != filepath.Join(tempDir, "invalid.json") {
+				t.Errorf("Expected diagnostic for invalid.json, got '%s'", diagnostics[0].FilePath)
+			}
+		})
+	}
+}
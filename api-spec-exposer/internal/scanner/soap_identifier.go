@@ -0,0 +1,64 @@
+package scanner
+
+import (
+	"fmt"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/wsdl"
+)
+
+// SOAPIdentifier identifies WSDL 1.1, WSDL 2.0 and XSD documents
+type SOAPIdentifier struct{}
+
+var soapDocumentTypes = map[wsdl.Kind]config.DocumentType{
+	wsdl.KindWSDL11: config.DocTypeWSDL11,
+	wsdl.KindWSDL20: config.DocTypeWSDL20,
+	wsdl.KindXSD:    config.DocTypeXSD,
+}
+
+func (i *SOAPIdentifier) CanHandle(path string) bool {
+	ext := getFileExtension(path)
+	return ext == "wsdl" || ext == "xsd" || ext == "xml"
+}
+
+func (i *SOAPIdentifier) ApiType() config.ApiType {
+	return config.ApiTypeSOAP
+}
+
+func (i *SOAPIdentifier) Identify(path string, content []byte) (*config.SpecMetadata, []string, []error) {
+	doc, err := wsdl.Parse(content)
+	if err != nil {
+		// Arbitrary .xml files are left to other identifiers
+		if getFileExtension(path) == "xml" {
+			return nil, nil, nil
+		}
+		return nil, nil, []error{fmt.Errorf("failed to parse XML file %s: %w", path, err)}
+	}
+	if doc == nil {
+		return nil, nil, nil
+	}
+
+	name := getFileName(path)
+	if len(doc.Services) > 0 {
+		name = doc.Services[0]
+	} else if doc.Name != "" {
+		name = doc.Name
+	}
+
+	return &config.SpecMetadata{
+		Name:     name,
+		FilePath: path,
+		Type:     soapDocumentTypes[doc.Kind],
+		ApiType:  config.ApiTypeSOAP,
+		Format:   config.FormatXML,
+		FileId:   generateFileId(path),
+		XApiKind: getXApiKind(path),
+		SpecDetails: config.SpecDetails{
+			SOAPDetails: config.SOAPDetails{
+				TargetNamespace:    doc.TargetNamespace,
+				Services:           doc.Services,
+				SOAPOperationCount: doc.OperationCount,
+			},
+		},
+	}, nil, nil
+}
@@ -0,0 +1,209 @@
+package scanner
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+)
+
+func TestSOAPIdentifierIdentify(t *testing.T) {
+	identifier := &SOAPIdentifier{}
+
+	tests := []struct {
+		name            string
+		path            string
+		content         string
+		expectedType    config.DocumentType
+		expectedName    string
+		expectedTNS     string
+		expectedOps     int
+		expectedNoMatch bool
+		expectedError   bool
+	}{
+		{
+			name:         "wsdl 1.1 named by service",
+			path:         "/soap/orders.wsdl",
+			content:      `<definitions xmlns="http://schemas.xmlsoap.org/wsdl/" name="Orders" targetNamespace="urn:orders"><portType name="P"><operation name="Get"/></portType><service name="OrderService"/></definitions>`,
+			expectedType: config.DocTypeWSDL11,
+			expectedName: "OrderService",
+			expectedTNS:  "urn:orders",
+			expectedOps:  1,
+		},
+		{
+			name:         "wsdl 2.0 in xml file",
+			path:         "/soap/users.xml",
+			content:      `<description xmlns="http://www.w3.org/ns/wsdl" targetNamespace="urn:users"/>`,
+			expectedType: config.DocTypeWSDL20,
+			expectedName: "users",
+			expectedTNS:  "urn:users",
+		},
+		{
+			name:         "xsd",
+			path:         "/soap/types.xsd",
+			content:      `<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" targetNamespace="urn:types"/>`,
+			expectedType: config.DocTypeXSD,
+			expectedName: "types",
+			expectedTNS:  "urn:types",
+		},
+		{
+			name:            "other xml",
+			path:            "/config/pom.xml",
+			content:         `<project><name>app</name></project>`,
+			expectedNoMatch: true,
+		},
+		{
+			name:            "malformed xml file",
+			path:            "/config/broken.xml",
+			content:         `<project>`,
+			expectedNoMatch: true,
+		},
+		{
+			name:          "malformed wsdl",
+			path:          "/soap/broken.wsdl",
+			content:       `<definitions xmlns="http://schemas.xmlsoap.org/wsdl/">`,
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec, _, errors := identifier.Identify(tt.path, []byte(tt.content))
+			if tt.expectedError {
+				if spec != nil || len(errors) == 0 {
+					t.Errorf("Expected parse error, got spec %v, errors %v", spec, errors)
+				}
+				return
+			}
+			if tt.expectedNoMatch {
+				if spec != nil || len(errors) > 0 {
+					t.Errorf("Expected file to be skipped, got spec %v, errors %v", spec, errors)
+				}
+				return
+			}
+			if spec == nil {
+				t.Fatalf("Expected spec to be identified, got errors %v", errors)
+			}
+			if spec.Type != tt.expectedType || spec.ApiType != config.ApiTypeSOAP || spec.Format != config.FormatXML {
+				t.Errorf("Expected %s SOAP spec in XML format, got %s/%s/%s", tt.expectedType, spec.Type, spec.ApiType, spec.Format)
+			}
+			if spec.Name != tt.expectedName {
+				t.Errorf("Expected name '%s', got '%s'", tt.expectedName, spec.Name)
+			}
+			if spec.TargetNamespace != tt.expectedTNS {
+				t.Errorf("Expected target namespace '%s', got '%s'", tt.expectedTNS, spec.TargetNamespace)
+			}
+			if spec.SOAPOperationCount != tt.expectedOps {
+				t.Errorf("Expected %d operations, got %d", tt.expectedOps, spec.SOAPOperationCount)
+			}
+		})
+	}
+}
+
+func TestScannerGroupWSDLImports(t *testing.T) {
+	tempDir := writeStitchingFiles(t, map[string]string{
+		"soap/orders.wsdl": `<definitions xmlns="http://schemas.xmlsoap.org/wsdl/" xmlns:xs="http://www.w3.org/2001/XMLSchema">
+  <types><xs:schema><xs:import schemaLocation="types/orders.xsd"/><xs:import schemaLocation="missing.xsd"/></xs:schema></types>
+  <service name="OrderService"/>
+</definitions>`,
+		"soap/types/orders.xsd":  `<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema"><xs:include schemaLocation="../common/common.xsd"/></xs:schema>`,
+		"soap/common/common.xsd": `<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema"/>`,
+		"soap/standalone.xsd":    `<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema"/>`,
+	})
+	defer os.RemoveAll(tempDir)
+
+	specs, _, warnings, errors := New(config.DiscoveryConfig{ScanDirectory: tempDir}).Scan()
+
+	if len(errors) != 0 {
+		t.Errorf("Expected no errors, got %v", errors)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "imported file missing.xsd does not exist") {
+		t.Errorf("Expected missing import warning, got %v", warnings)
+	}
+
+	// The WSDL with its imports and the standalone XSD
+	if len(specs) != 2 {
+		t.Fatalf("Expected 2 specs, got %d", len(specs))
+	}
+
+	var orders *config.SpecMetadata
+	for i := range specs {
+		if specs[i].Name == "OrderService" {
+			orders = &specs[i]
+		}
+	}
+	if orders == nil {
+		t.Fatal("Expected WSDL spec")
+	}
+
+	expected := []string{
+		filepath.Join(tempDir, "soap", "types", "orders.xsd"),
+		filepath.Join(tempDir, "soap", "common", "common.xsd"),
+	}
+	if strings.Join(orders.Assets, ",") != strings.Join(expected, ",") {
+		t.Errorf("Expected imports %v, got %v", expected, orders.Assets)
+	}
+}
+
+func TestScannerGroupWSDLImportsRestricted(t *testing.T) {
+	outsideDir := writeStitchingFiles(t, map[string]string{"secret.xsd": `<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema"/>`})
+	defer os.RemoveAll(outsideDir)
+
+	tempDir := writeStitchingFiles(t, map[string]string{
+		"soap/orders.wsdl": `<definitions xmlns="http://schemas.xmlsoap.org/wsdl/" xmlns:xs="http://www.w3.org/2001/XMLSchema">
+  <types><xs:schema>
+    <xs:import schemaLocation=".hidden/types.xsd"/>
+    <xs:import schemaLocation="internal/types.xsd"/>
+    <xs:import schemaLocation="linked.xsd"/>
+    <xs:import schemaLocation="common.xsd"/>
+  </xs:schema></types>
+  <service name="OrderService"/>
+</definitions>`,
+		"soap/.hidden/types.xsd":  `<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema"/>`,
+		"soap/internal/types.xsd": `<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema"/>`,
+		"soap/common.xsd":         `<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema"/>`,
+	})
+	defer os.RemoveAll(tempDir)
+
+	if err := os.Symlink(filepath.Join(outsideDir, "secret.xsd"), filepath.Join(tempDir, "soap", "linked.xsd")); err != nil {
+		t.Skipf("Symbolic links are not supported: %v", err)
+	}
+
+	cfg := config.DiscoveryConfig{ScanDirectory: tempDir, ExcludePatterns: []string{"soap/internal"}}
+	specs, _, warnings, _ := New(cfg).Scan()
+
+	var orders *config.SpecMetadata
+	for i := range specs {
+		if specs[i].Name == "OrderService" {
+			orders = &specs[i]
+		}
+	}
+	if orders == nil {
+		t.Fatal("Expected WSDL spec")
+	}
+	if expected := filepath.Join(tempDir, "soap", "common.xsd"); len(orders.Assets) != 1 || orders.Assets[0] != expected {
+		t.Errorf("Expected only import %s, got %v", expected, orders.Assets)
+	}
+
+	expectedWarnings := []string{
+		"imported file .hidden/types.xsd is excluded from the scan",
+		"imported file internal/types.xsd is excluded from the scan",
+		"imported file linked.xsd is outside the scan directory",
+	}
+	var importWarnings []string
+	for _, warning := range warnings {
+		if strings.Contains(warning, "imported file") {
+			importWarnings = append(importWarnings, warning)
+		}
+	}
+	if len(importWarnings) != len(expectedWarnings) {
+		t.Fatalf("Expected %d warnings, got %v", len(expectedWarnings), warnings)
+	}
+	for i, expected := range expectedWarnings {
+		if !strings.Contains(importWarnings[i], expected) {
+			t.Errorf("Expected warning containing '%s', got '%s'", expected, importWarnings[i])
+		}
+	}
+}
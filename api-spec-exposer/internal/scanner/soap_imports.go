@@ -0,0 +1,73 @@
+package scanner
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/charset"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/wsdl"
+)
+
+// groupWSDLImports collects documents imported or included by WSDL documents, directly or through other imports,
+// into their assets. Imported SOAP documents are served with the WSDL only and removed from the spec list
+func (s *Scanner) groupWSDLImports(specs []config.SpecMetadata) ([]config.SpecMetadata, []string) {
+	var warnings []string
+
+	grouped := make(map[string]bool)
+	for i := range specs {
+		spec := &specs[i]
+		if (spec.Type != config.DocTypeWSDL11 && spec.Type != config.DocTypeWSDL20) || spec.Archive != "" {
+			continue
+		}
+
+		seen := map[string]bool{filepath.Clean(spec.FilePath): true}
+		queue := []string{filepath.Clean(spec.FilePath)}
+		for len(queue) > 0 {
+			current := queue[0]
+			queue = queue[1:]
+
+			content, err := s.readFile(current)
+			if err != nil {
+				warnings = append(warnings, fmt.Sprintf("file %s: cannot collect imported files: %v", current, err))
+				continue
+			}
+			content, _ = charset.Normalize(content)
+			doc, err := wsdl.Parse(content)
+			if err != nil || doc == nil {
+				warnings = append(warnings, fmt.Sprintf("file %s: imported file is not a WSDL or XSD document", current))
+				continue
+			}
+
+			for _, reference := range doc.References {
+				if !wsdl.IsRelative(reference.Location) {
+					continue
+				}
+				importPath := filepath.Join(filepath.Dir(current), filepath.FromSlash(reference.Location))
+				if seen[importPath] {
+					continue
+				}
+				seen[importPath] = true
+
+				if err := s.checkAsset(importPath); err != nil {
+					warnings = append(warnings, fmt.Sprintf("file %s: imported file %s %v", current, reference.Location, err))
+					continue
+				}
+
+				spec.Assets = append(spec.Assets, importPath)
+				grouped[importPath] = true
+				queue = append(queue, importPath)
+			}
+		}
+	}
+
+	var result []config.SpecMetadata
+	for _, spec := range specs {
+		if (spec.ApiType == config.ApiTypeSOAP || spec.ApiType == config.ApiTypeUnknown) && grouped[filepath.Clean(spec.FilePath)] {
+			continue
+		}
+		result = append(result, spec)
+	}
+
+	return result, warnings
+}
@@ -0,0 +1,119 @@
+package scanner
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/graphql"
+)
+
+// stitchGraphQLSchemas merges GraphQL SDL specs of each group into a single spec, federation subgraphs and files of archives are left as is.
+// Groups that fail to merge are reported as errors and their files stay separate specs
+func (s *Scanner) stitchGraphQLSchemas(specs []config.SpecMetadata) ([]config.SpecMetadata, []error) {
+	var errors []error
+
+	groups := make(map[stitchingGroup][]int)
+	var groupOrder []stitchingGroup
+	for i, spec := range specs {
+		if spec.Type != config.DocTypeGraphQL || spec.FederationSubgraph || spec.Archive != "" {
+			continue
+		}
+		group, ok := s.findStitchingGroup(spec.FilePath)
+		if !ok {
+			continue
+		}
+		if _, exists := groups[group]; !exists {
+			groupOrder = append(groupOrder, group)
+		}
+		groups[group] = append(groups[group], i)
+	}
+
+	merged := make(map[int]*config.SpecMetadata)
+	skipped := make(map[int]bool)
+	for _, group := range groupOrder {
+		members := groups[group]
+		if len(members) < 2 {
+			continue
+		}
+
+		var sourceFiles []string
+		for _, i := range members {
+			sourceFiles = append(sourceFiles, specs[i].FilePath)
+		}
+
+		schema, err := graphql.LoadSchema(sourceFiles)
+		if err != nil {
+			errors = append(errors, fmt.Errorf("cannot merge GraphQL schema group %s: %w", group.directory, err))
+			continue
+		}
+
+		details := extractSDLDetails(schema)
+		name := groupName(group.directory)
+		merged[members[0]] = &config.SpecMetadata{
+			Name:        graphQLSpecName(name, details),
+			FilePath:    group.directory,
+			Type:        config.DocTypeGraphQL,
+			ApiType:     config.ApiTypeGraphQL,
+			Format:      config.FormatGraphQL,
+			FileId:      generateFileId(name),
+			XApiKind:    getXApiKind(name),
+			SourceFiles: sourceFiles,
+			SpecDetails: details,
+		}
+		for _, i := range members[1:] {
+			skipped[i] = true
+		}
+	}
+
+	var result []config.SpecMetadata
+	for i, spec := range specs {
+		if skipped[i] {
+			continue
+		}
+		if mergedSpec, ok := merged[i]; ok {
+			result = append(result, *mergedSpec)
+			continue
+		}
+		result = append(result, spec)
+	}
+
+	return result, errors
+}
+
+// groupName returns the directory a merged spec is named after, the absolute one when the files are in a relative
+// scan directory such as '.', which has no name of its own
+func groupName(directory string) string {
+	if base := filepath.Base(directory); base != "." && base != ".." {
+		return directory
+	}
+	if absolute, err := filepath.Abs(directory); err == nil {
+		return absolute
+	}
+	return directory
+}
+
+// stitchingGroup identifies a schema group by the configured pattern its files match, -1 without patterns,
+// and their directory, so that patterns with wildcard directories form a group per matched directory
+type stitchingGroup struct {
+	pattern   int
+	directory string
+}
+
+// findStitchingGroup returns the schema group the file belongs to
+func (s *Scanner) findStitchingGroup(path string) (stitchingGroup, bool) {
+	if len(s.config.GraphQLStitching.Groups) == 0 {
+		return stitchingGroup{pattern: -1, directory: filepath.Dir(path)}, true
+	}
+
+	relPath, err := filepath.Rel(s.config.ScanDirectory, path)
+	if err != nil {
+		return stitchingGroup{}, false
+	}
+	for i, pattern := range s.config.GraphQLStitching.Groups {
+		if matched, err := filepath.Match(pattern, relPath); err == nil && matched {
+			return stitchingGroup{pattern: i, directory: filepath.Dir(path)}, true
+		}
+	}
+	return stitchingGroup{}, false
+}
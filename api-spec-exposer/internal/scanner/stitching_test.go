@@ -0,0 +1,253 @@
+package scanner
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+)
+
+func writeStitchingFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	tempDir, err := os.MkdirTemp("", "scanner-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+
+	for name, content := range files {
+		path := filepath.Join(tempDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("Failed to create directory for %s: %v", name, err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write test file %s: %v", name, err)
+		}
+	}
+
+	return tempDir
+}
+
+func TestScannerStitchingByDirectory(t *testing.T) {
+	tempDir := writeStitchingFiles(t, map[string]string{
+		"users/schema/query.graphqls":  "type Query { users: [User] }",
+		"users/schema/users.graphqls":  "type User { id: ID! }",
+		"users/schema/orders.graphqls": "extend type Query { orders: [Order] }\ntype Order { id: ID! }",
+		"other/schema.graphql":         "type Query { other: String }",
+		"api.json":                     `{"openapi": "3.0.0", "info": {"title": "API", "version": "1.0.0"}, "paths": {}}`,
+	})
+	defer os.RemoveAll(tempDir)
+
+	tests := []struct {
+		name          string
+		enabled       bool
+		expectedSpecs int
+	}{
+		{"stitching disabled", false, 5},
+		{"stitching enabled", true, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.DiscoveryConfig{
+				ScanDirectory:    tempDir,
+				GraphQLStitching: config.GraphQLStitchingConfig{Enabled: tt.enabled},
+			}
+
+			specs, _, _, errors := New(cfg).Scan()
+
+			if len(errors) != 0 {
+				t.Errorf("Expected no errors, got %v", errors)
+			}
+
+			if len(specs) != tt.expectedSpecs {
+				t.Fatalf("Expected %d specs, got %d", tt.expectedSpecs, len(specs))
+			}
+
+			if !tt.enabled {
+				return
+			}
+
+			var stitched *config.SpecMetadata
+			for i := range specs {
+				if len(specs[i].SourceFiles) > 0 {
+					stitched = &specs[i]
+				}
+			}
+
+			if stitched == nil {
+				t.Fatal("Expected stitched spec")
+			}
+
+			if stitched.FilePath != filepath.Join(tempDir, "users", "schema") {
+				t.Errorf("Expected group directory as file path, got '%s'", stitched.FilePath)
+			}
+
+			if stitched.Name != "schema" || stitched.FileId != "schema" {
+				t.Errorf("Expected name and file id 'schema', got '%s' and '%s'", stitched.Name, stitched.FileId)
+			}
+
+			if len(stitched.SourceFiles) != 3 {
+				t.Errorf("Expected 3 source files, got %v", stitched.SourceFiles)
+			}
+
+			if stitched.TypeCount != 3 || stitched.QueryCount != 2 {
+				t.Errorf("Expected 3 types and 2 queries in merged schema, got %d and %d", stitched.TypeCount, stitched.QueryCount)
+			}
+		})
+	}
+}
+
+func TestScannerStitchingRootDirectory(t *testing.T) {
+	tempDir := writeStitchingFiles(t, map[string]string{
+		"billing/query.graphqls":    "type Query { invoices: [Invoice] }",
+		"billing/invoices.graphqls": "type Invoice { id: ID! }",
+	})
+	defer os.RemoveAll(tempDir)
+
+	workingDir, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Failed to get working directory: %v", err)
+	}
+	if err := os.Chdir(filepath.Join(tempDir, "billing")); err != nil {
+		t.Fatalf("Failed to change directory: %v", err)
+	}
+	defer os.Chdir(workingDir)
+
+	cfg := config.DiscoveryConfig{
+		ScanDirectory:    ".",
+		GraphQLStitching: config.GraphQLStitchingConfig{Enabled: true},
+	}
+
+	specs, _, _, errors := New(cfg).Scan()
+
+	if len(errors) != 0 {
+		t.Errorf("Expected no errors, got %v", errors)
+	}
+	if len(specs) != 1 {
+		t.Fatalf("Expected 1 spec, got %d", len(specs))
+	}
+	if specs[0].Name != "billing" || specs[0].FileId != "billing" {
+		t.Errorf("Expected name and file id 'billing', got '%s' and '%s'", specs[0].Name, specs[0].FileId)
+	}
+}
+
+func TestScannerStitchingByPattern(t *testing.T) {
+	tempDir := writeStitchingFiles(t, map[string]string{
+		"schema/query.graphqls": "type Query { users: [User] }",
+		"schema/users.graphqls": "type User { id: ID! }",
+		"schema/admin.graphql":  "type Query { admin: String }",
+	})
+	defer os.RemoveAll(tempDir)
+
+	cfg := config.DiscoveryConfig{
+		ScanDirectory: tempDir,
+		GraphQLStitching: config.GraphQLStitchingConfig{
+			Enabled: true,
+			Groups:  []string{"schema/*.graphqls"},
+		},
+	}
+
+	specs, _, _, errors := New(cfg).Scan()
+
+	if len(errors) != 0 {
+		t.Errorf("Expected no errors, got %v", errors)
+	}
+
+	if len(specs) != 2 {
+		t.Fatalf("Expected stitched spec and admin.graphql, got %d specs", len(specs))
+	}
+
+	for _, spec := range specs {
+		if spec.FilePath == filepath.Join(tempDir, "schema") && len(spec.SourceFiles) != 2 {
+			t.Errorf("Expected 2 source files, got %v", spec.SourceFiles)
+		}
+	}
+}
+
+func TestScannerStitchingInvalidGroup(t *testing.T) {
+	tempDir := writeStitchingFiles(t, map[string]string{
+		"schema/query.graphqls":  "type Query { users: [User] }",
+		"schema/orders.graphqls": "extend type Query { orders: [Order] }",
+	})
+	defer os.RemoveAll(tempDir)
+
+	cfg := config.DiscoveryConfig{
+		ScanDirectory:    tempDir,
+		GraphQLStitching: config.GraphQLStitchingConfig{Enabled: true},
+	}
+
+	specs, _, _, errors := New(cfg).Scan()
+
+	if len(errors) != 1 {
+		t.Fatalf("Expected 1 error, got %v", errors)
+	}
+
+	expected := "cannot merge GraphQL schema group " + filepath.Join(tempDir, "schema") + ": field 'Query.orders' references undefined type 'Order'"
+	if errors[0].Error() != expected {
+		t.Errorf("Expected error '%s', got '%s'", expected, errors[0].Error())
+	}
+
+	if len(specs) != 2 {
+		t.Errorf("Expected files of invalid group to stay separate, got %d specs", len(specs))
+	}
+}
+
+func TestScannerStitchingPatternGroups(t *testing.T) {
+	tempDir := writeStitchingFiles(t, map[string]string{
+		"schema/a-query.graphqls":               "type Query { users: [User] }",
+		"schema/a-users.graphqls":               "type User { id: ID! }",
+		"schema/b-query.graphqls":               "type Query { orders: [Order] }",
+		"schema/b-orders.graphqls":              "type Order { id: ID! }",
+		"services/users/schema/query.graphqls":  "type Query { users: [String] }",
+		"services/users/schema/ext.graphqls":    "extend type Query { user: String }",
+		"services/orders/schema/query.graphqls": "type Query { orders: [String] }",
+		"services/orders/schema/ext.graphqls":   "extend type Query { order: String }",
+	})
+	defer os.RemoveAll(tempDir)
+
+	cfg := config.DiscoveryConfig{
+		ScanDirectory: tempDir,
+		GraphQLStitching: config.GraphQLStitchingConfig{
+			Enabled: true,
+			Groups:  []string{"schema/a*.graphqls", "schema/b*.graphqls", "services/*/schema/*.graphqls"},
+		},
+	}
+
+	specs, _, _, errors := New(cfg).Scan()
+	if len(errors) != 0 {
+		t.Fatalf("Expected no errors, got %v", errors)
+	}
+	if len(specs) != 4 {
+		t.Fatalf("Expected 4 stitched specs, got %d: %v", len(specs), specs)
+	}
+
+	queriesByPath := make(map[string][]int)
+	for _, spec := range specs {
+		if len(spec.SourceFiles) != 2 {
+			t.Errorf("Expected 2 source files in %s, got %v", spec.FilePath, spec.SourceFiles)
+		}
+		if strings.Contains(spec.FilePath, "*") || strings.Contains(spec.FileId, "*") {
+			t.Errorf("Expected a concrete directory, got FilePath '%s' and FileId '%s'", spec.FilePath, spec.FileId)
+		}
+		queriesByPath[spec.FilePath] = append(queriesByPath[spec.FilePath], spec.QueryCount)
+	}
+
+	expected := map[string]int{
+		filepath.Join(tempDir, "schema"):                       2,
+		filepath.Join(tempDir, "services", "users", "schema"):  1,
+		filepath.Join(tempDir, "services", "orders", "schema"): 1,
+	}
+	for path, count := range expected {
+		if len(queriesByPath[path]) != count {
+			t.Errorf("Expected %d specs for %s, got %d", count, path, len(queriesByPath[path]))
+		}
+	}
+	for _, queries := range queriesByPath[filepath.Join(tempDir, "services", "users", "schema")] {
+		if queries != 2 {
+			t.Errorf("Expected 2 queries in the users schema, got %d", queries)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package scanner
+
+import (
+	"strings"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/config"
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/document"
+)
+
+// Validator interface for structural validation of identified specs
+type Validator interface {
+	// Validate checks the spec content and returns the problems found as diagnostics
+	Validate(spec *config.SpecMetadata, content []byte) []config.Diagnostic
+
+	// CanValidate returns true if this validator supports the identified spec
+	CanValidate(spec *config.SpecMetadata) bool
+}
+
+// validate runs every validator that supports the spec
+func validate(validators []Validator, spec *config.SpecMetadata, content []byte) []config.Diagnostic {
+	var diagnostics []config.Diagnostic
+	for _, validator := range validators {
+		if validator.CanValidate(spec) {
+			diagnostics = append(diagnostics, validator.Validate(spec, content)...)
+		}
+	}
+	return diagnostics
+}
+
+func appendPointer(pointer string, tokens ...string) string {
+	return pointer + document.Pointer(tokens...)
+}
+
+func unescapePointerToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+}
+
+// resolvePointer returns the value referenced by a JSON pointer within the document
+func resolvePointer(data interface{}, pointer string) (interface{}, bool) {
+	if pointer == "" {
+		return data, true
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, false
+	}
+
+	current := data
+	for _, token := range strings.Split(pointer[1:], "/") {
+		token = unescapePointerToken(token)
+		switch node := current.(type) {
+		case map[string]interface{}:
+			value, ok := node[token]
+			if !ok {
+				return nil, false
+			}
+			current = value
+		case []interface{}:
+			index := 0
+			if token == "" {
+				return nil, false
+			}
+			for _, r := range token {
+				if r < '0' || r > '9' {
+					return nil, false
+				}
+				index = index*10 + int(r-'0')
+			}
+			if index >= len(node) {
+				return nil, false
+			}
+			current = node[index]
+		default:
+			return nil, false
+		}
+	}
+	return current, true
+}
@@ -0,0 +1,237 @@
+package ui
+
+import (
+	"fmt"
+	"html"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/graphql"
+)
+
+// GraphQLSchema is a schema shown by the GraphQL schema explorer
+type GraphQLSchema struct {
+	Name     string
+	URL      string // endpoint serving the schema
+	Document *graphql.Document
+	Error    error // shown instead of the schema when it could not be loaded
+}
+
+// kindSections lists the sections of the explorer in display order, root operation types go first
+var kindSections = []struct {
+	kind  graphql.DefinitionKind
+	title string
+}{
+	{graphql.KindObject, "Objects"},
+	{graphql.KindInterface, "Interfaces"},
+	{graphql.KindUnion, "Unions"},
+	{graphql.KindEnum, "Enums"},
+	{graphql.KindInput, "Input Objects"},
+	{graphql.KindScalar, "Scalars"},
+}
+
+const explorerStylesheet = `body { margin: 0; background: #fafafa; color: #1f2328; font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; font-size: 15px; line-height: 1.5; }
+main { max-width: 1100px; margin: 0 auto; padding: 24px 32px; }
+h1 { margin: 0 0 16px; font-size: 26px; }
+h2 { margin: 40px 0 8px; padding-bottom: 6px; border-bottom: 1px solid #d1d9e0; }
+h3 { margin: 28px 0 8px; color: #59636e; font-size: 15px; text-transform: uppercase; letter-spacing: .05em; }
+a { color: #0969da; text-decoration: none; }
+a:hover { text-decoration: underline; }
+nav a { margin-right: 16px; }
+.type { margin: 0 0 12px; padding: 12px 16px; border: 1px solid #d1d9e0; border-radius: 6px; background: #ffffff; }
+.type:target { border-color: #0969da; }
+.signature, .field code { font-family: ui-monospace, SFMono-Regular, Menlo, Consolas, monospace; font-size: 14px; }
+.signature { font-weight: 600; }
+.keyword { color: #cf222e; font-weight: normal; }
+.description { margin: 4px 0; color: #59636e; white-space: pre-wrap; }
+.field { padding: 6px 0 6px 16px; border-top: 1px solid #eff1f3; }
+.deprecated { margin-left: 8px; padding: 0 6px; border-radius: 10px; background: #fff8c5; color: #7d4e00; font-size: 12px; }
+.error { padding: 12px 16px; border-radius: 6px; background: #ffebe9; color: #82071e; }
+`
+
+// GraphQLExplorer renders a static HTML page documenting the types of the schemas with links between them
+func GraphQLExplorer(schemas []GraphQLSchema) string {
+	var sb strings.Builder
+	sb.WriteString("<!DOCTYPE html>\n<html lang=\"en\">\n<head>\n<meta charset=\"utf-8\">\n")
+	sb.WriteString("<meta name=\"viewport\" content=\"width=device-width, initial-scale=1\">\n")
+	sb.WriteString("<title>GraphQL Schema Explorer</title>\n<style>\n" + explorerStylesheet + "</style>\n")
+	sb.WriteString("</head>\n<body>\n<main>\n<h1>GraphQL Schema Explorer</h1>\n")
+
+	if len(schemas) > 1 {
+		sb.WriteString("<nav>")
+		for i, schema := range schemas {
+			fmt.Fprintf(&sb, "<a href=\"#%s\">%s</a>", schemaAnchor(i), html.EscapeString(schema.Name))
+		}
+		sb.WriteString("</nav>\n")
+	}
+
+	for i, schema := range schemas {
+		writeSchema(&sb, schemaAnchor(i), schema)
+	}
+
+	sb.WriteString("</main>\n</body>\n</html>\n")
+	return sb.String()
+}
+
+func writeSchema(sb *strings.Builder, anchor string, schema GraphQLSchema) {
+	fmt.Fprintf(sb, "<h2 id=\"%s\">%s</h2>\n", anchor, html.EscapeString(schema.Name))
+	fmt.Fprintf(sb, "<p><a href=\"%s\">Download schema</a></p>\n", html.EscapeString(schema.URL))
+	if schema.Error != nil {
+		fmt.Fprintf(sb, "<p class=\"error\">Failed to load schema: %s</p>\n", html.EscapeString(schema.Error.Error()))
+		return
+	}
+
+	doc := schema.Document
+	if merged, errs := graphql.Merge(doc); len(errs) == 0 {
+		doc = merged
+	}
+	writeDescription(sb, doc.SchemaDescription())
+
+	types := make(map[string]*graphql.Definition)
+	for _, def := range doc.Definitions {
+		if def.Kind != graphql.KindSchema && def.Kind != graphql.KindDirective {
+			types[def.Name] = def
+		}
+	}
+	link := func(name string) string {
+		if _, ok := types[name]; !ok {
+			return html.EscapeString(name)
+		}
+		return fmt.Sprintf("<a href=\"#%s\">%s</a>", typeAnchor(anchor, name), html.EscapeString(name))
+	}
+
+	roots := doc.RootTypes()
+	rootNames := make(map[string]bool)
+	var operations []*graphql.Definition
+	for _, name := range []string{roots.Query, roots.Mutation, roots.Subscription} {
+		if def, ok := types[name]; ok && name != "" {
+			operations = append(operations, def)
+			rootNames[name] = true
+		}
+	}
+	if len(operations) > 0 {
+		sb.WriteString("<h3>Operations</h3>\n")
+		for _, def := range operations {
+			writeType(sb, anchor, def, link)
+		}
+	}
+
+	for _, section := range kindSections {
+		var defs []*graphql.Definition
+		for name, def := range types {
+			if def.Kind == section.kind && !rootNames[name] {
+				defs = append(defs, def)
+			}
+		}
+		if len(defs) == 0 {
+			continue
+		}
+		sort.Slice(defs, func(i, j int) bool { return defs[i].Name < defs[j].Name })
+
+		sb.WriteString("<h3>" + section.title + "</h3>\n")
+		for _, def := range defs {
+			writeType(sb, anchor, def, link)
+		}
+	}
+}
+
+func writeType(sb *strings.Builder, anchor string, def *graphql.Definition, link func(string) string) {
+	fmt.Fprintf(sb, "<div class=\"type\" id=\"%s\">\n", typeAnchor(anchor, def.Name))
+	fmt.Fprintf(sb, "<div class=\"signature\"><span class=\"keyword\">%s</span> %s", def.Kind, html.EscapeString(def.Name))
+	if len(def.Interfaces) > 0 {
+		links := make([]string, len(def.Interfaces))
+		for i, name := range def.Interfaces {
+			links[i] = link(name)
+		}
+		sb.WriteString(" <span class=\"keyword\">implements</span> " + strings.Join(links, " &amp; "))
+	}
+	if len(def.Types) > 0 {
+		links := make([]string, len(def.Types))
+		for i, name := range def.Types {
+			links[i] = link(name)
+		}
+		sb.WriteString(" = " + strings.Join(links, " | "))
+	}
+	sb.WriteString("</div>\n")
+	writeDescription(sb, def.Description)
+
+	for _, field := range def.Fields {
+		signature := html.EscapeString(field.Name) + arguments(field.Arguments, link) + ": " + typeReference(field.Type, link)
+		writeField(sb, signature, field.Description, field.Directives)
+	}
+	for _, field := range def.InputFields {
+		signature := html.EscapeString(field.Name) + ": " + typeReference(field.Type, link)
+		if field.DefaultValue != "" {
+			signature += " = " + html.EscapeString(field.DefaultValue)
+		}
+		writeField(sb, signature, field.Description, field.Directives)
+	}
+	for _, value := range def.EnumValues {
+		writeField(sb, html.EscapeString(value.Name), value.Description, value.Directives)
+	}
+	sb.WriteString("</div>\n")
+}
+
+func writeField(sb *strings.Builder, signature string, description string, directives []graphql.Directive) {
+	sb.WriteString("<div class=\"field\"><code>" + signature + "</code>")
+	for _, directive := range directives {
+		if directive.Name != "deprecated" {
+			continue
+		}
+		reason := "deprecated"
+		for _, argument := range directive.Arguments {
+			if argument.Name == "reason" {
+				if unquoted, err := strconv.Unquote(argument.Value); err == nil {
+					reason = "deprecated: " + unquoted
+				}
+			}
+		}
+		sb.WriteString("<span class=\"deprecated\">" + html.EscapeString(reason) + "</span>")
+	}
+	sb.WriteString("\n")
+	writeDescription(sb, description)
+	sb.WriteString("</div>\n")
+}
+
+func writeDescription(sb *strings.Builder, description string) {
+	if description != "" {
+		sb.WriteString("<div class=\"description\">" + html.EscapeString(description) + "</div>\n")
+	}
+}
+
+func arguments(values []graphql.InputValueDefinition, link func(string) string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	parts := make([]string, len(values))
+	for i, value := range values {
+		parts[i] = html.EscapeString(value.Name) + ": " + typeReference(value.Type, link)
+		if value.DefaultValue != "" {
+			parts[i] += " = " + html.EscapeString(value.DefaultValue)
+		}
+	}
+	return "(" + strings.Join(parts, ", ") + ")"
+}
+
+// typeReference renders a type reference with its named type linked to its definition
+func typeReference(t *graphql.Type, link func(string) string) string {
+	var s string
+	if t.OfType != nil {
+		s = "[" + typeReference(t.OfType, link) + "]"
+	} else {
+		s = link(t.Name)
+	}
+	if t.NonNull {
+		s += "!"
+	}
+	return s
+}
+
+func schemaAnchor(index int) string {
+	return fmt.Sprintf("schema-%d", index+1)
+}
+
+func typeAnchor(schemaAnchor string, typeName string) string {
+	return schemaAnchor + "-" + typeName
+}
@@ -0,0 +1,45 @@
+package ui
+
+import (
+	"embed"
+	"encoding/json"
+	"io/fs"
+)
+
+// SwaggerUIVersion is the version of the embedded Swagger UI distribution (Apache License 2.0, see swagger-ui/LICENSE).
+// The files are copied unchanged from the dist directory of https://github.com/swagger-api/swagger-ui at the tag
+// of the version, swagger-ui/SHA256SUMS lists their checksums
+const SwaggerUIVersion = "5.18.2"
+
+//go:embed swagger-ui/index.html swagger-ui/index.css swagger-ui/swagger-ui.css swagger-ui/swagger-ui-bundle.js
+//go:embed swagger-ui/swagger-ui-standalone-preset.js swagger-ui/favicon-16x16.png swagger-ui/favicon-32x32.png
+var swaggerUIFiles embed.FS
+
+// SwaggerUIFiles returns the static files of the embedded Swagger UI distribution
+func SwaggerUIFiles() fs.FS {
+	files, _ := fs.Sub(swaggerUIFiles, "swagger-ui")
+	return files
+}
+
+// SwaggerInitializer returns the script starting Swagger UI. The UI loads the spec list from configURL
+// when it is set and the single spec on specURL otherwise
+func SwaggerInitializer(configURL string, specURL string) string {
+	options := map[string]interface{}{
+		"dom_id":      "#swagger-ui",
+		"deepLinking": true,
+	}
+	if configURL != "" {
+		options["configUrl"] = configURL
+	} else {
+		options["url"] = specURL
+	}
+	encoded, _ := json.Marshal(options)
+
+	return "window.onload = function () {\n" +
+		"  var options = " + string(encoded) + ";\n" +
+		"  options.presets = [SwaggerUIBundle.presets.apis, SwaggerUIStandalonePreset];\n" +
+		"  options.plugins = [SwaggerUIBundle.plugins.DownloadUrl];\n" +
+		"  options.layout = \"StandaloneLayout\";\n" +
+		"  window.ui = SwaggerUIBundle(options);\n" +
+		"};\n"
+}
@@ -0,0 +1,118 @@
+package ui
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io/fs"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/Netcracker/qubership-apihub-commons-go/api-spec-exposer/internal/graphql"
+)
+
+func TestSwaggerUIFiles(t *testing.T) {
+	for _, name := range []string{"index.html", "swagger-ui.css", "swagger-ui-bundle.js", "swagger-ui-standalone-preset.js"} {
+		content, err := fs.ReadFile(SwaggerUIFiles(), name)
+		if err != nil {
+			t.Errorf("Expected embedded file %s, got error: %v", name, err)
+			continue
+		}
+		if len(content) == 0 {
+			t.Errorf("Expected embedded file %s not to be empty", name)
+		}
+	}
+}
+
+func TestSwaggerUIChecksums(t *testing.T) {
+	sums, err := os.ReadFile("swagger-ui/SHA256SUMS")
+	if err != nil {
+		t.Fatalf("Failed to read checksums: %v", err)
+	}
+
+	for _, line := range strings.Split(strings.TrimSpace(string(sums)), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			t.Fatalf("Unexpected checksum line %q", line)
+		}
+		content, err := fs.ReadFile(SwaggerUIFiles(), fields[1])
+		if err != nil {
+			t.Errorf("Expected embedded file %s, got error: %v", fields[1], err)
+			continue
+		}
+		sum := sha256.Sum256(content)
+		if hex.EncodeToString(sum[:]) != fields[0] {
+			t.Errorf("Expected checksum %s of %s, got %s", fields[0], fields[1], hex.EncodeToString(sum[:]))
+		}
+	}
+}
+
+func TestSwaggerInitializer(t *testing.T) {
+	tests := []struct {
+		name      string
+		configURL string
+		specURL   string
+		expected  string
+	}{
+		{name: "config url", configURL: "/v3/api-docs/swagger-config", expected: `"configUrl":"/v3/api-docs/swagger-config"`},
+		{name: "spec url", specURL: "/v3/api-docs", expected: `"url":"/v3/api-docs"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			script := SwaggerInitializer(tt.configURL, tt.specURL)
+			if !strings.Contains(script, tt.expected) || !strings.Contains(script, "SwaggerUIBundle(options)") {
+				t.Errorf("Expected initializer with %s, got %q", tt.expected, script)
+			}
+		})
+	}
+}
+
+func TestGraphQLExplorer(t *testing.T) {
+	doc, err := graphql.Parse(`
+"Users service"
+schema { query: Query }
+type Query {
+  "Finds a user"
+  user(id: ID!): User
+  legacy: String @deprecated(reason: "Use user")
+}
+type User implements Node { id: ID! friends: [User!]! role: Role }
+extend type User { name: String }
+interface Node { id: ID! }
+enum Role { ADMIN USER }
+`)
+	if err != nil {
+		t.Fatalf("Failed to parse schema: %v", err)
+	}
+
+	page := GraphQLExplorer([]GraphQLSchema{
+		{Name: "Users <v1>", URL: "/api/graphql-server/schema/users", Document: doc},
+		{Name: "Broken", URL: "/api/graphql-server/schema/broken", Error: errors.New("syntax error")},
+	})
+
+	expected := []string{
+		`<nav><a href="#schema-1">Users &lt;v1&gt;</a><a href="#schema-2">Broken</a></nav>`,
+		`<a href="/api/graphql-server/schema/users">Download schema</a>`,
+		`<div class="description">Users service</div>`,
+		`<div class="type" id="schema-1-Query">`,
+		`<code>user(id: ID!): <a href="#schema-1-User">User</a></code>`,
+		`<div class="description">Finds a user</div>`,
+		`<span class="deprecated">deprecated: Use user</span>`,
+		`<span class="keyword">implements</span> <a href="#schema-1-Node">Node</a>`,
+		`<code>friends: [<a href="#schema-1-User">User</a>!]!</code>`,
+		`<code>name: String</code>`,
+		`<code>ADMIN</code>`,
+		`<p class="error">Failed to load schema: syntax error</p>`,
+	}
+	for _, fragment := range expected {
+		if !strings.Contains(page, fragment) {
+			t.Errorf("Expected page to contain %q", fragment)
+		}
+	}
+
+	if strings.Index(page, "<h3>Operations</h3>") > strings.Index(page, "<h3>Objects</h3>") {
+		t.Error("Expected operations before other types")
+	}
+}
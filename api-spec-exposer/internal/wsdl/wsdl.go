@@ -0,0 +1,198 @@
+package wsdl
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// Namespaces of the supported document types
+const (
+	NamespaceWSDL11 = "http://schemas.xmlsoap.org/wsdl/"
+	NamespaceWSDL20 = "http://www.w3.org/ns/wsdl"
+	NamespaceXSD    = "http://www.w3.org/2001/XMLSchema"
+)
+
+// Kind is the type of a SOAP service description document
+type Kind string
+
+const (
+	KindWSDL11 Kind = "wsdl-1-1"
+	KindWSDL20 Kind = "wsdl-2-0"
+	KindXSD    Kind = "xsd"
+)
+
+// Document describes a WSDL or XSD document
+type Document struct {
+	Kind            Kind
+	Name            string // 'name' attribute of the root element
+	TargetNamespace string
+	Services        []string
+	OperationCount  int // operations of WSDL 1.1 port types or WSDL 2.0 interfaces
+	References      []Reference
+}
+
+// Reference is an import or include of another document
+type Reference struct {
+	Start    int // byte offset of the raw attribute value in the document
+	End      int
+	Location string
+}
+
+var locationAttributePattern = regexp.MustCompile(`(?:^|\s)(?:[\w.-]+:)?(location|schemaLocation)\s*=\s*(?:"([^"]*)"|'([^']*)')`)
+
+// referenceElements lists elements referencing other documents by namespace and local name, with the attribute holding the location
+var referenceElements = map[xml.Name]string{
+	{Space: NamespaceWSDL11, Local: "import"}:  "location",
+	{Space: NamespaceWSDL20, Local: "import"}:  "location",
+	{Space: NamespaceWSDL20, Local: "include"}: "location",
+	{Space: NamespaceXSD, Local: "import"}:     "schemaLocation",
+	{Space: NamespaceXSD, Local: "include"}:    "schemaLocation",
+	{Space: NamespaceXSD, Local: "redefine"}:   "schemaLocation",
+	{Space: NamespaceXSD, Local: "override"}:   "schemaLocation",
+}
+
+// Parse reads a WSDL 1.1, WSDL 2.0 or XSD document with a streaming decoder.
+// It returns nil without an error when the root element is not one of these documents
+func Parse(content []byte) (*Document, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(content))
+	// Service descriptions may declare any encoding, only names and locations are read
+	decoder.CharsetReader = func(charset string, input io.Reader) (io.Reader, error) {
+		return input, nil
+	}
+
+	var doc *Document
+	var parents []xml.Name
+	for {
+		start := decoder.InputOffset()
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch element := token.(type) {
+		case xml.StartElement:
+			if doc == nil {
+				doc = newDocument(element)
+				if doc == nil {
+					return nil, nil
+				}
+			}
+			doc.visit(element, parents)
+			if attribute, ok := referenceElements[element.Name]; ok {
+				if reference, ok := findReference(content, int(start), int(decoder.InputOffset()), element, attribute); ok {
+					doc.References = append(doc.References, reference)
+				}
+			}
+			parents = append(parents, element.Name)
+		case xml.EndElement:
+			parents = parents[:len(parents)-1]
+		}
+	}
+
+	if doc == nil {
+		return nil, errors.New("document has no root element")
+	}
+	return doc, nil
+}
+
+func newDocument(root xml.StartElement) *Document {
+	var kind Kind
+	switch root.Name {
+	case xml.Name{Space: NamespaceWSDL11, Local: "definitions"}:
+		kind = KindWSDL11
+	case xml.Name{Space: NamespaceWSDL20, Local: "description"}:
+		kind = KindWSDL20
+	case xml.Name{Space: NamespaceXSD, Local: "schema"}:
+		kind = KindXSD
+	default:
+		return nil
+	}
+	return &Document{
+		Kind:            kind,
+		Name:            attributeValue(root, "name"),
+		TargetNamespace: attributeValue(root, "targetNamespace"),
+	}
+}
+
+func (d *Document) visit(element xml.StartElement, parents []xml.Name) {
+	if len(parents) == 0 {
+		return
+	}
+	parent := parents[len(parents)-1]
+	switch {
+	case len(parents) == 1 && element.Name.Local == "service" && isWSDLNamespace(element.Name.Space):
+		if name := attributeValue(element, "name"); name != "" {
+			d.Services = append(d.Services, name)
+		}
+	case element.Name.Local == "operation" && isWSDLNamespace(element.Name.Space) &&
+		(parent.Local == "portType" || parent.Local == "interface") && len(parents) == 2:
+		d.OperationCount++
+	}
+}
+
+// findReference locates the location attribute of a reference element in its raw start tag content[start:end]
+func findReference(content []byte, start int, end int, element xml.StartElement, attribute string) (Reference, bool) {
+	location := attributeValue(element, attribute)
+	if location == "" {
+		return Reference{}, false
+	}
+	tag := content[start:end]
+	for _, match := range locationAttributePattern.FindAllSubmatchIndex(tag, -1) {
+		if string(tag[match[2]:match[3]]) != attribute {
+			continue
+		}
+		if match[4] >= 0 {
+			return Reference{Start: start + match[4], End: start + match[5], Location: location}, true
+		}
+		return Reference{Start: start + match[6], End: start + match[7], Location: location}, true
+	}
+	return Reference{}, false
+}
+
+// RewriteReferences replaces reference locations for which rewrite returns true
+func RewriteReferences(content []byte, rewrite func(location string) (string, bool)) ([]byte, error) {
+	doc, err := Parse(content)
+	if err != nil || doc == nil {
+		return content, err
+	}
+
+	var result bytes.Buffer
+	offset := 0
+	for _, reference := range doc.References {
+		replacement, ok := rewrite(reference.Location)
+		if !ok {
+			continue
+		}
+		result.Write(content[offset:reference.Start])
+		xml.EscapeText(&result, []byte(replacement))
+		offset = reference.End
+	}
+	result.Write(content[offset:])
+	return result.Bytes(), nil
+}
+
+// IsRelative reports whether a reference location is a relative path that can be resolved against the referencing file
+func IsRelative(location string) bool {
+	return location != "" && !strings.HasPrefix(location, "/") && !strings.Contains(location, ":") &&
+		!strings.HasPrefix(location, "#") && !strings.Contains(location, "?")
+}
+
+func isWSDLNamespace(namespace string) bool {
+	return namespace == NamespaceWSDL11 || namespace == NamespaceWSDL20
+}
+
+func attributeValue(element xml.StartElement, name string) string {
+	for _, attribute := range element.Attr {
+		if attribute.Name.Space == "" && attribute.Name.Local == name {
+			return attribute.Value
+		}
+	}
+	return ""
+}
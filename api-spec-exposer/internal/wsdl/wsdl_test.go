@@ -0,0 +1,162 @@
+package wsdl
+
+import (
+	"testing"
+)
+
+const ordersWSDL = `<?xml version="1.0" encoding="UTF-8"?>
+<wsdl:definitions name="Orders" targetNamespace="http://example.com/orders"
+    xmlns:wsdl="http://schemas.xmlsoap.org/wsdl/" xmlns:xs="http://www.w3.org/2001/XMLSchema">
+  <wsdl:import namespace="http://example.com/common" location="common.wsdl"/>
+  <wsdl:types>
+    <xs:schema targetNamespace="http://example.com/orders">
+      <xs:import namespace="http://example.com/types" schemaLocation='types/orders.xsd'/>
+      <xs:include schemaLocation="https://example.com/remote.xsd"/>
+    </xs:schema>
+  </wsdl:types>
+  <wsdl:portType name="OrdersPort">
+    <wsdl:operation name="GetOrder"/>
+    <wsdl:operation name="CreateOrder"/>
+  </wsdl:portType>
+  <wsdl:binding name="OrdersBinding" type="OrdersPort">
+    <wsdl:operation name="GetOrder"/>
+  </wsdl:binding>
+  <wsdl:service name="OrderService"/>
+  <wsdl:service name="OrderAdminService"/>
+</wsdl:definitions>`
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name            string
+		content         string
+		kind            Kind
+		docName         string
+		targetNamespace string
+		services        []string
+		operations      int
+		locations       []string
+	}{
+		{
+			name:            "wsdl 1.1",
+			content:         ordersWSDL,
+			kind:            KindWSDL11,
+			docName:         "Orders",
+			targetNamespace: "http://example.com/orders",
+			services:        []string{"OrderService", "OrderAdminService"},
+			operations:      2,
+			locations:       []string{"common.wsdl", "types/orders.xsd", "https://example.com/remote.xsd"},
+		},
+		{
+			name: "wsdl 2.0",
+			content: `<description xmlns="http://www.w3.org/ns/wsdl" targetNamespace="http://example.com/users">
+  <include location="base.wsdl"/>
+  <interface name="Users"><operation name="GetUser"/></interface>
+  <service name="UserService" interface="Users"/>
+</description>`,
+			kind:            KindWSDL20,
+			targetNamespace: "http://example.com/users",
+			services:        []string{"UserService"},
+			operations:      1,
+			locations:       []string{"base.wsdl"},
+		},
+		{
+			name:            "xsd",
+			content:         `<xsd:schema xmlns:xsd="http://www.w3.org/2001/XMLSchema" targetNamespace="http://example.com/types"><xsd:include schemaLocation="common.xsd"/></xsd:schema>`,
+			kind:            KindXSD,
+			targetNamespace: "http://example.com/types",
+			locations:       []string{"common.xsd"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := Parse([]byte(tt.content))
+			if err != nil || doc == nil {
+				t.Fatalf("Expected document, got error: %v", err)
+			}
+			if doc.Kind != tt.kind {
+				t.Errorf("Expected kind %s, got %s", tt.kind, doc.Kind)
+			}
+			if doc.Name != tt.docName {
+				t.Errorf("Expected name '%s', got '%s'", tt.docName, doc.Name)
+			}
+			if doc.TargetNamespace != tt.targetNamespace {
+				t.Errorf("Expected target namespace '%s', got '%s'", tt.targetNamespace, doc.TargetNamespace)
+			}
+			if len(doc.Services) != len(tt.services) {
+				t.Fatalf("Expected services %v, got %v", tt.services, doc.Services)
+			}
+			for i := range tt.services {
+				if doc.Services[i] != tt.services[i] {
+					t.Errorf("Expected services %v, got %v", tt.services, doc.Services)
+				}
+			}
+			if doc.OperationCount != tt.operations {
+				t.Errorf("Expected %d operations, got %d", tt.operations, doc.OperationCount)
+			}
+			if len(doc.References) != len(tt.locations) {
+				t.Fatalf("Expected references %v, got %v", tt.locations, doc.References)
+			}
+			for i, reference := range doc.References {
+				if reference.Location != tt.locations[i] {
+					t.Errorf("Expected location '%s', got '%s'", tt.locations[i], reference.Location)
+				}
+				if raw := tt.content[reference.Start:reference.End]; raw != reference.Location {
+					t.Errorf("Expected reference offsets to match '%s', got '%s'", reference.Location, raw)
+				}
+			}
+		})
+	}
+}
+
+func TestParseOtherDocuments(t *testing.T) {
+	doc, err := Parse([]byte(`<project xmlns="http://maven.apache.org/POM/4.0.0"><name>app</name></project>`))
+	if err != nil || doc != nil {
+		t.Errorf("Expected other XML documents to be skipped, got %v, %v", doc, err)
+	}
+
+	if _, err := Parse([]byte(`<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema">`)); err == nil {
+		t.Error("Expected error for malformed document")
+	}
+}
+
+func TestRewriteReferences(t *testing.T) {
+	content := `<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema"><xs:import schemaLocation="a.xsd"/><xs:include schemaLocation='b.xsd'/></xs:schema>`
+
+	result, err := RewriteReferences([]byte(content), func(location string) (string, bool) {
+		if location == "a.xsd" {
+			return "imports/a.xsd?x=1&y=2", true
+		}
+		return "", false
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := `<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema"><xs:import schemaLocation="imports/a.xsd?x=1&amp;y=2"/><xs:include schemaLocation='b.xsd'/></xs:schema>`
+	if string(result) != expected {
+		t.Errorf("Expected %q, got %q", expected, string(result))
+	}
+}
+
+func TestIsRelative(t *testing.T) {
+	tests := []struct {
+		location string
+		expected bool
+	}{
+		{"types/orders.xsd", true},
+		{"../common.xsd", true},
+		{"https://example.com/a.xsd", false},
+		{"/abs/a.xsd", false},
+		{"urn:example:a", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.location, func(t *testing.T) {
+			if result := IsRelative(tt.location); result != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}